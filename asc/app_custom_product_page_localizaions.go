@@ -22,6 +22,8 @@ type AppCustomProductPageLocalization struct {
 type AppCustomProductPageLocalizationAttributes struct {
 	Locale          string `json:"locale"`
 	PromotionalText string `json:"promotionalText,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCustomProductPageLocalizationRelationships defines model for AppCustomProductPageLocalizationRelationships.
@@ -31,6 +33,8 @@ type AppCustomProductPageLocalizationRelationships struct {
 	AppCustomProductPageVersion *RelationshipsAppCustomProductPageVersion `json:"appCustomProductPageVersion,omitempty"`
 	AppPreviewSets              *PagedRelationship                        `json:"appPreviewSets,omitempty"`
 	AppScreenshotSets           *PagedRelationship                        `json:"appScreenshotSets,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // RelationshipsAppCustomProductPageVersion defines model for RelationshipsAppCustomProductPageVersion.
@@ -137,6 +141,8 @@ type AppCustomProductPageLocalizationInlineCreate struct {
 type AppCustomProductPageLocalizationInlineCreateAttributes struct {
 	Locale          string `json:"locale"`
 	PromotionalText string `json:"promotionalText"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCustomProductPageLocalizationInlineCreateRelationships defines model for AppCustomProductPageLocalizationInlineCreate.Relationships
@@ -144,6 +150,8 @@ type AppCustomProductPageLocalizationInlineCreateAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationinlinecreate/relationships
 type AppCustomProductPageLocalizationInlineCreateRelationships struct {
 	AppCustomProductPageVersion *RelationShipAppCustomProductPageVersion `json:"appCustomProductPageVersion"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type RelationShipAppCustomProductPageVersion struct {
@@ -154,7 +162,7 @@ type RelationShipAppCustomProductPageVersion struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appcustomproductpagelocalizations_id_appscreenshotsets
 func (s *AppCustomProductPageService) GetCustomProductPageLocalizationAppScreenshotSets(ctx context.Context, id string, params *GetCustomProductPageLocalizationAppScreenshotSetsRequest) (*AppScreenshotSetsResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/appCustomProductPageLocalizations/%s/appScreenshotSets", id)
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s/appScreenshotSets", id)
 	res := new(AppScreenshotSetsResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 	if err != nil {
@@ -187,7 +195,7 @@ func (s *AppCustomProductPageService) CreateAppCustomProductPageLocalization(ctx
 		},
 	}
 
-	url := fmt.Sprintf("/v1/appCustomProductPageLocalizations")
+	url := fmt.Sprintf("appCustomProductPageLocalizations")
 	res := new(AppCustomProductPageLocalizationResponse)
 	resp, err := s.client.post(ctx, url, newRequestBody(req.Data), res)
 	if err != nil {
@@ -51,6 +51,8 @@ type AppCustomProductPageVersion struct {
 type AppCustomProductPageVersionAttributes struct {
 	State   string `json:"state,omitempty"`
 	Version string `json:"version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCustomProductPageVersionRelationships defines model for AppCustomProductPageVersionRelationships.
@@ -59,6 +61,8 @@ type AppCustomProductPageVersionAttributes struct {
 type AppCustomProductPageVersionRelationships struct {
 	AppCustomProductPage              *RelationshipsAppCustomProductPage `json:"appCustomProductPage,omitempty"`
 	AppCustomProductPageLocalizations *AppCustomProductPageLocalizations `json:"appCustomProductPageLocalizations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // RelationshipsAppCustomProductPage defines model for RelationshipsAppCustomProductPage.
@@ -101,6 +105,8 @@ type AppCustomProductPageVersionInlineCreate struct {
 type AppCustomProductPageVersionInlineCreateRelationships struct {
 	AppCustomProductPage              *RelationShipAppCustomProductPage              `json:"appCustomProductPage,omitempty"`
 	AppCustomProductPageLocalizations *RelationShipAppCustomProductPageLocalizations `json:"appCustomProductPageLocalizations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // RelationShipAppCustomProductPage defines model for AppCustomProductPageVersionInlineCreate.Relationships.AppCustomProductPage
@@ -121,7 +127,7 @@ type RelationShipAppCustomProductPageLocalizations struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appcustomproductpageversions_id
 func (s *AppCustomProductPageService) GetAppCustomProductPageVersion(ctx context.Context, id string, req *GetAppCustomProductPageVersionsRequest) (*AppCustomProductPageVersionResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/appCustomProductPageVersions/%s", id)
+	url := fmt.Sprintf("appCustomProductPageVersions/%s", id)
 	res := new(AppCustomProductPageVersionResponse)
 	resp, err := s.client.get(ctx, url, req, res)
 	if err != nil {
@@ -25,11 +25,15 @@ type AppCustomProductPageAttributes struct {
 	Name    string `json:"name"`
 	Url     string `json:"url"`
 	Visible bool   `json:"visible"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type AppCustomProductPageRelationships struct {
 	App      *AppCustomProductPageRelationshipsApp      `json:"app,omitempty"`
 	Versions *AppCustomProductPageRelationshipsVersions `json:"versions,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type AppCustomProductPageRelationshipsApp struct {
@@ -86,6 +90,8 @@ type AppCustomProductPageCreateRequestData struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data/attributes
 type AppCustomProductPageCreateRequestDataAttributes struct {
 	Name string `json:"name"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCustomProductPageCreateRequestDataRelationships defines model for appCustomProductPageCreateRequest.Data.Relationships
@@ -96,6 +102,8 @@ type AppCustomProductPageCreateRequestDataRelationships struct {
 	AppCustomProductPageVersions *AppCustomProductPageCreateRequestDataRelationshipsAppCustomProductPageVersions `json:"appCustomProductPageVersions,omitempty"`
 	AppStoreVersionTemplate      *AppCustomProductPageCreateRequestDataRelationshipsAppStoreVersionTemplate      `json:"appStoreVersionTemplate,omitempty"`
 	CustomProductPageTemplate    *AppCustomProductPageCreateRequestDataRelationshipsCustomProductPageTemplate    `json:"customProductPageTemplate,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCustomProductPageCreateRequestDataRelationshipsApp defines model for appCustomProductPageCreateRequest.Data.Relationships.App
@@ -125,10 +133,11 @@ type AppCustomProductPageCreateRequestDataRelationshipsCustomProductPageTemplate
 }
 
 type GetAppCustomProductPageQuery struct {
-	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
-	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
-	Include                            []string `url:"include,omitempty"`
-	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
+	FieldsAppCustomProductPageVersions []string     `url:"fields[appCustomProductPageVersions],omitempty"`
+	FieldsAppCustomProductPages        []string     `url:"fields[appCustomProductPages],omitempty"`
+	Include                            []string     `url:"include,omitempty"`
+	LimitAppCustomProductPageVersions  int          `url:"limit[appCustomProductPageVersions],omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // AppCustomProductPageResponse defines model for AppCustomProductPageResponse.
@@ -154,33 +163,35 @@ type AppCustomProductPagesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app/
 type GetAppCustomProductPagesForAnAppQuery struct {
-	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
-	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
-	filterVisible                      []string `url:"filter[visible],omitempty"`
-	Include                            []string `url:"include,omitempty"`
-	Limit                              int      `url:"limit,omitempty"`
-	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
-	FieldsApps                         []string `url:"fields[apps],omitempty"`
+	FieldsAppCustomProductPageVersions []string     `url:"fields[appCustomProductPageVersions],omitempty"`
+	FieldsAppCustomProductPages        []string     `url:"fields[appCustomProductPages],omitempty"`
+	filterVisible                      []string     `url:"filter[visible],omitempty"`
+	Include                            []string     `url:"include,omitempty"`
+	Limit                              int          `url:"limit,omitempty"`
+	LimitAppCustomProductPageVersions  int          `url:"limit[appCustomProductPageVersions],omitempty"`
+	FieldsApps                         []string     `url:"fields[apps],omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // GetAppCustomProductPageVersionsByAppCustomProductPagesIdQuery defines model for GetAppCustomProductPageVersionsByAppCustomProductPagesIdQuery.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appcustomproductpages_id_appcustomproductpageversions/
 type GetAppCustomProductPageVersionsByAppCustomProductPagesIdQuery struct {
-	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
-	FieldsAppCustomProductPageVersions      []string `url:"fields[appCustomProductPageVersions],omitempty"`
-	FilterState                             []string `url:"filter[state],omitempty"`
-	Include                                 []string `url:"include,omitempty"`
-	Limit                                   int      `url:"limit,omitempty"`
-	LimitAppCustomProductPageLocalizations  int      `url:"limit[appCustomProductPageLocalizations],omitempty"`
-	FieldsAppCustomProductPages             []string `url:"fields[appCustomProductPages],omitempty"`
+	FieldsAppCustomProductPageLocalizations []string     `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppCustomProductPageVersions      []string     `url:"fields[appCustomProductPageVersions],omitempty"`
+	FilterState                             []string     `url:"filter[state],omitempty"`
+	Include                                 []string     `url:"include,omitempty"`
+	Limit                                   int          `url:"limit,omitempty"`
+	LimitAppCustomProductPageLocalizations  int          `url:"limit[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppCustomProductPages             []string     `url:"fields[appCustomProductPages],omitempty"`
+	Query                                   QueryEncoder `url:"-"`
 }
 
 // GetAppCustomProductPage  get app custom product page by id
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appcustomproductpages_id
 func (s *AppCustomProductPageService) GetAppCustomProductPage(ctx context.Context, id string, params *GetAppCustomProductPageQuery) (*AppCustomProductPageResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/appCustomProductPages/%s", id)
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
 	res := new(AppCustomProductPageResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
@@ -191,7 +202,7 @@ func (s *AppCustomProductPageService) GetAppCustomProductPage(ctx context.Contex
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app/
 func (s *AppCustomProductPageService) GetAllAppCustomProductPagesForAnApp(ctx context.Context, appId string, params *GetAppCustomProductPagesForAnAppQuery) (*AppCustomProductPagesResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/apps/%s/appCustomProductPages", appId)
+	url := fmt.Sprintf("apps/%s/appCustomProductPages", appId)
 	res := new(AppCustomProductPagesResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 	return res, resp, err
@@ -202,7 +213,7 @@ func (s *AppCustomProductPageService) GetAllAppCustomProductPagesForAnApp(ctx co
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appcustomproductpages_id_appcustomproductpageversions/
 func (s *AppCustomProductPageService) GetAppCustomProductPageVersionsByAppCustomProductPageId(ctx context.Context, customProductPageId string,
 	params *GetAppCustomProductPageVersionsByAppCustomProductPagesIdQuery) (*AppCustomProductPageVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/appCustomProductPages/%s/appCustomProductPageVersions", customProductPageId)
+	url := fmt.Sprintf("appCustomProductPages/%s/appCustomProductPageVersions", customProductPageId)
 	res := new(AppCustomProductPageVersionsResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 	return res, resp, err
@@ -269,7 +280,7 @@ func (s *AppCustomProductPageService) CreateAppCustomProductPage(ctx context.Con
 		},
 	}
 
-	url := "/v1/appCustomProductPages"
+	url := "appCustomProductPages"
 	res := new(AppCustomProductPageResponse)
 	resp, err := s.client.post(ctx, url, newRequestBodyWithIncluded(req.Data, req.Included), res)
 	return res, resp, err
@@ -279,7 +290,7 @@ func (s *AppCustomProductPageService) CreateAppCustomProductPage(ctx context.Con
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_custom_product_page
 func (s *AppCustomProductPageService) DeleteAnAppCustomProductPage(ctx context.Context, id string) (*Response, error) {
-	url := fmt.Sprintf("/v1/appCustomProductPages/%s", id)
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
 
 	return s.client.delete(ctx, url, nil)
 }
@@ -25,10 +25,14 @@ type CustomerReviewAttributes struct {
 	CreatedDate      string `json:"createdDate"`
 	ReviewerNickname string `json:"reviewerNickname"`
 	Territory        string `json:"territory"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type Relationships struct {
 	Response *Relationship `json:"response,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // CustomerReviewsResponse defines model for CustomerReviewsResponse.
@@ -58,38 +62,48 @@ type CustomerReviewResponseAttributes struct {
 	ResponseBody     string `json:"responseBody"`
 	LastModifiedDate string `json:"lastModifiedDate"`
 	State            string `json:"state"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type CustomerReviewResponseV1Relationships struct {
 	Review *Relationship `json:"review"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GetCustomerReviewsQuery defines query parameters for getting customer reviews.
 type GetCustomerReviewsQuery struct {
-	FieldsCustomerReviews         string `url:"fields[customerReviews],omitempty"`         // 要返回的客户评论字段
-	FieldsCustomerReviewResponses string `url:"fields[customerReviewResponses],omitempty"` // 要返回的客户评论回复字段
-	FilterRating                  string `url:"filter[rating],omitempty"`                  // 评级过滤（如：1, 2, 5）
-	FilterTerritory               string `url:"filter[territory],omitempty"`               // 国家或地区过滤
-	Include                       string `url:"include,omitempty"`                         // 包含的相关数据，如评论回复
-	Limit                         int    `url:"limit,omitempty"`                           // 返回的记录数量，最大值为200
-	Sort                          string `url:"sort,omitempty"`                            // 排序方式，如：createdDate, -createdDate, rating, -rating
-	ExistsPublishedResponse       bool   `url:"exists[publishedResponse],omitempty"`       // 过滤是否有已发布回复的评论
-	Cursor                        string `url:"cursor,omitempty"`
+	FieldsCustomerReviews         string       `url:"fields[customerReviews],omitempty"`         // 要返回的客户评论字段
+	FieldsCustomerReviewResponses string       `url:"fields[customerReviewResponses],omitempty"` // 要返回的客户评论回复字段
+	FilterRating                  string       `url:"filter[rating],omitempty"`                  // 评级过滤（如：1, 2, 5）
+	FilterTerritory               string       `url:"filter[territory],omitempty"`               // 国家或地区过滤
+	Include                       string       `url:"include,omitempty"`                         // 包含的相关数据，如评论回复
+	Limit                         int          `url:"limit,omitempty"`                           // 返回的记录数量，最大值为200
+	Sort                          string       `url:"sort,omitempty"`                            // 排序方式，如：createdDate, -createdDate, rating, -rating
+	ExistsPublishedResponse       bool         `url:"exists[publishedResponse],omitempty"`       // 过滤是否有已发布回复的评论
+	Cursor                        string       `url:"cursor,omitempty"`
+	Query                         QueryEncoder `url:"-"`
 }
 
 // GetCustomerReviewQuery defines query parameters for getting a specific customer review.
 type GetCustomerReviewQuery struct {
-	FieldsCustomerReviews         string `url:"fields[customerReviews],omitempty"`         // 要返回的客户评论字段
-	FieldsCustomerReviewResponses string `url:"fields[customerReviewResponses],omitempty"` // 要返回的客户评论回复字段
-	Include                       string `url:"include,omitempty"`                         // 包含的相关数据，如评论回复
+	FieldsCustomerReviews         string       `url:"fields[customerReviews],omitempty"`         // 要返回的客户评论字段
+	FieldsCustomerReviewResponses string       `url:"fields[customerReviewResponses],omitempty"` // 要返回的客户评论回复字段
+	Include                       string       `url:"include,omitempty"`                         // 包含的相关数据，如评论回复
+	Query                         QueryEncoder `url:"-"`
 }
 
 type CustomerReviewResponseV1CreateRequestDataAttributes struct {
 	ResponseBody string `json:"responseBody"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type CustomerReviewResponseV1CreateRequestRelationships struct {
 	Review CustomerReviewResponseV1CreateRequestRelationshipsReview `json:"review"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 type CustomerReviewResponseV1CreateRequestRelationshipsReview struct {
@@ -120,7 +134,7 @@ type CustomerReviewResponseV1Response struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v1-apps-_id_-customerreviews
 // GET https://api.appstoreconnect.apple.com/v1/apps/{id}/customerReviews
 func (s *AppCustomerReviewsService) GetCustomerReviewsForApp(ctx context.Context, appId string, params *GetCustomerReviewsQuery) (*CustomerReviewsResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/apps/%s/customerReviews", appId)
+	url := fmt.Sprintf("apps/%s/customerReviews", appId)
 	res := new(CustomerReviewsResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 	return res, resp, err
@@ -130,7 +144,7 @@ func (s *AppCustomerReviewsService) GetCustomerReviewsForApp(ctx context.Context
 // https://developer.apple.com/documentation/appstoreconnectapi/post-v1-customerreviewresponses
 // POST GET POST https://api.appstoreconnect.apple.com/v1/customerReviewResponses
 func (s *AppCustomerReviewsService) CreateOrUpdateCustomerReviewResponse(ctx context.Context, appId string, params *CustomerReviewResponseV1CreateRequest) (*CustomerReviewResponseV1Response, *Response, error) {
-	url := "/v1/customerReviewResponses"
+	url := "customerReviewResponses"
 	res := new(CustomerReviewResponseV1Response)
 	resp, err := s.client.post(ctx, url, newRequestBody(params), res)
 	return res, resp, err
@@ -140,7 +154,7 @@ func (s *AppCustomerReviewsService) CreateOrUpdateCustomerReviewResponse(ctx con
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v1-customerreviews-_id_
 // GET https://api.appstoreconnect.apple.com/v1/customerReviews/{id}
 func (s *AppCustomerReviewsService) GetCustomerReview(ctx context.Context, id string, params *GetCustomerReviewQuery) (*CustomerReviewResponse, *Response, error) {
-	url := fmt.Sprintf("/v1/customerReviews/%s", id)
+	url := fmt.Sprintf("customerReviews/%s", id)
 	res := new(CustomerReviewResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 	return res, resp, err
@@ -67,6 +67,8 @@ type AppAttributes struct {
 	Name                      *string `json:"name,omitempty"`
 	PrimaryLocale             *string `json:"primaryLocale,omitempty"`
 	Sku                       *string `json:"sku,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppRelationships defines model for App.Relationships
@@ -87,6 +89,8 @@ type AppRelationships struct {
 	PreOrder                  *Relationship      `json:"preOrder,omitempty"`
 	PreReleaseVersions        *PagedRelationship `json:"preReleaseVersions,omitempty"`
 	Prices                    *PagedRelationship `json:"prices,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppUpdateRequest defines model for AppUpdateRequest.
@@ -107,6 +111,8 @@ type AppUpdateRequestAttributes struct {
 	BundleID                  *string `json:"bundleId,omitempty"`
 	ContentRightsDeclaration  *string `json:"contentRightsDeclaration,omitempty"`
 	PrimaryLocale             *string `json:"primaryLocale,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appUpdateRequestRelationships are relationships for AppUpdateRequest
@@ -159,6 +165,8 @@ type InAppPurchaseAttributes struct {
 	ProductID         *string `json:"productId,omitempty"`
 	ReferenceName     *string `json:"referenceName,omitempty"`
 	State             *string `json:"state,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // InAppPurchaseRelationships defines model for InAppPurchase.Relationships
@@ -166,6 +174,8 @@ type InAppPurchaseAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/inapppurchase/relationships
 type InAppPurchaseRelationships struct {
 	Apps *PagedRelationship `json:"apps,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // InAppPurchaseResponse defines model for InAppPurchaseResponse.
@@ -189,100 +199,104 @@ type InAppPurchasesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_apps
 type ListAppsQuery struct {
-	FieldsApps                          []string `url:"fields[apps],omitempty"`
-	FieldsBetaLicenseAgreements         []string `url:"fields[betaLicenseAgreements],omitempty"`
-	FieldsPreReleaseVersions            []string `url:"fields[preReleaseVersions],omitempty"`
-	FieldsBetaAppReviewDetails          []string `url:"fields[betaAppReviewDetails],omitempty"`
-	FieldsBetaAppLocalizations          []string `url:"fields[betaAppLocalizations],omitempty"`
-	FieldsBuilds                        []string `url:"fields[builds],omitempty"`
-	FieldsBetaGroups                    []string `url:"fields[betaGroups],omitempty"`
-	FieldsEndUserLicenseAgreements      []string `url:"fields[endUserLicenseAgreements],omitempty"`
-	FieldsAppStoreVersions              []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsTerritories                   []string `url:"fields[territories],omitempty"`
-	FieldsAppPrices                     []string `url:"fields[appPrices],omitempty"`
-	FieldsAppPreOrders                  []string `url:"fields[appPreOrders],omitempty"`
-	FieldsAppInfos                      []string `url:"fields[appInfos],omitempty"`
-	FieldsPerfPowerMetrics              []string `url:"fields[perfPowerMetrics],omitempty"`
-	FieldsInAppPurchases                []string `url:"fields[inAppPurchases],omitempty"`
-	FilterBundleID                      []string `url:"filter[bundleId],omitempty"`
-	FilterID                            []string `url:"filter[id],omitempty"`
-	FilterName                          []string `url:"filter[name],omitempty"`
-	FilterSKU                           []string `url:"filter[sku],omitempty"`
-	FilterAppStoreVersions              []string `url:"filter[appStoreVersions],omitempty"`
-	FilterAppStoreVersionsPlatform      []string `url:"filter[appStoreVersionsPlatform],omitempty"`
-	FilterAppStoreVersionsAppStoreState []string `url:"filter[appStoreVersionsAppStoreState],omitempty"`
-	FilterGameCenterEnabledVersions     []string `url:"filter[gameCenterEnabledVersions],omitempty"`
-	Include                             []string `url:"include,omitempty"`
-	Limit                               int      `url:"limit,omitempty"`
-	LimitPreReleaseVersions             int      `url:"limit[preReleaseVersions],omitempty"`
-	LimitBuilds                         int      `url:"limit[builds],omitempty"`
-	LimitBetaGroups                     int      `url:"limit[betaGroups],omitempty"`
-	LimitBetaAppLocalizations           int      `url:"limit[betaAppLocalizations],omitempty"`
-	LimitPrices                         int      `url:"limit[prices],omitempty"`
-	LimitAvailableTerritories           int      `url:"limit[availableTerritories],omitempty"`
-	LimitAppStoreVersions               int      `url:"limit[appStoreVersions],omitempty"`
-	LimitAppInfos                       int      `url:"limit[appInfos],omitempty"`
-	LimitGameCenterEnabledVersions      int      `url:"limit[gameCenterEnabledVersions],omitempty"`
-	LimitInAppPurchases                 int      `url:"limit[inAppPurchases],omitempty"`
-	Sort                                []string `url:"sort,omitempty"`
-	ExistsGameCenterEnabledVersions     []string `url:"exists[gameCenterEnabledVersions],omitempty"`
-	Cursor                              string   `url:"cursor,omitempty"`
+	FieldsApps                          []string     `url:"fields[apps],omitempty"`
+	FieldsBetaLicenseAgreements         []string     `url:"fields[betaLicenseAgreements],omitempty"`
+	FieldsPreReleaseVersions            []string     `url:"fields[preReleaseVersions],omitempty"`
+	FieldsBetaAppReviewDetails          []string     `url:"fields[betaAppReviewDetails],omitempty"`
+	FieldsBetaAppLocalizations          []string     `url:"fields[betaAppLocalizations],omitempty"`
+	FieldsBuilds                        []string     `url:"fields[builds],omitempty"`
+	FieldsBetaGroups                    []string     `url:"fields[betaGroups],omitempty"`
+	FieldsEndUserLicenseAgreements      []string     `url:"fields[endUserLicenseAgreements],omitempty"`
+	FieldsAppStoreVersions              []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsTerritories                   []string     `url:"fields[territories],omitempty"`
+	FieldsAppPrices                     []string     `url:"fields[appPrices],omitempty"`
+	FieldsAppPreOrders                  []string     `url:"fields[appPreOrders],omitempty"`
+	FieldsAppInfos                      []string     `url:"fields[appInfos],omitempty"`
+	FieldsPerfPowerMetrics              []string     `url:"fields[perfPowerMetrics],omitempty"`
+	FieldsInAppPurchases                []string     `url:"fields[inAppPurchases],omitempty"`
+	FilterBundleID                      []string     `url:"filter[bundleId],omitempty"`
+	FilterID                            []string     `url:"filter[id],omitempty"`
+	FilterName                          []string     `url:"filter[name],omitempty"`
+	FilterSKU                           []string     `url:"filter[sku],omitempty"`
+	FilterAppStoreVersions              []string     `url:"filter[appStoreVersions],omitempty"`
+	FilterAppStoreVersionsPlatform      []string     `url:"filter[appStoreVersionsPlatform],omitempty"`
+	FilterAppStoreVersionsAppStoreState []string     `url:"filter[appStoreVersionsAppStoreState],omitempty"`
+	FilterGameCenterEnabledVersions     []string     `url:"filter[gameCenterEnabledVersions],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	Limit                               int          `url:"limit,omitempty"`
+	LimitPreReleaseVersions             int          `url:"limit[preReleaseVersions],omitempty"`
+	LimitBuilds                         int          `url:"limit[builds],omitempty"`
+	LimitBetaGroups                     int          `url:"limit[betaGroups],omitempty"`
+	LimitBetaAppLocalizations           int          `url:"limit[betaAppLocalizations],omitempty"`
+	LimitPrices                         int          `url:"limit[prices],omitempty"`
+	LimitAvailableTerritories           int          `url:"limit[availableTerritories],omitempty"`
+	LimitAppStoreVersions               int          `url:"limit[appStoreVersions],omitempty"`
+	LimitAppInfos                       int          `url:"limit[appInfos],omitempty"`
+	LimitGameCenterEnabledVersions      int          `url:"limit[gameCenterEnabledVersions],omitempty"`
+	LimitInAppPurchases                 int          `url:"limit[inAppPurchases],omitempty"`
+	Sort                                []string     `url:"sort,omitempty"`
+	ExistsGameCenterEnabledVersions     []string     `url:"exists[gameCenterEnabledVersions],omitempty"`
+	Cursor                              string       `url:"cursor,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // GetAppQuery are query options for GetApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_information
 type GetAppQuery struct {
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	FieldsBetaLicenseAgreements     []string `url:"fields[betaLicenseAgreements],omitempty"`
-	FieldsPreReleaseVersions        []string `url:"fields[preReleaseVersions],omitempty"`
-	FieldsBetaAppReviewDetails      []string `url:"fields[betaAppReviewDetails],omitempty"`
-	FieldsBetaAppLocalizations      []string `url:"fields[betaAppLocalizations],omitempty"`
-	FieldsBuilds                    []string `url:"fields[builds],omitempty"`
-	FieldsBetaGroups                []string `url:"fields[betaGroups],omitempty"`
-	FieldsEndUserLicenseAgreements  []string `url:"fields[endUserLicenseAgreements],omitempty"`
-	FieldsAppStoreVersions          []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsTerritories               []string `url:"fields[territories],omitempty"`
-	FieldsAppPrices                 []string `url:"fields[appPrices],omitempty"`
-	FieldsAppPreOrders              []string `url:"fields[appPreOrders],omitempty"`
-	FieldsAppInfos                  []string `url:"fields[appInfos],omitempty"`
-	FieldsPerfPowerMetrics          []string `url:"fields[perfPowerMetrics],omitempty"`
-	FieldsGameCenterEnabledVersions []string `url:"fields[gameCenterEnabledVersions],omitempty"`
-	FieldsInAppPurchases            []string `url:"fields[inAppPurchases],omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	LimitPreReleaseVersions         int      `url:"limit[preReleaseVersions],omitempty"`
-	LimitBuilds                     int      `url:"limit[builds],omitempty"`
-	LimitBetaGroups                 int      `url:"limit[betaGroups],omitempty"`
-	LimitBetaAppLocalizations       int      `url:"limit[betaAppLocalizations],omitempty"`
-	LimitPrices                     int      `url:"limit[prices],omitempty"`
-	LimitAvailableTerritories       int      `url:"limit[availableTerritories],omitempty"`
-	LimitAppStoreVersions           int      `url:"limit[appStoreVersions],omitempty"`
-	LimitAppInfos                   int      `url:"limit[appInfos],omitempty"`
-	LimitGameCenterEnabledVersions  int      `url:"limit[gameCenterEnabledVersions],omitempty"`
-	LimitInAppPurchases             int      `url:"limit[inAppPurchases],omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	FieldsBetaLicenseAgreements     []string     `url:"fields[betaLicenseAgreements],omitempty"`
+	FieldsPreReleaseVersions        []string     `url:"fields[preReleaseVersions],omitempty"`
+	FieldsBetaAppReviewDetails      []string     `url:"fields[betaAppReviewDetails],omitempty"`
+	FieldsBetaAppLocalizations      []string     `url:"fields[betaAppLocalizations],omitempty"`
+	FieldsBuilds                    []string     `url:"fields[builds],omitempty"`
+	FieldsBetaGroups                []string     `url:"fields[betaGroups],omitempty"`
+	FieldsEndUserLicenseAgreements  []string     `url:"fields[endUserLicenseAgreements],omitempty"`
+	FieldsAppStoreVersions          []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsTerritories               []string     `url:"fields[territories],omitempty"`
+	FieldsAppPrices                 []string     `url:"fields[appPrices],omitempty"`
+	FieldsAppPreOrders              []string     `url:"fields[appPreOrders],omitempty"`
+	FieldsAppInfos                  []string     `url:"fields[appInfos],omitempty"`
+	FieldsPerfPowerMetrics          []string     `url:"fields[perfPowerMetrics],omitempty"`
+	FieldsGameCenterEnabledVersions []string     `url:"fields[gameCenterEnabledVersions],omitempty"`
+	FieldsInAppPurchases            []string     `url:"fields[inAppPurchases],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	LimitPreReleaseVersions         int          `url:"limit[preReleaseVersions],omitempty"`
+	LimitBuilds                     int          `url:"limit[builds],omitempty"`
+	LimitBetaGroups                 int          `url:"limit[betaGroups],omitempty"`
+	LimitBetaAppLocalizations       int          `url:"limit[betaAppLocalizations],omitempty"`
+	LimitPrices                     int          `url:"limit[prices],omitempty"`
+	LimitAvailableTerritories       int          `url:"limit[availableTerritories],omitempty"`
+	LimitAppStoreVersions           int          `url:"limit[appStoreVersions],omitempty"`
+	LimitAppInfos                   int          `url:"limit[appInfos],omitempty"`
+	LimitGameCenterEnabledVersions  int          `url:"limit[gameCenterEnabledVersions],omitempty"`
+	LimitInAppPurchases             int          `url:"limit[inAppPurchases],omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // ListInAppPurchasesQuery are query options for ListInAppPurchases
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchases_for_an_app
 type ListInAppPurchasesQuery struct {
-	FieldsApps              []string `url:"fields[apps],omitempty"`
-	FieldsInAppPurchases    []string `url:"fields[inAppPurchases],omitempty"`
-	FilterCanBeSubmitted    []string `url:"filter[canBeSubmitted],omitempty"`
-	FilterInAppPurchaseType []string `url:"filter[inAppPurchaseType],omitempty"`
-	Limit                   int      `url:"limit,omitempty"`
-	Include                 []string `url:"include,omitempty"`
-	Sort                    []string `url:"sort,omitempty"`
-	Cursor                  string   `url:"cursor,omitempty"`
+	FieldsApps              []string     `url:"fields[apps],omitempty"`
+	FieldsInAppPurchases    []string     `url:"fields[inAppPurchases],omitempty"`
+	FilterCanBeSubmitted    []string     `url:"filter[canBeSubmitted],omitempty"`
+	FilterInAppPurchaseType []string     `url:"filter[inAppPurchaseType],omitempty"`
+	Limit                   int          `url:"limit,omitempty"`
+	Include                 []string     `url:"include,omitempty"`
+	Sort                    []string     `url:"sort,omitempty"`
+	Cursor                  string       `url:"cursor,omitempty"`
+	Query                   QueryEncoder `url:"-"`
 }
 
 // GetInAppPurchaseQuery are query options for GetInAppPurchase
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_information
 type GetInAppPurchaseQuery struct {
-	FieldsInAppPurchases []string `url:"fields[inAppPurchases],omitempty"`
-	Include              []string `url:"include,omitempty"`
-	LimitApps            int      `url:"limit[apps],omitempty"`
+	FieldsInAppPurchases []string     `url:"fields[inAppPurchases],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	LimitApps            int          `url:"limit[apps],omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListApps finds and lists apps added in App Store Connect.
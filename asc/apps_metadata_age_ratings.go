@@ -104,6 +104,8 @@ type AgeRatingDeclarationUpdateRequestAttributes struct {
 	ViolenceCartoonOrFantasy                    *string      `json:"violenceCartoonOrFantasy,omitempty"`
 	ViolenceRealistic                           *string      `json:"violenceRealistic,omitempty"`
 	ViolenceRealisticProlongedGraphicOrSadistic *string      `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AgeRatingDeclarationResponse defines model for AgeRatingDeclarationResponse.
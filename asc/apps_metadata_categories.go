@@ -41,6 +41,8 @@ type AppCategory struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appcategory/attributes
 type AppCategoryAttributes struct {
 	Platforms []Platform `json:"platforms,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCategoryRelationships defines model for AppCategory.Relationships
@@ -49,6 +51,8 @@ type AppCategoryAttributes struct {
 type AppCategoryRelationships struct {
 	Parent        *Relationship      `json:"parent,omitempty"`
 	Subcategories *PagedRelationship `json:"subcategories,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppCategoriesResponse defines model for AppCategoriesResponse.
@@ -78,31 +82,34 @@ type AppCategoryResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_categories
 type ListAppCategoriesQuery struct {
-	ExistsParent        []string `url:"exists[parent],omitempty"`
-	FieldsAppCategories []string `url:"fields[appCategories],omitempty"`
-	FilterPlatforms     []string `url:"filter[platforms],omitempty"`
-	Include             []string `url:"include,omitempty"`
-	Limit               int      `url:"limit,omitempty"`
-	LimitSubcategories  []string `url:"limit[subcategories],omitempty"`
-	Cursor              string   `url:"cursor,omitempty"`
+	ExistsParent        []string     `url:"exists[parent],omitempty"`
+	FieldsAppCategories []string     `url:"fields[appCategories],omitempty"`
+	FilterPlatforms     []string     `url:"filter[platforms],omitempty"`
+	Include             []string     `url:"include,omitempty"`
+	Limit               int          `url:"limit,omitempty"`
+	LimitSubcategories  []string     `url:"limit[subcategories],omitempty"`
+	Cursor              string       `url:"cursor,omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // ListSubcategoriesForAppCategoryQuery are query options for ListSubcategoriesForAppCategory
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_subcategories_for_an_app_category
 type ListSubcategoriesForAppCategoryQuery struct {
-	FieldsAppCategories []string `url:"fields[appCategories],omitempty"`
-	Limit               int      `url:"limit,omitempty"`
-	Cursor              string   `url:"cursor,omitempty"`
+	FieldsAppCategories []string     `url:"fields[appCategories],omitempty"`
+	Limit               int          `url:"limit,omitempty"`
+	Cursor              string       `url:"cursor,omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // GetAppCategoryQuery are query options for GetAppCategory
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_category_information
 type GetAppCategoryQuery struct {
-	FieldsAppCategories []string `url:"fields[appCategories],omitempty"`
-	Include             []string `url:"include,omitempty"`
-	LimitSubcategories  []string `url:"limit[subcategories],omitempty"`
+	FieldsAppCategories []string     `url:"fields[appCategories],omitempty"`
+	Include             []string     `url:"include,omitempty"`
+	LimitSubcategories  []string     `url:"limit[subcategories],omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // GetAppCategoryForAppInfoQuery are query options for GetAppCategoryForAppInfo
@@ -115,7 +122,8 @@ type GetAppCategoryQuery struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_secondary_subcategory_one_information_of_an_app_info
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_secondary_subcategory_two_information_of_an_app_info
 type GetAppCategoryForAppInfoQuery struct {
-	FieldsAppCategories []string `url:"fields[appCategories],omitempty"`
+	FieldsAppCategories []string     `url:"fields[appCategories],omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // ListAppCategories lists all categories on the App Store, including the category and subcategory hierarchy.
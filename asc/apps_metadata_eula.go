@@ -41,6 +41,8 @@ type EndUserLicenseAgreement struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/enduserlicenseagreement/attributes
 type EndUserLicenseAgreementAttributes struct {
 	AgreementText *string `json:"agreementText,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // EndUserLicenseAgreementRelationships defines model for EndUserLicenseAgreement.Relationships
@@ -49,6 +51,8 @@ type EndUserLicenseAgreementAttributes struct {
 type EndUserLicenseAgreementRelationships struct {
 	App         *Relationship      `json:"app,omitempty"`
 	Territories *PagedRelationship `json:"territories,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // endUserLicenseAgreementCreateRequest defines model for EndUserLicenseAgreementCreateRequest.
@@ -112,17 +116,19 @@ type EndUserLicenseAgreementResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_end_user_license_agreement_information
 type GetEULAQuery struct {
-	FieldsEndUserLicenseAgreements []string `url:"fields[endUserLicenseAgreements],omitempty"`
-	FieldsTerritories              []string `url:"fields[territories],omitempty"`
-	Include                        []string `url:"include,omitempty"`
-	LimitTerritories               int      `url:"limit[territories],omitempty"`
+	FieldsEndUserLicenseAgreements []string     `url:"fields[endUserLicenseAgreements],omitempty"`
+	FieldsTerritories              []string     `url:"fields[territories],omitempty"`
+	Include                        []string     `url:"include,omitempty"`
+	LimitTerritories               int          `url:"limit[territories],omitempty"`
+	Query                          QueryEncoder `url:"-"`
 }
 
 // GetEULAForAppQuery are query options for GetEULAForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_end_user_license_agreement_information_of_an_app
 type GetEULAForAppQuery struct {
-	FieldsEndUserLicenseAgreements []string `url:"fields[endUserLicenseAgreements],omitempty"`
+	FieldsEndUserLicenseAgreements []string     `url:"fields[endUserLicenseAgreements],omitempty"`
+	Query                          QueryEncoder `url:"-"`
 }
 
 // CreateEULA adds a custom end user license agreement (EULA) to an app and configure the territories to which it applies.
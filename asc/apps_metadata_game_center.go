@@ -43,6 +43,8 @@ type GameCenterEnabledVersionAttributes struct {
 	IconAsset     *ImageAsset `json:"iconAsset,omitempty"`
 	Platform      *Platform   `json:"platform,omitempty"`
 	VersionString *string     `json:"versionString,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterEnabledVersionRelationships defines model for GameCenterEnabledVersion.Relationships
@@ -51,6 +53,8 @@ type GameCenterEnabledVersionAttributes struct {
 type GameCenterEnabledVersionRelationships struct {
 	App                *Relationship      `json:"app,omitempty"`
 	CompatibleVersions *PagedRelationship `json:"compatibleVersions,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterEnabledVersionCompatibleVersionsLinkagesResponse defines model for GameCenterEnabledVersionCompatibleVersionsLinkagesResponse.
@@ -76,37 +80,40 @@ type GameCenterEnabledVersionsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_compatible_versions_for_a_game_center_enabled_version
 type ListGameCenterEnabledVersionsForAppQuery struct {
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	FieldsGameCenterEnabledVersions []string `url:"fields[gameCenterEnabledVersions],omitempty"`
-	Limit                           int      `url:"limit,omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	Sort                            []string `url:"sort,omitempty"`
-	FilterID                        []string `url:"filter[id],omitempty"`
-	FilterPlatform                  []string `url:"filter[platform],omitempty"`
-	FilterVersionString             []string `url:"filter[versionString],omitempty"`
-	Cursor                          string   `url:"cursor,omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	FieldsGameCenterEnabledVersions []string     `url:"fields[gameCenterEnabledVersions],omitempty"`
+	Limit                           int          `url:"limit,omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Sort                            []string     `url:"sort,omitempty"`
+	FilterID                        []string     `url:"filter[id],omitempty"`
+	FilterPlatform                  []string     `url:"filter[platform],omitempty"`
+	FilterVersionString             []string     `url:"filter[versionString],omitempty"`
+	Cursor                          string       `url:"cursor,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // ListCompatibleVersionsForGameCenterEnabledVersionQuery are query options for ListCompatibleVersionsForGameCenterEnabledVersion.
 type ListCompatibleVersionsForGameCenterEnabledVersionQuery struct {
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	FieldsGameCenterEnabledVersions []string `url:"fields[gameCenterEnabledVersions],omitempty"`
-	Limit                           int      `url:"limit,omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	Sort                            []string `url:"sort,omitempty"`
-	FilterApp                       []string `url:"filter[app],omitempty"`
-	FilterID                        []string `url:"filter[id],omitempty"`
-	FilterPlatform                  []string `url:"filter[platform],omitempty"`
-	FilterVersionString             []string `url:"filter[versionString],omitempty"`
-	Cursor                          string   `url:"cursor,omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	FieldsGameCenterEnabledVersions []string     `url:"fields[gameCenterEnabledVersions],omitempty"`
+	Limit                           int          `url:"limit,omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Sort                            []string     `url:"sort,omitempty"`
+	FilterApp                       []string     `url:"filter[app],omitempty"`
+	FilterID                        []string     `url:"filter[id],omitempty"`
+	FilterPlatform                  []string     `url:"filter[platform],omitempty"`
+	FilterVersionString             []string     `url:"filter[versionString],omitempty"`
+	Cursor                          string       `url:"cursor,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // ListCompatibleVersionIDsForGameCenterEnabledVersionQuery are query options for ListCompatibleVersionIDsForGameCenterEnabledVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_compatible_version_ids_for_a_game_center_enabled_version
 type ListCompatibleVersionIDsForGameCenterEnabledVersionQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // ListGameCenterEnabledVersionsForApp lists the versions for a given app that are enabled for Game Center
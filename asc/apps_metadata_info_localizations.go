@@ -45,6 +45,8 @@ type AppInfoLocalizationAttributes struct {
 	PrivacyPolicyText *string `json:"privacyPolicyText,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	Subtitle          *string `json:"subtitle,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppInfoLocalizationRelationships defines model for AppInfoLocalization.Relationships
@@ -52,6 +54,8 @@ type AppInfoLocalizationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appinfolocalization/relationships
 type AppInfoLocalizationRelationships struct {
 	AppInfo *Relationship `json:"appInfo,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appInfoLocalizationCreateRequest defines model for AppInfoLocalizationCreateRequest.
@@ -72,6 +76,8 @@ type AppInfoLocalizationCreateRequestAttributes struct {
 	PrivacyPolicyText *string `json:"privacyPolicyText,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	Subtitle          *string `json:"subtitle,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppInfoLocalizationCreateRequestRelationships are relationships for AppInfoLocalizationCreateRequest
@@ -106,6 +112,8 @@ type AppInfoLocalizationUpdateRequestAttributes struct {
 	PrivacyPolicyText *string `json:"privacyPolicyText,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	Subtitle          *string `json:"subtitle,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppInfoLocalizationsResponse defines model for AppInfoLocalizationsResponse.
@@ -121,20 +129,22 @@ type AppInfoLocalizationsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_info_localizations_for_an_app_info
 type ListAppInfoLocalizationsForAppInfoQuery struct {
-	FieldsAppInfos             []string `url:"fields[appInfos],omitempty"`
-	FieldsAppInfoLocalizations []string `url:"fields[appInfoLocalizations],omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Include                    []string `url:"include,omitempty"`
-	FilterLocale               []string `url:"filter[locale],omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsAppInfos             []string     `url:"fields[appInfos],omitempty"`
+	FieldsAppInfoLocalizations []string     `url:"fields[appInfoLocalizations],omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	FilterLocale               []string     `url:"filter[locale],omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetAppInfoLocalizationQuery are query options for GetAppInfoLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_info_localization_information
 type GetAppInfoLocalizationQuery struct {
-	FieldsAppInfoLocalizations []string `url:"fields[appInfoLocalizations],omitempty"`
-	Include                    []string `url:"include,omitempty"`
+	FieldsAppInfoLocalizations []string     `url:"fields[appInfoLocalizations],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // ListAppInfoLocalizationsForAppInfo gets a list of localized, app-level information for an app.
@@ -44,6 +44,8 @@ type AppInfoAttributes struct {
 	AppStoreState     *AppStoreVersionState `json:"appStoreState,omitempty"`
 	BrazilAgeRating   *BrazilAgeRating      `json:"brazilAgeRating,omitempty"`
 	KidsAgeBand       *KidsAgeBand          `json:"kidsAgeBand,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppInfoRelationships defines model for AppInfo.Relationships
@@ -59,6 +61,8 @@ type AppInfoRelationships struct {
 	SecondarySubcategoryOne *Relationship      `json:"secondarySubcategoryOne,omitempty"`
 	SecondarySubcategoryTwo *Relationship      `json:"secondarySubcategoryTwo,omitempty"`
 	AgeRatingDeclaration    *Relationship      `json:"ageRatingDeclarations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppInfoResponse defines model for AppInfoResponse.
@@ -113,39 +117,44 @@ type AppInfoUpdateRequestRelationships struct {
 	SecondaryCategoryID       *string
 	SecondarySubcategoryOneID *string
 	SecondarySubcategoryTwoID *string
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GetAppInfoQuery are query options for GetAppInfo
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_info_information
 type GetAppInfoQuery struct {
-	FieldsAppInfos              []string `url:"fields[appInfos],omitempty"`
-	FieldsAppInfoLocalizations  []string `url:"fields[appInfoLocalizations],omitempty"`
-	FieldsAppCategories         []string `url:"fields[appCategories],omitempty"`
-	Include                     []string `url:"include,omitempty"`
-	LimitAppInfoLocalizations   int      `url:"limit[appInfoLocalizations],omitempty"`
-	FieldsAgeRatingDeclarations []string `url:"fields[ageRatingDeclarations],omitEmpty"`
+	FieldsAppInfos              []string     `url:"fields[appInfos],omitempty"`
+	FieldsAppInfoLocalizations  []string     `url:"fields[appInfoLocalizations],omitempty"`
+	FieldsAppCategories         []string     `url:"fields[appCategories],omitempty"`
+	Include                     []string     `url:"include,omitempty"`
+	LimitAppInfoLocalizations   int          `url:"limit[appInfoLocalizations],omitempty"`
+	FieldsAgeRatingDeclarations []string     `url:"fields[ageRatingDeclarations],omitEmpty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // ListAppInfosForAppQuery are query options for ListAppInfosForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_infos_for_an_app
 type ListAppInfosForAppQuery struct {
-	FieldsAppInfos              []string `url:"fields[appInfos],omitempty"`
-	FieldsApps                  []string `url:"fields[apps],omitempty"`
-	FieldsAppInfoLocalizations  []string `url:"fields[appInfoLocalizations],omitempty"`
-	FieldsAppCategories         []string `url:"fields[appCategories],omitempty"`
-	FieldsAgeRatingDeclarations []string `url:"fields[ageRatingDeclarations],omitempty"`
-	Limit                       int      `url:"limit,omitempty"`
-	Include                     []string `url:"include,omitempty"`
-	Cursor                      string   `url:"cursor,omitempty"`
+	FieldsAppInfos              []string     `url:"fields[appInfos],omitempty"`
+	FieldsApps                  []string     `url:"fields[apps],omitempty"`
+	FieldsAppInfoLocalizations  []string     `url:"fields[appInfoLocalizations],omitempty"`
+	FieldsAppCategories         []string     `url:"fields[appCategories],omitempty"`
+	FieldsAgeRatingDeclarations []string     `url:"fields[ageRatingDeclarations],omitempty"`
+	Limit                       int          `url:"limit,omitempty"`
+	Include                     []string     `url:"include,omitempty"`
+	Cursor                      string       `url:"cursor,omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // GetAgeRatingDeclarationForAppInfoQuery are query options for GetAgeRatingDeclarationForInfo
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appinfos_id_ageratingdeclaration
 type GetAgeRatingDeclarationForAppInfoQuery struct {
-	FieldsAgeRatingDeclarations []string `url:"fields[ageRatingDeclarations],omitempty"`
+	FieldsAgeRatingDeclarations []string     `url:"fields[ageRatingDeclarations],omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // GetAppInfo reads App Store information including your App Store state, age ratings, Brazil age rating, and kids' age band.
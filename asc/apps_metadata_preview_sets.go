@@ -41,6 +41,8 @@ type AppPreviewSet struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewset/attributes
 type AppPreviewSetAttributes struct {
 	PreviewType *PreviewType `json:"previewType,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPreviewSetRelationships defines model for AppPreviewSet.Relationships
@@ -49,6 +51,8 @@ type AppPreviewSetAttributes struct {
 type AppPreviewSetRelationships struct {
 	AppPreviews                 *PagedRelationship `json:"appPreviews,omitempty"`
 	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appPreviewSetCreateRequest defines model for AppPreviewSetCreateRequest.
@@ -106,29 +110,32 @@ type AppPreviewSetAppPreviewsLinkagesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_preview_set_information
 type GetAppPreviewSetQuery struct {
-	FieldsAppPreviews    []string `url:"fields[appPreviews],omitempty"`
-	FieldsAppPreviewSets []string `url:"fields[appPreviewSets],omitempty"`
-	Include              []string `url:"include,omitempty"`
-	LimitAppPreviews     int      `url:"limit[appPreviews],omitempty"`
+	FieldsAppPreviews    []string     `url:"fields[appPreviews],omitempty"`
+	FieldsAppPreviewSets []string     `url:"fields[appPreviewSets],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	LimitAppPreviews     int          `url:"limit[appPreviews],omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListAppPreviewsForSetQuery are query options for ListAppPreviewsForSet
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_previews_for_an_app_preview_set
 type ListAppPreviewsForSetQuery struct {
-	FieldsAppPreviewSets []string `url:"fields[appPreviewSets],omitempty"`
-	FieldsAppPreviews    []string `url:"fields[appPreviews],omitempty"`
-	Limit                int      `url:"limit,omitempty"`
-	Include              []string `url:"include,omitempty"`
-	Cursor               string   `url:"cursor,omitempty"`
+	FieldsAppPreviewSets []string     `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews    []string     `url:"fields[appPreviews],omitempty"`
+	Limit                int          `url:"limit,omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	Cursor               string       `url:"cursor,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListAppPreviewIDsForSetQuery are query options for ListAppPreviewIDsForSet
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_preview_ids_for_an_app_preview_set
 type ListAppPreviewIDsForSetQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // GetAppPreviewSet gets an app preview set including its display target, language, and the preview it contains.
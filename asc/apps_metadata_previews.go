@@ -87,6 +87,8 @@ type AppPreviewAttributes struct {
 	SourceFileChecksum   *string             `json:"sourceFileChecksum,omitempty"`
 	UploadOperations     []UploadOperation   `json:"uploadOperations,omitempty"`
 	VideoURL             *string             `json:"videoUrl,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPreviewRelationships defines model for AppPreview.Relationships
@@ -94,6 +96,8 @@ type AppPreviewAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreview/relationships
 type AppPreviewRelationships struct {
 	AppPreviewSet *Relationship `json:"appPreviewSet,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPreviewCreateRequest defines model for AppPreviewCreateRequest.
@@ -170,8 +174,9 @@ type ImageAsset struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_preview_information
 type GetAppPreviewQuery struct {
-	FieldsAppPreviews []string `url:"fields[appPreviews],omitempty"`
-	Include           []string `url:"include,omitempty"`
+	FieldsAppPreviews []string     `url:"fields[appPreviews],omitempty"`
+	Include           []string     `url:"include,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // GetAppPreview gets information about an app preview and its upload and processing status.
@@ -45,6 +45,8 @@ type RoutingAppCoverageAttributes struct {
 	FileSize           *int64              `json:"fileSize,omitempty"`
 	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
 	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // RoutingAppCoverageRelationships defines model for RoutingAppCoverage.Relationships
@@ -52,6 +54,8 @@ type RoutingAppCoverageAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/routingappcoverage/relationships
 type RoutingAppCoverageRelationships struct {
 	AppStoreVersion *Relationship `json:"appStoreVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // RoutingAppCoverageCreateRequest defines model for RoutingAppCoverageCreateRequest.
@@ -124,15 +128,17 @@ type AppMediaStateError struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_routing_app_coverage_information_of_an_app_store_version
 type GetRoutingAppCoverageForVersionQuery struct {
-	FieldsRoutingAppCoverages []string `url:"fields[routingAppCoverages],omitempty"`
+	FieldsRoutingAppCoverages []string     `url:"fields[routingAppCoverages],omitempty"`
+	Query                     QueryEncoder `url:"-"`
 }
 
 // GetRoutingAppCoverageQuery are query options for GetRoutingAppCoverage
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_routing_app_coverage_information
 type GetRoutingAppCoverageQuery struct {
-	FieldsRoutingAppCoverages []string `url:"fields[routingAppCoverages],omitempty"`
-	Include                   []string `url:"include,omitempty"`
+	FieldsRoutingAppCoverages []string     `url:"fields[routingAppCoverages],omitempty"`
+	Include                   []string     `url:"include,omitempty"`
+	Query                     QueryEncoder `url:"-"`
 }
 
 // GetRoutingAppCoverageForAppStoreVersion gets the routing app coverage file that is associated with a specific App Store version
@@ -103,6 +103,8 @@ type AppScreenshotSet struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotset/attributes
 type AppScreenshotSetAttributes struct {
 	ScreenshotDisplayType *ScreenshotDisplayType `json:"screenshotDisplayType,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppScreenshotSetRelationships defines model for AppScreenshotSet.Relationships
@@ -111,6 +113,8 @@ type AppScreenshotSetAttributes struct {
 type AppScreenshotSetRelationships struct {
 	AppScreenshots              *PagedRelationship `json:"appScreenshots,omitempty"`
 	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appScreenshotSetCreateRequest defines model for AppScreenshotSetCreateRequest.
@@ -170,28 +174,31 @@ type AppScreenshotSetAppScreenshotsLinkagesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_screenshot_set_information
 type GetAppScreenshotSetQuery struct {
-	FieldsAppScreenshots    []string `url:"fields[appScreenshots],omitempty"`
-	FieldsAppScreenshotSets []string `url:"fields[appScreenshotSets],omitempty"`
-	Include                 []string `url:"include,omitempty"`
-	LimitAppScreenshots     int      `url:"limit[appScreenshots],omitempty"`
+	FieldsAppScreenshots    []string     `url:"fields[appScreenshots],omitempty"`
+	FieldsAppScreenshotSets []string     `url:"fields[appScreenshotSets],omitempty"`
+	Include                 []string     `url:"include,omitempty"`
+	LimitAppScreenshots     int          `url:"limit[appScreenshots],omitempty"`
+	Query                   QueryEncoder `url:"-"`
 }
 
 // ListAppScreenshotsForSetQuery are query options for ListAppScreenshotsForSet
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshots_for_an_app_screenshot_set
 type ListAppScreenshotsForSetQuery struct {
-	FieldsAppScreenshotSets []string `url:"fields[appScreenshotSets],omitempty"`
-	FieldsAppScreenshots    []string `url:"fields[appScreenshots],omitempty"`
-	Limit                   int      `url:"limit,omitempty"`
-	Include                 []string `url:"include,omitempty"`
-	Cursor                  string   `url:"cursor,omitempty"`
+	FieldsAppScreenshotSets []string     `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots    []string     `url:"fields[appScreenshots],omitempty"`
+	Limit                   int          `url:"limit,omitempty"`
+	Include                 []string     `url:"include,omitempty"`
+	Cursor                  string       `url:"cursor,omitempty"`
+	Query                   QueryEncoder `url:"-"`
 }
 
 // ListAppScreenshotIDsForSetQuery are query options for ListAppScreenshotIDsForSet
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_screenshot_ids_for_an_app_screenshot_set
 type ListAppScreenshotIDsForSetQuery struct {
-	Limit int `url:"limit,omitempty"`
+	Limit int          `url:"limit,omitempty"`
+	Query QueryEncoder `url:"-"`
 }
 
 // GetAppScreenshotSet gets an app screenshot set including its display target, language, and the screenshot it contains.
@@ -48,6 +48,8 @@ type AppScreenshotAttributes struct {
 	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
 	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
 	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppScreenshotRelationships defines model for AppScreenshot.Relationships
@@ -55,6 +57,8 @@ type AppScreenshotAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshot/relationships
 type AppScreenshotRelationships struct {
 	AppScreenshotSet *Relationship `json:"appScreenshotSet,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppScreenshotCreateRequest defines model for AppScreenshotCreateRequest.
@@ -119,8 +123,9 @@ type AppScreenshotsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_screenshot_information
 type GetAppScreenshotQuery struct {
-	FieldsAppScreenshots []string `url:"fields[appScreenshots],omitempty"`
-	Include              []string `url:"include,omitempty"`
+	FieldsAppScreenshots []string     `url:"fields[appScreenshots],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // GetAppScreenshot gets information about an app screenshot and its upload and processing status.
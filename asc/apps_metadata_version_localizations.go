@@ -47,6 +47,8 @@ type AppStoreVersionLocalizationAttributes struct {
 	PromotionalText *string `json:"promotionalText,omitempty"`
 	SupportURL      *string `json:"supportUrl,omitempty"`
 	WhatsNew        *string `json:"whatsNew,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionLocalizationRelationships defines model for AppStoreVersionLocalization.Relationships
@@ -56,6 +58,8 @@ type AppStoreVersionLocalizationRelationships struct {
 	AppPreviewSets    *PagedRelationship `json:"appPreviewSets,omitempty"`
 	AppScreenshotSets *PagedRelationship `json:"appScreenshotSets,omitempty"`
 	AppStoreVersion   *Relationship      `json:"appStoreVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionLocalizationCreateRequest defines model for AppStoreVersionLocalizationCreateRequest.
@@ -78,6 +82,8 @@ type AppStoreVersionLocalizationCreateRequestAttributes struct {
 	PromotionalText *string `json:"promotionalText,omitempty"`
 	SupportURL      *string `json:"supportUrl,omitempty"`
 	WhatsNew        *string `json:"whatsNew,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionLocalizationCreateRequestRelationships are relationships for AppStoreVersionLocalizationCreateRequest
@@ -129,53 +135,59 @@ type AppStoreVersionLocalizationUpdateRequestAttributes struct {
 	PromotionalText *string `json:"promotionalText,omitempty"`
 	SupportURL      *string `json:"supportUrl,omitempty"`
 	WhatsNew        *string `json:"whatsNew,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // ListLocalizationsForAppStoreVersionQuery are query options for ListLocalizationsForAppStoreVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_localizations_for_an_app_store_version
 type ListLocalizationsForAppStoreVersionQuery struct {
-	FieldsAppStoreVersionLocalizations []string `url:"fields[appStoreVersionLocalizations],omitempty"`
-	Limit                              int      `url:"limit,omitempty"`
-	Cursor                             string   `url:"cursor,omitempty"`
+	FieldsAppStoreVersionLocalizations []string     `url:"fields[appStoreVersionLocalizations],omitempty"`
+	Limit                              int          `url:"limit,omitempty"`
+	Cursor                             string       `url:"cursor,omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // GetAppStoreVersionLocalizationQuery are query options for GetAppStoreVersionLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_localization_information
 type GetAppStoreVersionLocalizationQuery struct {
-	FieldsAppPreviewSets               []string `url:"fields[appPreviewSets],omitempty"`
-	FieldsAppScreenshotSets            []string `url:"fields[appScreenshotSets],omitempty"`
-	FieldsAppStoreVersionLocalizations []string `url:"fields[appStoreVersionLocalizations],omitempty"`
-	Include                            []string `url:"include,omitempty"`
-	LimitAppPreviewSets                int      `url:"limit[appPreviewSets],omitempty"`
-	LimitAppScreenshotSets             int      `url:"limit[appScreenshotSets],omitempty"`
+	FieldsAppPreviewSets               []string     `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets            []string     `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppStoreVersionLocalizations []string     `url:"fields[appStoreVersionLocalizations],omitempty"`
+	Include                            []string     `url:"include,omitempty"`
+	LimitAppPreviewSets                int          `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets             int          `url:"limit[appScreenshotSets],omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // ListAppScreenshotSetsForAppStoreVersionLocalizationQuery are query options for ListAppScreenshotSetsForAppStoreVersionLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_localization
 type ListAppScreenshotSetsForAppStoreVersionLocalizationQuery struct {
-	FieldsAppScreenshotSets            []string `url:"fields[appScreenshotSets],omitempty"`
-	FieldsAppScreenshots               []string `url:"fields[appScreenshots],omitempty"`
-	FieldsAppStoreVersionLocalizations []string `url:"fields[appStoreVersionLocalizations],omitempty"`
-	Limit                              int      `url:"limit,omitempty"`
-	Include                            []string `url:"include,omitempty"`
-	FilterScreenshotDisplayType        []string `url:"filter[screenshotDisplayType],omitempty"`
-	Cursor                             string   `url:"cursor,omitempty"`
+	FieldsAppScreenshotSets            []string     `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots               []string     `url:"fields[appScreenshots],omitempty"`
+	FieldsAppStoreVersionLocalizations []string     `url:"fields[appStoreVersionLocalizations],omitempty"`
+	Limit                              int          `url:"limit,omitempty"`
+	Include                            []string     `url:"include,omitempty"`
+	FilterScreenshotDisplayType        []string     `url:"filter[screenshotDisplayType],omitempty"`
+	Cursor                             string       `url:"cursor,omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // ListAppPreviewSetsForAppStoreVersionLocalizationQuery are query options for ListAppPreviewSetsForAppStoreVersionLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_localization
 type ListAppPreviewSetsForAppStoreVersionLocalizationQuery struct {
-	FieldsAppPreviewSets               []string `url:"fields[appPreviewSets],omitempty"`
-	FieldsAppPreviews                  []string `url:"fields[appPreviews],omitempty"`
-	FieldsAppStoreVersionLocalizations []string `url:"fields[appStoreVersionLocalizations],omitempty"`
-	Limit                              int      `url:"limit,omitempty"`
-	Include                            []string `url:"include,omitempty"`
-	FilterPreviewType                  []string `url:"filter[previewType],omitempty"`
-	Cursor                             string   `url:"cursor,omitempty"`
+	FieldsAppPreviewSets               []string     `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews                  []string     `url:"fields[appPreviews],omitempty"`
+	FieldsAppStoreVersionLocalizations []string     `url:"fields[appStoreVersionLocalizations],omitempty"`
+	Limit                              int          `url:"limit,omitempty"`
+	Include                            []string     `url:"include,omitempty"`
+	FilterPreviewType                  []string     `url:"filter[previewType],omitempty"`
+	Cursor                             string       `url:"cursor,omitempty"`
+	Query                              QueryEncoder `url:"-"`
 }
 
 // ListLocalizationsForAppStoreVersion gets a list of localized, version-level information about an app, for all locales.
@@ -87,6 +87,8 @@ type AppStoreVersionUpdateRequestAttributes struct {
 	ReleaseType         *string   `json:"releaseType,omitempty"`
 	UsesIDFA            *bool     `json:"usesIdfa,omitempty"`
 	VersionString       *string   `json:"versionString,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appStoreVersionUpdateRequestRelationships are relationships for AppStoreVersionUpdateRequest
@@ -126,6 +128,8 @@ type AgeRatingDeclarationAttributes struct {
 	ViolenceCartoonOrFantasy                    *string      `json:"violenceCartoonOrFantasy,omitempty"`
 	ViolenceRealistic                           *string      `json:"violenceRealistic,omitempty"`
 	ViolenceRealisticProlongedGraphicOrSadistic *string      `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersion defines model for AppStoreVersion.
@@ -152,6 +156,8 @@ type AppStoreVersionAttributes struct {
 	ReleaseType         *string               `json:"releaseType,omitempty"`
 	UsesIDFA            *bool                 `json:"usesIdfa,omitempty"`
 	VersionString       *string               `json:"versionString,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionRelationships defines model for AppStoreVersion.Relationships
@@ -166,6 +172,8 @@ type AppStoreVersionRelationships struct {
 	Build                        *Relationship      `json:"build,omitempty"`
 	IDFADeclaration              *Relationship      `json:"idfaDeclaration,omitempty"`
 	RoutingAppCoverage           *Relationship      `json:"routingAppCoverage,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionResponse defines model for AppStoreVersionResponse.
@@ -210,6 +218,8 @@ type AppStoreVersionCreateRequestAttributes struct {
 	ReleaseType         *string   `json:"releaseType,omitempty"`
 	UsesIDFA            *bool     `json:"usesIdfa,omitempty"`
 	VersionString       string    `json:"versionString"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreVersionCreateRequestRelationships are relationships for AppStoreVersionCreateRequest
@@ -232,38 +242,40 @@ type AppStoreVersionBuildLinkageResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_versions_for_an_app
 type ListAppStoreVersionsQuery struct {
-	FieldsApps                          []string `url:"fields[apps],omitempty"`
-	FieldsAppStoreVersionSubmissions    []string `url:"fields[appStoreVersionSubmissions],omitempty"`
-	FieldsBuilds                        []string `url:"fields[builds],omitempty"`
-	FieldsAppStoreVersions              []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsAppStoreReviewDetails         []string `url:"fields[appStoreReviewDetails],omitempty"`
-	FieldsAgeRatingDeclarations         []string `url:"fields[ageRatingDeclarations],omitempty"`
-	FieldsAppStoreVersionPhasedReleases []string `url:"fields[appStoreVersionPhasedReleases],omitempty"`
-	FieldsRoutingAppCoverages           []string `url:"fields[routingAppCoverages],omitempty"`
-	FieldsIDFADeclarations              []string `url:"fields[idfaDeclarations],omitempty"`
-	Limit                               int      `url:"limit,omitempty"`
-	Include                             []string `url:"include,omitempty"`
-	FilterID                            []string `url:"filter[id],omitempty"`
-	FilterVersionString                 []string `url:"filter[versionString],omitempty"`
-	FilterPlatform                      []string `url:"filter[platform],omitempty"`
-	FilterAppStoreState                 []string `url:"filter[appStoreState],omitempty"`
-	Cursor                              string   `url:"cursor,omitempty"`
+	FieldsApps                          []string     `url:"fields[apps],omitempty"`
+	FieldsAppStoreVersionSubmissions    []string     `url:"fields[appStoreVersionSubmissions],omitempty"`
+	FieldsBuilds                        []string     `url:"fields[builds],omitempty"`
+	FieldsAppStoreVersions              []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsAppStoreReviewDetails         []string     `url:"fields[appStoreReviewDetails],omitempty"`
+	FieldsAgeRatingDeclarations         []string     `url:"fields[ageRatingDeclarations],omitempty"`
+	FieldsAppStoreVersionPhasedReleases []string     `url:"fields[appStoreVersionPhasedReleases],omitempty"`
+	FieldsRoutingAppCoverages           []string     `url:"fields[routingAppCoverages],omitempty"`
+	FieldsIDFADeclarations              []string     `url:"fields[idfaDeclarations],omitempty"`
+	Limit                               int          `url:"limit,omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	FilterID                            []string     `url:"filter[id],omitempty"`
+	FilterVersionString                 []string     `url:"filter[versionString],omitempty"`
+	FilterPlatform                      []string     `url:"filter[platform],omitempty"`
+	FilterAppStoreState                 []string     `url:"filter[appStoreState],omitempty"`
+	Cursor                              string       `url:"cursor,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // GetAppStoreVersionQuery are query options for GetAppStoreVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_information
 type GetAppStoreVersionQuery struct {
-	FieldsAppStoreVersions              []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsAppStoreVersionSubmissions    []string `url:"fields[appStoreVersionSubmissions],omitempty"`
-	FieldsBuilds                        []string `url:"fields[builds],omitempty"`
-	FieldsAppStoreReviewDetails         []string `url:"fields[appStoreReviewDetails],omitempty"`
-	FieldsAppStoreVersionPhasedReleases []string `url:"fields[appStoreVersionPhasedReleases],omitempty"`
-	FieldsRoutingAppCoverages           []string `url:"fields[routingAppCoverages],omitempty"`
-	FieldsIDFADeclarations              []string `url:"fields[idfaDeclarations],omitempty"`
-	FieldsAppStoreVersionLocalizations  []string `url:"fields[appStoreVersionLocalizations],omitempty"`
-	Include                             []string `url:"include,omitempty"`
-	LimitAppStoreVersionLocalizations   int      `url:"limit[appStoreVersionLocalizations],omitempty"`
+	FieldsAppStoreVersions              []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsAppStoreVersionSubmissions    []string     `url:"fields[appStoreVersionSubmissions],omitempty"`
+	FieldsBuilds                        []string     `url:"fields[builds],omitempty"`
+	FieldsAppStoreReviewDetails         []string     `url:"fields[appStoreReviewDetails],omitempty"`
+	FieldsAppStoreVersionPhasedReleases []string     `url:"fields[appStoreVersionPhasedReleases],omitempty"`
+	FieldsRoutingAppCoverages           []string     `url:"fields[routingAppCoverages],omitempty"`
+	FieldsIDFADeclarations              []string     `url:"fields[idfaDeclarations],omitempty"`
+	FieldsAppStoreVersionLocalizations  []string     `url:"fields[appStoreVersionLocalizations],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	LimitAppStoreVersionLocalizations   int          `url:"limit[appStoreVersionLocalizations],omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // ListAppStoreVersionsForApp gets a list of all App Store versions of an app across all platforms.
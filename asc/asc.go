@@ -27,15 +27,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/google/go-querystring/query"
 )
 
@@ -54,6 +53,18 @@ type Client struct {
 	UserAgent string
 	httpDebug bool
 
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	logger      *slog.Logger
+	logConfig   logConfig
+	middleware  []Middleware
+	cache       *responseCache
+	strictMode  StrictMode
+
+	uploadClient *http.Client
+	plan         *Plan
+	audit        *AuditSink
+
 	common service
 
 	Apps              *AppsService
@@ -83,9 +94,11 @@ func NewClient(httpClient *http.Client) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{
-		client:    httpClient,
-		baseURL:   baseURL,
-		UserAgent: userAgent,
+		client:      httpClient,
+		baseURL:     baseURL,
+		UserAgent:   userAgent,
+		retryPolicy: DefaultRetryPolicy(),
+		logConfig:   defaultLogConfig(),
 	}
 
 	c.common.client = c
@@ -106,7 +119,9 @@ func NewClient(httpClient *http.Client) *Client {
 	return c
 }
 
-// SetHTTPDebug this enables global http request/response dumping for this API.
+// SetHTTPDebug this enables global http request/response dumping for this API. Requests are logged
+// to stdout at the Debug level with sensitive headers and fields redacted, unless a logger has been
+// set with SetLogger.
 func (c *Client) SetHTTPDebug(flag bool) {
 	c.httpDebug = flag
 }
@@ -117,6 +132,17 @@ type Response struct {
 	*http.Response
 
 	Rate Rate
+
+	// Attempts is the number of times the request was sent before this response was received,
+	// including the first attempt.
+	Attempts int
+
+	// Cached reports whether the response was read from the cache of the client rather than the API.
+	Cached bool
+
+	// DryRun reports whether the response was synthesized by a client in dry-run mode rather than
+	// received from the API.
+	DryRun bool
 }
 
 // Rate represents the rate limit for the current client.
@@ -218,7 +244,8 @@ func withContentType(typ string) requestOption {
 }
 
 // AddOptions adds the parameters in opt as URL query parameters to s.  opt
-// must be a struct whose fields may contain "url" tags.
+// must be url.Values, a QueryEncoder such as a Query, or a struct whose fields may
+// contain "url" tags.
 func appendingQueryOptions(s string, opt interface{}) (string, error) {
 	v := reflect.ValueOf(opt)
 	if v.Kind() == reflect.Ptr && v.IsNil() {
@@ -230,7 +257,17 @@ func appendingQueryOptions(s string, opt interface{}) (string, error) {
 		return s, err
 	}
 
-	qs, err := query.Values(opt)
+	var qs url.Values
+
+	switch opt := opt.(type) {
+	case url.Values:
+		qs = opt
+	case QueryEncoder:
+		qs, err = opt.Values()
+	default:
+		qs, err = query.Values(opt)
+	}
+
 	if err != nil {
 		return s, err
 	}
@@ -244,7 +281,7 @@ func appendingQueryOptions(s string, opt interface{}) (string, error) {
 func (c *Client) get(ctx context.Context, url string, query interface{}, v interface{}, options ...requestOption) (*Response, error) {
 	var err error
 	if query != nil {
-		url, err = appendingQueryOptions(url, query)
+		url, err = c.appendingQuery(url, query)
 		if err != nil {
 			return nil, err
 		}
@@ -309,12 +346,7 @@ func (c *Client) newRequest(ctx context.Context, method string, path string, bod
 		return nil, err
 	}
 
-	var u *url.URL
-	if rel.IsAbs() {
-		u = rel
-	} else {
-		u = c.baseURL.ResolveReference(rel)
-	}
+	u := c.resolve(rel)
 
 	buf := new(bytes.Buffer)
 
@@ -341,69 +373,149 @@ func (c *Client) newRequest(ctx context.Context, method string, path string, bod
 	return req, nil
 }
 
-func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	var resp *http.Response
+// send sends the request, retrying it according to the client's RetryPolicy, and decodes the response into v.
+func (c *Client) send(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	var (
+		resp     *http.Response
+		err      error
+		attempts int
+	)
+
+	if response, ok, err := c.cachedResponse(req, v); ok {
+		return response, err
+	}
+
+	logger := c.log()
+	limiter := c.limiter()
+	policy := c.retryPolicy
+	b := policy.backOff()
+	start := time.Now()
 
-	op := func() error {
-		if c.httpDebug {
-			if dump, err := httputil.DumpRequest(req, true); err == nil {
-				fmt.Printf("DEBUG request uri=%s\n%s\n", req.URL, dump) // nolint: forbidigo
+	for {
+		attempts++
+
+		if attempts > 1 {
+			if err := rewindBody(req); err != nil {
+				return nil, err
+			}
+		}
+
+		if limiter != nil {
+			if err := waitForLimiter(ctx, limiter, policy, start); err != nil {
+				return nil, err
 			}
 		}
 
-		var err error
+		c.logRequest(ctx, logger, req, attempts)
+
+		attemptStart := time.Now()
 		resp, err = c.client.Do(req) // nolint: bodyclose
+
+		c.logResponse(ctx, logger, req, resp, err, attempts, time.Since(attemptStart))
+
 		if err != nil {
 			select {
 			case <-ctx.Done():
-				return backoff.Permanent(ctx.Err())
+				return nil, ctx.Err()
 			default:
-				return backoff.Permanent(err)
 			}
 		}
 
-		if c.httpDebug {
-			if dump, err := httputil.DumpResponse(resp, true); err == nil {
-				fmt.Printf("DEBUG response uri=%s\n%s\n", req.URL, dump) // nolint: forbidigo
+		retry, minimum := policy.shouldRetry(req, resp, err)
+
+		var (
+			delay time.Duration
+			ok    bool
+		)
+
+		if retry {
+			delay, ok = policy.retryDelay(b, attempts, start, minimum)
+		}
+
+		if limiter != nil && resp != nil {
+			limiter.Update(parseRate(resp))
+
+			if resp.StatusCode == http.StatusTooManyRequests {
+				// Hold back the other requests for as long as this one waits to be retried.
+				limiter.exceed(max(minimum, delay))
 			}
 		}
 
-		return nil
-	}
+		if !ok {
+			break
+		}
+
+		c.logRetry(ctx, logger, req, attempts, delay)
+
+		discardBody(resp)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
 
-	notify := func(err error, delay time.Duration) {
-		if c.httpDebug {
-			fmt.Printf("DEBUG error %v, retry in %v\n", err, delay) // nolint: forbidigo
+			return nil, ctx.Err()
+		case <-timer.C:
 		}
 	}
 
-	err := backoff.RetryNotify(op, backoff.NewExponentialBackOff(), notify)
-
-	if resp == nil {
+	if err != nil {
 		return nil, err
 	}
 
 	defer closeDesc(resp.Body)
 
 	response := newResponse(resp)
+	response.Attempts = attempts
+
+	if err := checkResponse(response); err != nil {
+		if erro, ok := err.(*ErrorResponse); ok {
+			c.logErrorResponse(ctx, logger, req, erro)
+		}
 
-	if err != nil {
 		return response, err
 	}
 
-	if err := checkResponse(response); err != nil {
+	if c.cache == nil {
+		return response, decodeBody(resp.Body, v)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
 		return response, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-		}
+	c.cacheResponse(req, response, body)
+
+	return response, decodeBody(bytes.NewReader(body), v)
+}
+
+// waitForLimiter waits until limiter permits a request to be sent, giving up once the retries of
+// the request started at start would have run out of time.
+func waitForLimiter(ctx context.Context, limiter *RateLimiter, policy RetryPolicy, start time.Time) error {
+	if policy.MaxElapsedTime > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithDeadline(ctx, start.Add(policy.MaxElapsedTime))
+		defer cancel()
+	}
+
+	return limiter.Wait(ctx)
+}
+
+// decodeBody decodes a response body into v. If v is an io.Writer, the body is copied to it instead.
+func decodeBody(body io.Reader, v interface{}) error {
+	if v == nil {
+		return nil
+	}
+
+	if w, ok := v.(io.Writer); ok {
+		_, err := io.Copy(w, body)
+
+		return err
 	}
 
-	return response, err
+	return json.NewDecoder(body).Decode(v)
 }
 
 func newResponse(r *http.Response) *Response {
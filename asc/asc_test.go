@@ -195,7 +195,7 @@ func TestDelete(t *testing.T) {
 func TestCheckGoodResponse(t *testing.T) {
 	t.Parallel()
 
-	resp := &Response{&http.Response{StatusCode: 200}, Rate{}}
+	resp := &Response{Response: &http.Response{StatusCode: 200}}
 	err := checkResponse(resp)
 	assert.NoError(t, err)
 }
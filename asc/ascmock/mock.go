@@ -0,0 +1,102 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package ascmock provides mocks of the services of package asc, to test code that depends on the
+// interfaces of asc.Services without a server.
+//
+// Each mock has a field per method of its service, such as AppsMock.GetAppFunc, that stubs the
+// method. Calling a method that isn't stubbed returns ErrNotStubbed, and every call is recorded:
+//
+//	mocks := ascmock.New()
+//	mocks.Apps.GetAppFunc = func(ctx context.Context, id string, params *asc.GetAppQuery) (*asc.AppResponse, *asc.Response, error) {
+//		return &asc.AppResponse{Data: asc.App{ID: id}}, nil, nil
+//	}
+//
+//	err := Release(ctx, mocks.Services(), "1")
+//	calls := mocks.Apps.CallsTo("GetApp")
+//
+// The mocks are generated from package asc with go generate, so that they cover every method.
+package ascmock
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotStubbed is returned by the methods of a mock whose function field is nil.
+var ErrNotStubbed = errors.New("method not stubbed")
+
+// Call is a call to a method of a mock.
+type Call struct {
+	// Method is the name of the method, such as "GetApp".
+	Method string
+	// Args holds the arguments of the call, including its context.
+	Args []interface{}
+}
+
+// Recorder records the calls to the methods of a mock. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the mock, in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to the given method of the mock, in order.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the calls recorded so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func notStubbed(mock string, method string) error {
+	return fmt.Errorf("%s.%s: %w", mock, method, ErrNotStubbed)
+}
@@ -0,0 +1,129 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ascmock_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/castbox/asc-go/asc"
+	"github.com/castbox/asc-go/asc/ascmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// latestBuild is code under test that depends on the services of a client.
+func latestBuild(ctx context.Context, services asc.Services, appID string) (string, error) {
+	app, _, err := services.Apps.GetApp(ctx, appID, nil)
+	if err != nil {
+		return "", err
+	}
+
+	builds, _, err := services.Builds.ListBuildsForApp(ctx, app.Data.ID, &asc.ListBuildsForAppQuery{Limit: 1})
+	if err != nil {
+		return "", err
+	}
+
+	if len(builds.Data) == 0 {
+		return "", errors.New("no builds")
+	}
+
+	return builds.Data[0].ID, nil
+}
+
+func TestMocks(t *testing.T) {
+	t.Parallel()
+
+	mocks := ascmock.New()
+	mocks.Apps.GetAppFunc = func(ctx context.Context, id string, params *asc.GetAppQuery) (*asc.AppResponse, *asc.Response, error) {
+		return &asc.AppResponse{Data: asc.App{ID: id}}, nil, nil
+	}
+	mocks.Builds.ListBuildsForAppFunc = func(ctx context.Context, id string, params *asc.ListBuildsForAppQuery) (*asc.BuildsResponse, *asc.Response, error) {
+		return &asc.BuildsResponse{Data: []asc.Build{{ID: "b1"}}}, nil, nil
+	}
+
+	id, err := latestBuild(context.Background(), mocks.Services(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "b1", id)
+
+	calls := mocks.Builds.CallsTo("ListBuildsForApp")
+	if assert.Len(t, calls, 1) {
+		assert.Equal(t, "1", calls[0].Args[1])
+		assert.Equal(t, &asc.ListBuildsForAppQuery{Limit: 1}, calls[0].Args[2])
+	}
+
+	assert.Len(t, mocks.Apps.Calls(), 1)
+	assert.Empty(t, mocks.Builds.CallsTo("GetBuild"))
+
+	mocks.Apps.Reset()
+	assert.Empty(t, mocks.Apps.Calls())
+}
+
+func TestMockNotStubbed(t *testing.T) {
+	t.Parallel()
+
+	mocks := ascmock.New()
+
+	res, resp, err := mocks.Apps.GetApp(context.Background(), "1", nil)
+	assert.ErrorIs(t, err, ascmock.ErrNotStubbed)
+	assert.EqualError(t, err, "AppsMock.GetApp: method not stubbed")
+	assert.Nil(t, res)
+	assert.Nil(t, resp)
+
+	_, err = mocks.Users.RemoveUser(context.Background(), "1")
+	assert.ErrorIs(t, err, ascmock.ErrNotStubbed)
+
+	assert.Len(t, mocks.Apps.CallsTo("GetApp"), 1)
+}
+
+func TestMockConcurrentCalls(t *testing.T) {
+	t.Parallel()
+
+	mock := &ascmock.UsersMock{}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _ = mock.RemoveUser(context.Background(), "1")
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Len(t, mock.CallsTo("RemoveUser"), 10)
+}
+
+func TestClientServices(t *testing.T) {
+	t.Parallel()
+
+	client := asc.NewClient(nil)
+	services := client.Services()
+
+	assert.Same(t, client.Apps, services.Apps)
+	assert.Same(t, client.TestFlight, services.TestFlight)
+	assert.Same(t, client.CustomerReviews, services.CustomerReviews)
+}
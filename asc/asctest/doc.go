@@ -0,0 +1,57 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/*
+Package asctest provides a fake App Store Connect API server for testing code that uses the asc
+package.
+
+The Server keeps every resource in memory, and serves the JSON:API endpoints the asc services call
+for any resource type: listing with filters, sorting, sparse fieldsets, include and cursor paging,
+reading, creating, updating and deleting resources, reading related resources, and managing
+relationships. It also reserves upload operations for assets such as screenshots, stores the parts
+uploaded to them, and processes the asset when it is committed.
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	appID := server.Add("apps", asc.AppAttributes{BundleID: asc.String("com.example.app")}, nil)
+	server.Add("builds", asc.BuildAttributes{Version: asc.String("42")}, map[string]asctest.Relationship{
+		"app": asctest.ToOne("apps", appID),
+	})
+
+	client := server.NewClient()
+	builds, _, err := client.Builds.ListBuildsForApp(ctx, appID, nil)
+
+Resources are related to each other through the relationships they were created with. Related
+resources are also found the other way around: the builds of an app are the builds whose "app"
+relationship is that app, so "apps/1/builds" lists them without the app declaring them.
+
+Faults and rate limits can be injected to test how code handles errors:
+
+	server.Inject(asctest.Fault{Method: "POST", Path: "betaGroups/1/relationships/builds", Status: 409})
+	server.SetRateLimit(10)
+
+A Recorder records the exchanges of a client with the live API into a cassette file, scrubbed of
+tokens and personal data, and replays them later so that tests run offline:
+
+	recorder, err := asctest.NewRecorder("testdata/apps.json", asctest.ModeReplay)
+	client := asc.NewClient(recorder.Client())
+*/
+package asctest
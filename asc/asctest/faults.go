@@ -0,0 +1,188 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/castbox/asc-go/asc"
+)
+
+// Fault makes the server fail the requests that match it.
+type Fault struct {
+	// Method is the HTTP method of the requests to fail. Empty matches every method.
+	Method string
+	// Path is the path of the requests to fail relative to the API version, such as "apps/1".
+	// A "*" segment matches any segment, as in "apps/*/builds". Empty matches every path.
+	Path string
+	// Status is the status of the error response. It defaults to 500 Internal Server Error.
+	Status int
+	// Errors are the errors of the error response. They default to a single error whose code
+	// matches the status, such as NOT_FOUND for 404 Not Found.
+	Errors []asc.ErrorResponseError
+	// Times is the number of requests to fail. Zero fails every matching request.
+	Times int
+	// Delay delays the response, to test timeouts.
+	Delay time.Duration
+	// RetryAfter sets the Retry-After header of the response, in seconds.
+	RetryAfter int
+
+	failed int
+}
+
+// Inject makes the server fail the requests that match the fault, until the fault has failed
+// the given number of Times or is cleared. The first matching fault wins.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if fault.Status == 0 {
+		fault.Status = http.StatusInternalServerError
+	}
+
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) matchFault(method string, path string) *Fault {
+	for i, fault := range s.faults {
+		if !fault.matches(method, path) {
+			continue
+		}
+
+		fault.failed++
+		if fault.Times > 0 && fault.failed >= fault.Times {
+			s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+		}
+
+		return fault
+	}
+
+	return nil
+}
+
+func (f *Fault) matches(method string, path string) bool {
+	if f.Method != "" && !strings.EqualFold(f.Method, method) {
+		return false
+	}
+
+	if f.Path == "" {
+		return true
+	}
+
+	pattern := strings.Split(strings.Trim(f.Path, "/"), "/")
+	segments := strings.Split(path, "/")
+
+	if len(pattern) != len(segments) {
+		return false
+	}
+
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	time.Sleep(f.Delay)
+
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", fmt.Sprint(f.RetryAfter))
+	}
+
+	errs := f.Errors
+	if len(errs) == 0 {
+		errs = []asc.ErrorResponseError{{
+			Code:   statusCode(f.Status),
+			Status: fmt.Sprint(f.Status),
+			Title:  http.StatusText(f.Status),
+			Detail: "This error was injected by the test server.",
+		}}
+	}
+
+	writeErrors(w, f.Status, errs)
+}
+
+// statusCode returns the error code the API uses for a status.
+func statusCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "PARAMETER_ERROR"
+	case http.StatusUnauthorized:
+		return "NOT_AUTHORIZED"
+	case http.StatusForbidden:
+		return "FORBIDDEN_ERROR"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "ENTITY_ERROR"
+	case http.StatusTooManyRequests:
+		return "RATE_LIMIT_EXCEEDED"
+	default:
+		return "UNEXPECTED_ERROR"
+	}
+}
+
+// rateLimit counts the requests made against the hourly limit of the API key.
+type rateLimit struct {
+	limit     int
+	remaining int
+}
+
+// SetRateLimit limits the number of requests the server accepts to limit, reported in the
+// X-Rate-Limit header of each response. Once the limit is reached, requests fail with
+// 429 Too Many Requests until SetRateLimit is called again. A limit of 0 removes the limit.
+func (s *Server) SetRateLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rate = rateLimit{limit: limit, remaining: limit}
+}
+
+// take counts a request against the limit, sets the rate limit header, and reports whether the
+// request exceeds the limit.
+func (r *rateLimit) take(header http.Header) bool {
+	if r.limit == 0 {
+		return false
+	}
+
+	limited := r.remaining == 0
+	if !limited {
+		r.remaining--
+	}
+
+	header.Set("X-Rate-Limit", fmt.Sprintf("user-hour-lim:%d;user-hour-rem:%d;", r.limit, r.remaining))
+
+	return limited
+}
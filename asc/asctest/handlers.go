@@ -0,0 +1,547 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/castbox/asc-go/asc"
+)
+
+// entity is a resource object in the body of a request.
+type entity struct {
+	Type          string                        `json:"type"`
+	ID            string                        `json:"id"`
+	Attributes    map[string]interface{}        `json:"attributes"`
+	Relationships map[string]relationshipObject `json:"relationships"`
+}
+
+type relationshipObject struct {
+	Data json.RawMessage `json:"data"`
+}
+
+func (o relationshipObject) relationship() (Relationship, error) {
+	data := bytes.TrimSpace(o.Data)
+
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+		return Relationship{}, nil
+	case data[0] == '[':
+		rel := Relationship{Many: true}
+
+		return rel, json.Unmarshal(data, &rel.Data)
+	default:
+		var one asc.RelationshipData
+		if err := json.Unmarshal(data, &one); err != nil {
+			return Relationship{}, err
+		}
+
+		return Relationship{Data: []asc.RelationshipData{one}}, nil
+	}
+}
+
+type requestDocument struct {
+	Data     entity   `json:"data"`
+	Included []entity `json:"included"`
+}
+
+func unprocessable(err error) *apiError {
+	return &apiError{
+		status: http.StatusUnprocessableEntity,
+		code:   "ENTITY_UNPROCESSABLE",
+		title:  "The request entity is not valid JSON:API",
+		detail: err.Error(),
+	}
+}
+
+func (s *Server) list(ctx *requestContext, typ string) (int, interface{}, *apiError) {
+	q, err := parseQuery(ctx.query)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return http.StatusOK, s.pagedDocument(ctx, q, s.resources[typ]), nil
+}
+
+func (s *Server) get(ctx *requestContext, typ string, id string) (int, interface{}, *apiError) {
+	q, err := parseQuery(ctx.query)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	res := s.find(typ, id)
+	if res == nil {
+		return 0, nil, notFound(typ, id)
+	}
+
+	return http.StatusOK, s.document(ctx, q, res), nil
+}
+
+func (s *Server) getRelated(ctx *requestContext, typ string, id string, name string) (int, interface{}, *apiError) {
+	q, err := parseQuery(ctx.query)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	res := s.find(typ, id)
+	if res == nil {
+		return 0, nil, notFound(typ, id)
+	}
+
+	related, many := s.related(res, name)
+	if many {
+		return http.StatusOK, s.pagedDocument(ctx, q, related), nil
+	}
+
+	if len(related) == 0 {
+		return http.StatusOK, map[string]interface{}{
+			"data":  nil,
+			"links": map[string]string{"self": ctx.link("%s/%s/%s", typ, id, name)},
+		}, nil
+	}
+
+	return http.StatusOK, s.document(ctx, q, related[0]), nil
+}
+
+func (s *Server) create(ctx *requestContext, typ string) (int, interface{}, *apiError) {
+	var doc requestDocument
+	if err := json.Unmarshal(ctx.body, &doc); err != nil {
+		return 0, nil, unprocessable(err)
+	}
+
+	if doc.Data.Type != typ {
+		return 0, nil, invalidEntity("ENTITY_ERROR.TYPE_MISMATCH", "/data/type", fmt.Sprintf("The resource type '%s' does not match the path '%s'.", doc.Data.Type, typ))
+	}
+
+	doc.Data.ID = ""
+
+	created, err := s.store(doc)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	res := created[0]
+	s.reserveUpload(res)
+
+	return http.StatusCreated, s.document(ctx, &query{}, res), nil
+}
+
+func (s *Server) update(ctx *requestContext, typ string, id string) (int, interface{}, *apiError) {
+	res := s.find(typ, id)
+	if res == nil {
+		return 0, nil, notFound(typ, id)
+	}
+
+	var doc requestDocument
+	if err := json.Unmarshal(ctx.body, &doc); err != nil {
+		return 0, nil, unprocessable(err)
+	}
+
+	if doc.Data.Type != typ || doc.Data.ID != id {
+		return 0, nil, invalidEntity("ENTITY_ERROR.ID_MISMATCH", "/data/id", fmt.Sprintf("The resource '%s' '%s' does not match the path.", doc.Data.Type, doc.Data.ID))
+	}
+
+	if _, err := s.store(doc); err != nil {
+		return 0, nil, err
+	}
+
+	s.commitUpload(res)
+
+	return http.StatusOK, s.document(ctx, &query{}, res), nil
+}
+
+func (s *Server) delete(typ string, id string) (int, interface{}, *apiError) {
+	if !s.remove(typ, id) {
+		return 0, nil, notFound(typ, id)
+	}
+
+	return http.StatusNoContent, nil, nil
+}
+
+// store creates or updates the resources of a request document, and returns them. Resources with
+// no ID are created, and the local IDs of included resources, such as "${new-price}", are replaced
+// by the IDs of the resources created for them.
+func (s *Server) store(doc requestDocument) ([]*Resource, *apiError) {
+	entities := append([]entity{doc.Data}, doc.Included...)
+	ids := map[string]string{}
+	resources := make([]*Resource, len(entities))
+
+	for i, e := range entities {
+		if existing := s.find(e.Type, e.ID); existing != nil {
+			resources[i] = existing
+
+			continue
+		}
+
+		resources[i] = &Resource{Type: e.Type, ID: s.newID(), Attributes: map[string]interface{}{}, Relationships: map[string]Relationship{}}
+		if e.ID != "" {
+			ids[e.Type+"/"+e.ID] = resources[i].ID
+		}
+	}
+
+	pending := map[string]bool{}
+	for _, res := range resources {
+		pending[res.Type+"/"+res.ID] = true
+	}
+
+	updates := make([]map[string]Relationship, len(entities))
+
+	for i, e := range entities {
+		pointer := "/data"
+		if i > 0 {
+			pointer = fmt.Sprintf("/included/%d", i-1)
+		}
+
+		updates[i] = map[string]Relationship{}
+
+		for name, obj := range e.Relationships {
+			rel, err := obj.relationship()
+			if err != nil {
+				return nil, unprocessable(err)
+			}
+
+			for j, data := range rel.Data {
+				if id, ok := ids[data.Type+"/"+data.ID]; ok {
+					rel.Data[j].ID = id
+				}
+
+				if s.find(data.Type, rel.Data[j].ID) == nil && !pending[data.Type+"/"+rel.Data[j].ID] {
+					return nil, invalidEntity("ENTITY_ERROR.RELATIONSHIP.INVALID", pointer+"/relationships/"+name,
+						fmt.Sprintf("There is no resource of type '%s' with id '%s'", data.Type, data.ID))
+				}
+			}
+
+			updates[i][name] = rel
+		}
+	}
+
+	for i, e := range entities {
+		res := resources[i]
+
+		for key, value := range e.Attributes {
+			if value == nil {
+				delete(res.Attributes, key)
+			} else {
+				res.Attributes[key] = value
+			}
+		}
+
+		for name, rel := range updates[i] {
+			res.Relationships[name] = rel
+		}
+
+		if s.find(res.Type, res.ID) == nil {
+			s.insert(res)
+		}
+	}
+
+	return resources, nil
+}
+
+func (s *Server) serveRelationship(ctx *requestContext, method string, typ string, id string, name string) (int, interface{}, *apiError) {
+	res := s.find(typ, id)
+	if res == nil {
+		return 0, nil, notFound(typ, id)
+	}
+
+	related, many := s.related(res, name)
+
+	if method == http.MethodGet {
+		return http.StatusOK, map[string]interface{}{
+			"data":  linkage(related, many),
+			"links": map[string]string{"self": ctx.link("%s/%s/relationships/%s", typ, id, name)},
+		}, nil
+	}
+
+	var body relationshipObject
+	if err := json.Unmarshal(ctx.body, &body); err != nil {
+		return 0, nil, unprocessable(err)
+	}
+
+	rel, err := body.relationship()
+	if err != nil {
+		return 0, nil, unprocessable(err)
+	}
+
+	for _, data := range rel.Data {
+		if s.find(data.Type, data.ID) == nil {
+			return 0, nil, invalidEntity("ENTITY_ERROR.RELATIONSHIP.INVALID", "/data",
+				fmt.Sprintf("There is no resource of type '%s' with id '%s'", data.Type, data.ID))
+		}
+	}
+
+	current := Relationship{Many: many, Data: linkageData(related)}
+
+	switch method {
+	case http.MethodPatch:
+		current = rel
+	case http.MethodPost:
+		for _, data := range rel.Data {
+			if !current.contains(data.Type, data.ID) {
+				current.Data = append(current.Data, data)
+			}
+		}
+	case http.MethodDelete:
+		kept := current.Data[:0]
+
+		for _, data := range current.Data {
+			if rel.contains(data.Type, data.ID) {
+				s.unlink(data, res)
+			} else {
+				kept = append(kept, data)
+			}
+		}
+
+		current.Data = kept
+	default:
+		return 0, nil, unknownPath(method)
+	}
+
+	res.Relationships[name] = current
+
+	return http.StatusNoContent, nil, nil
+}
+
+// unlink removes the relationships of the resource identified by data to res.
+func (s *Server) unlink(data asc.RelationshipData, res *Resource) {
+	target := s.find(data.Type, data.ID)
+	if target == nil {
+		return
+	}
+
+	for name, rel := range target.Relationships {
+		kept := rel.Data[:0]
+
+		for _, d := range rel.Data {
+			if d.Type != res.Type || d.ID != res.ID {
+				kept = append(kept, d)
+			}
+		}
+
+		rel.Data = kept
+		target.Relationships[name] = rel
+	}
+}
+
+func linkageData(resources []*Resource) []asc.RelationshipData {
+	data := make([]asc.RelationshipData, 0, len(resources))
+	for _, r := range resources {
+		data = append(data, asc.RelationshipData{Type: r.Type, ID: r.ID})
+	}
+
+	return data
+}
+
+func linkage(resources []*Resource, many bool) interface{} {
+	data := linkageData(resources)
+	if many {
+		return data
+	}
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	return data[0]
+}
+
+// document returns the response document of a single resource.
+func (s *Server) document(ctx *requestContext, q *query, res *Resource) map[string]interface{} {
+	doc := map[string]interface{}{
+		"data":  s.render(ctx, q, res),
+		"links": map[string]string{"self": ctx.link("%s/%s", res.Type, res.ID)},
+	}
+
+	if included := s.included(ctx, q, []*Resource{res}); len(included) > 0 {
+		doc["included"] = included
+	}
+
+	return doc
+}
+
+// pagedDocument filters, sorts and pages the resources, and returns the response document of the
+// requested page.
+func (s *Server) pagedDocument(ctx *requestContext, q *query, resources []*Resource) map[string]interface{} {
+	var matched []*Resource
+
+	for _, res := range resources {
+		if s.matches(res, q.filters) {
+			matched = append(matched, res)
+		}
+	}
+
+	sortResources(matched, q.sort)
+
+	start := q.offset
+	if start > len(matched) {
+		start = len(matched)
+	}
+
+	end := start + q.limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	page := matched[start:end]
+	data := make([]interface{}, 0, len(page))
+
+	for _, res := range page {
+		data = append(data, s.render(ctx, q, res))
+	}
+
+	links := map[string]string{
+		"self":  ctx.pageLink(-1),
+		"first": ctx.pageLink(0),
+	}
+
+	if end < len(matched) {
+		links["next"] = ctx.pageLink(end)
+	}
+
+	doc := map[string]interface{}{
+		"data":  data,
+		"links": links,
+		"meta": map[string]interface{}{
+			"paging": map[string]int{"total": len(matched), "limit": q.limit},
+		},
+	}
+
+	if included := s.included(ctx, q, page); len(included) > 0 {
+		doc["included"] = included
+	}
+
+	return doc
+}
+
+// pageLink returns the URL of the request with the cursor of the page starting at offset. A
+// negative offset keeps the cursor of the request.
+func (c *requestContext) pageLink(offset int) string {
+	u := *c.url
+	values := u.Query()
+
+	switch {
+	case offset == 0:
+		values.Del("cursor")
+	case offset > 0:
+		values.Set("cursor", encodeCursor(offset))
+	}
+
+	u.RawQuery = values.Encode()
+
+	return c.root + u.RequestURI()
+}
+
+// render returns the resource object of res, restricted to the sparse fieldset of its type.
+// Relationships have links, and the linkage of the relationships that are included.
+func (s *Server) render(ctx *requestContext, q *query, res *Resource) map[string]interface{} {
+	attributes := map[string]interface{}{}
+
+	for key, value := range res.Attributes {
+		if q.selects(res.Type, key) {
+			attributes[key] = value
+		}
+	}
+
+	names := map[string]bool{}
+	for name := range res.Relationships {
+		names[name] = true
+	}
+
+	for _, include := range q.include {
+		name, _, _ := strings.Cut(include, ".")
+		names[name] = true
+	}
+
+	relationships := map[string]interface{}{}
+
+	for name := range names {
+		if !q.selects(res.Type, name) {
+			continue
+		}
+
+		rel := map[string]interface{}{
+			"links": map[string]string{
+				"self":    ctx.link("%s/%s/relationships/%s", res.Type, res.ID, name),
+				"related": ctx.link("%s/%s/%s", res.Type, res.ID, name),
+			},
+		}
+
+		if q.includes(name) {
+			related, many := s.related(res, name)
+			rel["data"] = linkage(related, many)
+		}
+
+		relationships[name] = rel
+	}
+
+	obj := map[string]interface{}{
+		"type":       res.Type,
+		"id":         res.ID,
+		"attributes": attributes,
+		"links":      map[string]string{"self": ctx.link("%s/%s", res.Type, res.ID)},
+	}
+
+	if len(relationships) > 0 {
+		obj["relationships"] = relationships
+	}
+
+	return obj
+}
+
+// included returns the resource objects of the resources included with the primary resources,
+// following include paths such as "appStoreVersions.build".
+func (s *Server) included(ctx *requestContext, q *query, primary []*Resource) []interface{} {
+	seen := map[string]bool{}
+	for _, res := range primary {
+		seen[res.Type+"/"+res.ID] = true
+	}
+
+	var included []interface{}
+
+	for _, include := range q.include {
+		current := primary
+
+		for _, name := range strings.Split(include, ".") {
+			var next []*Resource
+
+			for _, res := range current {
+				related, _ := s.related(res, name)
+				next = append(next, related...)
+			}
+
+			for _, res := range next {
+				if key := res.Type + "/" + res.ID; !seen[key] {
+					seen[key] = true
+
+					included = append(included, s.render(ctx, q, res))
+				}
+			}
+
+			current = next
+		}
+	}
+
+	return included
+}
@@ -0,0 +1,273 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+// query holds the JSON:API query parameters of a request.
+type query struct {
+	include []string
+	fields  map[string][]string
+	filters map[string][]string
+	sort    []string
+	limit   int
+	offset  int
+}
+
+func parseQuery(values url.Values) (*query, *apiError) {
+	q := &query{
+		fields:  map[string][]string{},
+		filters: map[string][]string{},
+		limit:   defaultLimit,
+	}
+
+	for key, vals := range values {
+		list := splitValues(vals)
+
+		switch {
+		case key == "include":
+			q.include = list
+		case key == "sort":
+			q.sort = list
+		case key == "limit":
+			limit, err := strconv.Atoi(vals[0])
+			if err != nil || limit < 1 || limit > maxLimit {
+				return nil, invalidParameter(key, fmt.Sprintf("The limit must be between 1 and %d.", maxLimit))
+			}
+
+			q.limit = limit
+		case key == "cursor":
+			offset, ok := decodeCursor(vals[0])
+			if !ok {
+				return nil, invalidParameter(key, "The cursor is invalid.")
+			}
+
+			q.offset = offset
+		case strings.HasPrefix(key, "fields[") && strings.HasSuffix(key, "]"):
+			q.fields[key[len("fields["):len(key)-1]] = list
+		case strings.HasPrefix(key, "filter[") && strings.HasSuffix(key, "]"):
+			q.filters[key[len("filter["):len(key)-1]] = list
+		}
+	}
+
+	return q, nil
+}
+
+func splitValues(vals []string) []string {
+	var list []string
+
+	for _, v := range vals {
+		for _, item := range strings.Split(v, ",") {
+			if item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+
+	return list
+}
+
+func (q *query) includes(name string) bool {
+	for _, include := range q.include {
+		if include == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selects reports whether the field of a resource of the given type is in its sparse fieldset.
+func (q *query) selects(typ string, field string) bool {
+	fields, ok := q.fields[typ]
+	if !ok {
+		return true
+	}
+
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the resource matches every filter. A filter matches the ID of the
+// resource, an attribute, or the IDs of a relationship.
+func (s *Server) matches(res *Resource, filters map[string][]string) bool {
+	for name, values := range filters {
+		var candidates []string
+
+		switch {
+		case name == "id":
+			candidates = []string{res.ID}
+		case res.Attributes[name] != nil:
+			candidates = attributeStrings(res.Attributes[name])
+		default:
+			related, _ := s.related(res, name)
+			for _, r := range related {
+				candidates = append(candidates, r.ID)
+			}
+		}
+
+		if !anyEqual(candidates, values) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func attributeStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case []interface{}:
+		var list []string
+		for _, item := range v {
+			list = append(list, attributeStrings(item)...)
+		}
+
+		return list
+	case string:
+		return []string{v}
+	case map[string]interface{}:
+		b, _ := json.Marshal(v)
+
+		return []string{string(b)}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func anyEqual(candidates []string, values []string) bool {
+	for _, c := range candidates {
+		for _, v := range values {
+			if c == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sortResources sorts resources by the given fields, such as "name" or "-uploadedDate" for a
+// descending order.
+func sortResources(resources []*Resource, fields []string) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		for _, field := range fields {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+
+			cmp := compareField(resources[i], resources[j], field)
+			if cmp == 0 {
+				continue
+			}
+
+			return (cmp < 0) != desc
+		}
+
+		return false
+	})
+}
+
+func compareField(a *Resource, b *Resource, field string) int {
+	if field == "id" {
+		return compareValues(a.ID, b.ID)
+	}
+
+	return compareValues(a.Attributes[field], b.Attributes[field])
+}
+
+func compareValues(a interface{}, b interface{}) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if x, ok := a.(float64); ok {
+		if y, ok := b.(float64); ok {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	if x, err := strconv.Atoi(fmt.Sprint(a)); err == nil {
+		if y, err := strconv.Atoi(fmt.Sprint(b)); err == nil {
+			return compareValues(float64(x), float64(y))
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+type cursor struct {
+	Offset string `json:"offset"`
+}
+
+// encodeCursor returns a cursor for the page starting at offset, in the format used by the API.
+func encodeCursor(offset int) string {
+	b, _ := json.Marshal(cursor{Offset: strconv.Itoa(offset)})
+
+	return base64.RawStdEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (int, bool) {
+	b, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return 0, false
+	}
+
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return 0, false
+	}
+
+	offset, err := strconv.Atoi(c.Offset)
+	if err != nil || offset < 0 {
+		return 0, false
+	}
+
+	return offset, true
+}
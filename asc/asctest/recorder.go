@@ -0,0 +1,526 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects whether a Recorder records or replays exchanges with the API.
+type Mode int
+
+const (
+	// ModeReplay serves requests from the cassette, and fails the requests it has no recording for.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the API and records them in the cassette.
+	ModeRecord
+)
+
+// ErrNoInteraction happens when a Recorder replaying a cassette receives a request that was not
+// recorded.
+var ErrNoInteraction = errors.New("asctest: no recorded interaction matches the request")
+
+const cassetteVersion = 1
+
+// cassette is the file a Recorder stores exchanges in.
+type cassette struct {
+	Version      int           `json:"version"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	// Body holds JSON bodies, scrubbed. Other bodies, such as the parts of an upload, are only
+	// recorded by their SHA-256 checksum.
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodySHA256 string          `json:"bodySha256,omitempty"`
+}
+
+type recordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	// Body holds the body as text, or encoded in base64 if BodyEncoding is "base64".
+	Body         string `json:"body,omitempty"`
+	BodyEncoding string `json:"bodyEncoding,omitempty"`
+}
+
+// recordedHeaders are the response headers kept in cassettes.
+var recordedHeaders = []string{"Content-Type", "Content-Encoding", "Location", "Retry-After", "X-Rate-Limit"}
+
+// Recorder is an http.RoundTripper that records exchanges with the App Store Connect API into a
+// cassette file, or replays them from it, so that tests can run offline from recorded traffic.
+//
+// Before they are recorded, requests and responses are scrubbed of secrets and personal data:
+// request headers such as Authorization are dropped, JSON Web Tokens are replaced, email addresses
+// and device UDIDs are replaced by placeholders derived from their hash, and the signatures of
+// signed URLs, such as the URLs of upload operations, are removed. Requests are scrubbed the same
+// way when replaying, so a request matches its recording by method, path, query and body.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []func(string) string
+
+	mu       sync.Mutex
+	cassette cassette
+	played   []bool
+}
+
+// RecorderOption configures a Recorder.
+type RecorderOption func(*Recorder)
+
+// WithRecordingTransport sets the transport that sends requests to the API when recording. It
+// defaults to http.DefaultTransport.
+func WithRecordingTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// WithScrubber adds a function that scrubs the strings of requests and responses, such as the
+// values of JSON bodies and the segments of URLs, after the default scrubbing. It must return
+// its input unchanged when it has already been scrubbed.
+func WithScrubber(scrub func(string) string) RecorderOption {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrub)
+	}
+}
+
+// NewRecorder returns a Recorder for the cassette file at path. When replaying, the cassette is
+// read from the file. When recording, the cassette starts empty and is written by Save.
+func NewRecorder(path string, mode Mode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  cassette{Version: cassetteVersion},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+
+	if r.cassette.Version != cassetteVersion {
+		return nil, fmt.Errorf("reading cassette %s: unsupported version %d", path, r.cassette.Version)
+	}
+
+	// Bodies are indented in the file, and compacted like the bodies of the requests they match.
+	for i := range r.cassette.Interactions {
+		request := &r.cassette.Interactions[i].Request
+		if len(request.Body) == 0 {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, request.Body); err != nil {
+			return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+		}
+
+		request.Body = buf.Bytes()
+	}
+
+	r.played = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// Client returns an HTTP client that sends its requests through the recorder. Wrap the recorder
+// in an asc.AuthTransport instead to authenticate the requests that are recorded.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Save writes the recorded cassette to its file. It does nothing when replaying.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0o600)
+}
+
+// RoundTrip records or replays the exchange of a request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	recorded := r.recordRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction{
+		Request:  recorded,
+		Response: r.recordResponse(resp, respBody),
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Unwrap returns the transport that sends requests to the API when recording, so that an asc.Client
+// can find the CredentialPool it wraps.
+func (r *Recorder) Unwrap() http.RoundTripper {
+	return r.transport
+}
+
+// replay returns the response of the first interaction that matches the request and has not
+// been played yet, or of the last matching interaction if they have all been played, such as
+// when a resource is polled more times than when it was recorded.
+func (r *Recorder) replay(req *http.Request, recorded recordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+
+	for i, candidate := range r.cassette.Interactions {
+		if !candidate.Request.matches(recorded) {
+			continue
+		}
+
+		match = i
+		if !r.played[i] {
+			break
+		}
+	}
+
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.URL)
+	}
+
+	r.played[match] = true
+	recordedResp := r.cassette.Interactions[match].Response
+
+	body := []byte(recordedResp.Body)
+	if recordedResp.BodyEncoding == "base64" {
+		var err error
+
+		body, err = base64.StdEncoding.DecodeString(recordedResp.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recordedResp.Status, http.StatusText(recordedResp.Status)),
+		StatusCode:    recordedResp.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recordedResp.Headers.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (q recordedRequest) matches(other recordedRequest) bool {
+	return q.Method == other.Method &&
+		q.URL == other.URL &&
+		bytes.Equal(q.Body, other.Body) &&
+		q.BodySHA256 == other.BodySHA256
+}
+
+func (r *Recorder) recordRequest(req *http.Request, body []byte) recordedRequest {
+	recorded := recordedRequest{
+		Method: req.Method,
+		URL:    r.scrubURL(req.URL),
+	}
+
+	if len(body) == 0 {
+		return recorded
+	}
+
+	if scrubbed, ok := r.scrubJSON(body); ok {
+		recorded.Body = scrubbed
+	} else {
+		recorded.BodySHA256 = fmt.Sprintf("%x", sha256.Sum256(body))
+	}
+
+	return recorded
+}
+
+func (r *Recorder) recordResponse(resp *http.Response, body []byte) recordedResponse {
+	recorded := recordedResponse{
+		Status:  resp.StatusCode,
+		Headers: http.Header{},
+	}
+
+	for _, name := range recordedHeaders {
+		for _, value := range resp.Header.Values(name) {
+			recorded.Headers.Add(name, r.scrubHeader(name, value))
+		}
+	}
+
+	switch scrubbed, ok := r.scrubJSON(body); {
+	case ok:
+		recorded.Body = string(scrubbed)
+	case utf8.Valid(body) && resp.Header.Get("Content-Encoding") == "":
+		recorded.Body = r.scrub("", string(body))
+	default:
+		recorded.Body = base64.StdEncoding.EncodeToString(body)
+		recorded.BodyEncoding = "base64"
+	}
+
+	return recorded
+}
+
+var (
+	jwtPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	udidPattern  = regexp.MustCompile(`\b(?:[0-9a-fA-F]{40}|[0-9A-F]{8}-[0-9A-F]{16})\b`)
+	urlPattern   = regexp.MustCompile(`https?://[^\s"'<>]+`)
+)
+
+// signedParameters are parts of the names of the query parameters that sign URLs, such as
+// X-Amz-Signature. Parameters named "sig" are signatures as well.
+var signedParameters = []string{"signature", "token", "credential", "policy", "key-pair-id", "expires", "x-amz-", "x-goog-"}
+
+const redacted = "REDACTED"
+
+// scrub scrubs a string, which is the value of a JSON member or a query parameter with the
+// given key, if any.
+func (r *Recorder) scrub(key string, s string) string {
+	if strings.EqualFold(key, "udid") || strings.EqualFold(key, "filter[udid]") {
+		s = placeholderUDID(s)
+	}
+
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	s = urlPattern.ReplaceAllStringFunc(s, func(raw string) string {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return raw
+		}
+
+		return scrubSignedURL(u).String()
+	})
+	s = emailPattern.ReplaceAllStringFunc(s, placeholderEmail)
+	s = udidPattern.ReplaceAllStringFunc(s, placeholderUDID)
+
+	for _, scrub := range r.scrubbers {
+		s = scrub(s)
+	}
+
+	return s
+}
+
+// scrubURL returns the URL of a request, scrubbed, with its query parameters sorted.
+func (r *Recorder) scrubURL(u *url.URL) string {
+	scrubbed := *scrubSignedURL(u)
+
+	segments := strings.Split(scrubbed.Path, "/")
+	for i, segment := range segments {
+		segments[i] = r.scrub("", segment)
+	}
+
+	scrubbed.Path = strings.Join(segments, "/")
+	scrubbed.RawPath = ""
+
+	query := scrubbed.Query()
+	for key, values := range query {
+		for i, value := range values {
+			values[i] = r.scrub(key, value)
+		}
+	}
+
+	scrubbed.RawQuery = query.Encode()
+
+	return scrubbed.String()
+}
+
+// scrubHeader scrubs the value of a response header. Location headers are scrubbed as URLs, so
+// that relative URLs are scrubbed as well as absolute ones.
+func (r *Recorder) scrubHeader(name string, value string) string {
+	if strings.EqualFold(name, "Location") {
+		if u, err := url.Parse(value); err == nil {
+			return r.scrubURL(u)
+		}
+	}
+
+	return r.scrub("", value)
+}
+
+// scrubJSON scrubs every string of a JSON document, and reports whether data is JSON.
+func (r *Recorder) scrubJSON(data []byte) (json.RawMessage, bool) {
+	var doc interface{}
+	if len(bytes.TrimSpace(data)) == 0 || json.Unmarshal(data, &doc) != nil {
+		return nil, false
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(r.scrubValue("", doc)); err != nil {
+		return nil, false
+	}
+
+	return bytes.TrimRight(buf.Bytes(), "\n"), true
+}
+
+func (r *Recorder) scrubValue(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			v[k] = r.scrubValue(k, value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.scrubValue(key, value)
+		}
+	case string:
+		return r.scrub(key, v)
+	}
+
+	return v
+}
+
+// scrubSignedURL returns u with the values of its signing parameters redacted.
+func scrubSignedURL(u *url.URL) *url.URL {
+	scrubbed := *u
+	query := scrubbed.Query()
+	changed := false
+
+	for key := range query {
+		if isSignedParameter(key) {
+			query[key] = []string{redacted}
+			changed = true
+		}
+	}
+
+	if changed {
+		scrubbed.RawQuery = query.Encode()
+	}
+
+	return &scrubbed
+}
+
+func isSignedParameter(key string) bool {
+	lower := strings.ToLower(key)
+	if lower == "sig" {
+		return true
+	}
+
+	for _, param := range signedParameters {
+		if strings.Contains(lower, param) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// placeholderEmail replaces an email address by an address at example.com derived from its hash.
+// Addresses at example.com, including placeholders, are kept.
+func placeholderEmail(email string) string {
+	if strings.HasSuffix(strings.ToLower(email), "@example.com") {
+		return email
+	}
+
+	return "user-" + shortHash(strings.ToLower(email)) + "@example.com"
+}
+
+// placeholderUDID replaces a device UDID by a placeholder derived from its hash.
+func placeholderUDID(udid string) string {
+	if udid == "" || strings.HasPrefix(udid, redacted) {
+		return udid
+	}
+
+	return redacted + "-UDID-" + shortHash(udid)
+}
+
+func shortHash(s string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))[:12]
+}
@@ -0,0 +1,301 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/castbox/asc-go/asc"
+)
+
+// Server is a fake App Store Connect API. Its methods are safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string][]*Resource
+	lastID    int
+	requests  []Request
+	faults    []*Fault
+	rate      rateLimit
+	uploads   map[string]*upload
+	partSize  int
+	delivery  []asc.AppMediaStateError
+}
+
+// Request is a request received by a Server.
+type Request struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the request relative to the API version, such as "apps/1/builds", or
+	// the path of the upload for parts of assets, such as "upload/appScreenshots/1".
+	Path string
+	// Query holds the query parameters of the request.
+	Query url.Values
+	// Body is the body of the request, or nil if it has none.
+	Body []byte
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithPartSize sets the size of the parts that assets are split into for upload. It defaults to
+// DefaultPartSize.
+func WithPartSize(size int) Option {
+	return func(s *Server) {
+		if size > 0 {
+			s.partSize = size
+		}
+	}
+}
+
+// DefaultPartSize is the default size of the parts of an asset upload.
+const DefaultPartSize = 1 << 20
+
+// NewServer starts a Server. The caller should call Close when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		resources: map[string][]*Resource{},
+		uploads:   map[string]*upload{},
+		partSize:  DefaultPartSize,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// NewClient returns a client that sends its requests to the server. Failed requests are retried
+// like with the default retry policy, without waiting between attempts.
+func (s *Server) NewClient() *asc.Client {
+	client := asc.NewClient(s.Client())
+	_ = client.SetBaseURL(s.URL)
+
+	policy := asc.DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	policy.MaxInterval = time.Millisecond
+	policy.Jitter = 0
+	client.SetRetryPolicy(policy)
+
+	return client
+}
+
+// Requests returns the requests received by the server so far, in the order they were received.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+var versionPrefix = regexp.MustCompile(`^/v[0-9]+/`)
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	if len(body) == 0 {
+		body = nil
+	}
+
+	version := versionPrefix.FindString(r.URL.Path)
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, version), "/")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: path, Query: r.URL.Query(), Body: body})
+	fault := s.matchFault(r.Method, path)
+
+	var limited bool
+	if version != "" {
+		limited = s.rate.take(w.Header())
+	}
+	s.mu.Unlock()
+
+	if fault != nil {
+		fault.write(w)
+
+		return
+	}
+
+	if limited {
+		writeError(w, &apiError{status: http.StatusTooManyRequests, code: "RATE_LIMIT_EXCEEDED", title: "The request rate limit has been reached."})
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if version == "" {
+		s.servePart(w, r, path, body)
+
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		s.processUploads()
+	}
+
+	ctx := &requestContext{
+		root:  s.URL,
+		base:  s.URL + version,
+		url:   r.URL,
+		query: r.URL.Query(),
+		body:  body,
+	}
+
+	status, doc, err := s.route(ctx, r.Method, strings.Split(path, "/"))
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	if doc == nil {
+		w.WriteHeader(status)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+// requestContext holds what the handlers need to know about a request to the API.
+type requestContext struct {
+	root  string
+	base  string
+	url   *url.URL
+	query url.Values
+	body  []byte
+}
+
+// link returns the URL of a path of the API version of the request.
+func (c *requestContext) link(format string, args ...interface{}) string {
+	return c.base + fmt.Sprintf(format, args...)
+}
+
+func (s *Server) route(ctx *requestContext, method string, segments []string) (int, interface{}, *apiError) {
+	switch {
+	case len(segments) == 1 && segments[0] != "":
+		switch method {
+		case http.MethodGet:
+			return s.list(ctx, segments[0])
+		case http.MethodPost:
+			return s.create(ctx, segments[0])
+		}
+	case len(segments) == 2:
+		switch method {
+		case http.MethodGet:
+			return s.get(ctx, segments[0], segments[1])
+		case http.MethodPatch:
+			return s.update(ctx, segments[0], segments[1])
+		case http.MethodDelete:
+			return s.delete(segments[0], segments[1])
+		}
+	case len(segments) == 3 && method == http.MethodGet:
+		return s.getRelated(ctx, segments[0], segments[1], segments[2])
+	case len(segments) == 4 && segments[2] == "relationships":
+		return s.serveRelationship(ctx, method, segments[0], segments[1], segments[3])
+	}
+
+	return 0, nil, unknownPath(method)
+}
+
+// apiError is an error response of the API.
+type apiError struct {
+	status int
+	code   string
+	title  string
+	detail string
+	source *asc.ErrorSource
+}
+
+func unknownPath(method string) *apiError {
+	return &apiError{
+		status: http.StatusNotFound,
+		code:   "NOT_FOUND",
+		title:  "The specified resource does not exist",
+		detail: fmt.Sprintf("The path provided does not match a defined resource type or the method %s is not allowed on it.", method),
+	}
+}
+
+func notFound(typ string, id string) *apiError {
+	return &apiError{
+		status: http.StatusNotFound,
+		code:   "NOT_FOUND",
+		title:  "The specified resource does not exist",
+		detail: fmt.Sprintf("There is no resource of type '%s' with id '%s'", typ, id),
+	}
+}
+
+func invalidEntity(code string, pointer string, detail string) *apiError {
+	return &apiError{
+		status: http.StatusConflict,
+		code:   code,
+		title:  "The provided entity is invalid.",
+		detail: detail,
+		source: &asc.ErrorSource{Pointer: pointer},
+	}
+}
+
+func invalidParameter(parameter string, detail string) *apiError {
+	return &apiError{
+		status: http.StatusBadRequest,
+		code:   "PARAMETER_ERROR.INVALID",
+		title:  "A parameter has an invalid value",
+		detail: detail,
+		source: &asc.ErrorSource{Parameter: parameter},
+	}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeErrors(w, err.status, []asc.ErrorResponseError{{
+		Code:   err.code,
+		Status: fmt.Sprint(err.status),
+		Title:  err.title,
+		Detail: err.detail,
+		Source: err.source,
+	}})
+}
+
+func writeErrors(w http.ResponseWriter, status int, errs []asc.ErrorResponseError) {
+	var buf bytes.Buffer
+
+	_ = json.NewEncoder(&buf).Encode(asc.ErrorResponse{Errors: errs})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
@@ -0,0 +1,293 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/castbox/asc-go/asc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppsVersionsAndLocalizations(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+
+	appID := server.Add("apps", asc.AppAttributes{BundleID: asc.String("com.example.app"), Name: asc.String("Example")}, nil)
+	server.Add("apps", asc.AppAttributes{BundleID: asc.String("com.example.other")}, nil)
+
+	apps, _, err := client.Apps.ListApps(ctx, &asc.ListAppsQuery{FilterBundleID: []string{"com.example.app"}})
+	assert.NoError(t, err)
+	assert.Len(t, apps.Data, 1)
+	assert.Equal(t, appID, apps.Data[0].ID)
+	assert.Equal(t, "Example", *apps.Data[0].Attributes.Name)
+
+	version, _, err := client.Apps.CreateAppStoreVersion(ctx, asc.AppStoreVersionCreateRequestAttributes{
+		Platform:      asc.PlatformIOS,
+		VersionString: "1.0",
+	}, appID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", *version.Data.Attributes.VersionString)
+
+	_, _, err = client.Apps.CreateAppStoreVersionLocalization(ctx, asc.AppStoreVersionLocalizationCreateRequestAttributes{
+		Locale: "en-US",
+	}, version.Data.ID)
+	assert.NoError(t, err)
+
+	versions, _, err := client.Apps.ListAppStoreVersionsForApp(ctx, appID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, versions.Data, 1)
+
+	localizations, _, err := client.Apps.ListLocalizationsForAppStoreVersion(ctx, version.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, localizations.Data, 1)
+	assert.Equal(t, "en-US", *localizations.Data[0].Attributes.Locale)
+
+	app, _, err := client.Apps.GetApp(ctx, appID, &asc.GetAppQuery{Include: []string{"appStoreVersions"}})
+	assert.NoError(t, err)
+	assert.Len(t, app.Included, 1)
+	assert.Equal(t, version.Data.ID, app.Included[0].AppStoreVersion().ID)
+	assert.Equal(t, version.Data.ID, app.Data.Relationships.AppStoreVersions.Data[0].ID)
+
+	_, err = client.Apps.DeleteAppStoreVersion(ctx, version.Data.ID)
+	assert.NoError(t, err)
+
+	_, ok := server.Resource("appStoreVersions", version.Data.ID)
+	assert.False(t, ok)
+
+	_, _, err = client.Apps.GetApp(ctx, "404", nil)
+	assert.ErrorIs(t, err, asc.ErrNotFound)
+}
+
+func TestCursorPaging(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+
+	appID := server.Add("apps", nil, nil)
+	for _, v := range []string{"3", "1", "5", "2", "4"} {
+		server.Add("builds", asc.BuildAttributes{Version: asc.String(v)}, map[string]Relationship{
+			"app": ToOne("apps", appID),
+		})
+	}
+
+	server.Add("builds", asc.BuildAttributes{Version: asc.String("99")}, nil)
+
+	var versions []string
+
+	for build, err := range asc.Items[asc.Build](ctx, client, func(ctx context.Context) (*asc.BuildsResponse, *asc.Response, error) {
+		return client.Builds.ListBuildsForApp(ctx, appID, &asc.ListBuildsForAppQuery{Limit: 2})
+	}) {
+		assert.NoError(t, err)
+
+		versions = append(versions, *build.Attributes.Version)
+	}
+
+	assert.Equal(t, []string{"3", "1", "5", "2", "4"}, versions)
+
+	builds, _, err := client.Builds.ListBuilds(ctx, &asc.ListBuildsQuery{Sort: []string{"-version"}, Limit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, builds.Data, 3)
+	assert.Equal(t, "99", *builds.Data[0].Attributes.Version)
+	assert.Equal(t, "5", *builds.Data[1].Attributes.Version)
+	assert.Equal(t, 6, builds.Meta.Paging.Total)
+	assert.NotEmpty(t, builds.Links.Next.Cursor())
+
+	_, _, err = client.Builds.ListBuilds(ctx, &asc.ListBuildsQuery{Cursor: "bogus"})
+
+	var errResp *asc.ErrorResponse
+
+	assert.True(t, errors.As(err, &errResp))
+	assert.Equal(t, "cursor", errResp.Errors[0].Source.Parameter)
+}
+
+func TestBetaGroupRelationships(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+
+	appID := server.Add("apps", nil, nil)
+
+	group, _, err := client.TestFlight.CreateBetaGroup(ctx, asc.BetaGroupCreateRequestAttributes{Name: "Friends"}, appID, nil, nil)
+	assert.NoError(t, err)
+
+	tester, _, err := client.TestFlight.CreateBetaTester(ctx, asc.BetaTesterCreateRequestAttributes{
+		Email: "one@example.com",
+	}, []string{group.Data.ID}, nil)
+	assert.NoError(t, err)
+
+	other := server.Add("betaTesters", asc.BetaTesterAttributes{Email: emailPtr("two@example.com")}, nil)
+
+	_, err = client.TestFlight.AddBetaTestersToBetaGroup(ctx, group.Data.ID, []string{other})
+	assert.NoError(t, err)
+
+	testers, _, err := client.TestFlight.ListBetaTestersForBetaGroup(ctx, group.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, testers.Data, 2)
+
+	groups, _, err := client.TestFlight.ListBetaGroupsForBetaTester(ctx, other, nil)
+	assert.NoError(t, err)
+	assert.Len(t, groups.Data, 1)
+
+	_, err = client.TestFlight.RemoveBetaTestersFromBetaGroup(ctx, group.Data.ID, []string{tester.Data.ID})
+	assert.NoError(t, err)
+
+	testers, _, err = client.TestFlight.ListBetaTestersForBetaGroup(ctx, group.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, testers.Data, 1)
+
+	groups, _, err = client.TestFlight.ListBetaGroupsForBetaTester(ctx, tester.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, groups.Data)
+
+	_, err = client.TestFlight.AddBetaTestersToBetaGroup(ctx, group.Data.ID, []string{"missing"})
+	assert.ErrorIs(t, err, asc.ErrConflict)
+}
+
+func TestDevicesAndUsers(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+
+	_, _, err := client.Provisioning.CreateDevice(ctx, "iPhone", "00008030", asc.BundleIDPlatformiOS)
+	assert.NoError(t, err)
+
+	devices, _, err := client.Provisioning.ListDevices(ctx, &asc.ListDevicesQuery{FilterUDID: []string{"00008030"}})
+	assert.NoError(t, err)
+	assert.Len(t, devices.Data, 1)
+	assert.Equal(t, "iPhone", *devices.Data[0].Attributes.Name)
+
+	server.Add("users", asc.UserAttributes{Username: asc.String("dev@example.com"), Roles: []asc.UserRole{asc.UserRoleDeveloper}}, nil)
+	server.Add("users", asc.UserAttributes{Username: asc.String("admin@example.com"), Roles: []asc.UserRole{asc.UserRoleAdmin}}, nil)
+
+	users, _, err := client.Users.ListUsers(ctx, &asc.ListUsersQuery{FilterRoles: []string{string(asc.UserRoleAdmin)}})
+	assert.NoError(t, err)
+	assert.Len(t, users.Data, 1)
+	assert.Equal(t, "admin@example.com", *users.Data[0].Attributes.Username)
+}
+
+func TestAssetUpload(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer(WithPartSize(4))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+
+	localizationID := server.Add("gameCenterAchievementLocalizations", nil, nil)
+	contents := []byte("achievement image")
+
+	image, err := asc.UploadAsset(ctx, client, asc.GameCenterAchievementImageAsset, localizationID, "badge.png",
+		bytes.NewReader(contents), int64(len(contents)), asc.WithAssetPollInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, asc.AssetStateComplete, *image.Attributes.AssetDeliveryState.State)
+
+	uploaded, complete := server.Uploaded("gameCenterAchievementImages", image.ID)
+	assert.True(t, complete)
+	assert.Equal(t, contents, uploaded)
+
+	setID := server.Add("appScreenshotSets", nil, nil)
+	server.FailAssetDelivery(asc.AppMediaStateError{Code: asc.String("IMAGE_INCORRECT_DIMENSIONS")})
+
+	_, err = asc.UploadAsset(ctx, client, asc.AppScreenshotAsset, setID, "home.png",
+		bytes.NewReader(contents), int64(len(contents)), asc.WithAssetPollInterval(time.Millisecond))
+
+	var deliveryErr *asc.AssetDeliveryError
+
+	assert.True(t, errors.As(err, &deliveryErr))
+	assert.True(t, deliveryErr.HasCode("IMAGE_INCORRECT_DIMENSIONS"))
+
+	server.FailAssetDelivery()
+
+	screenshot, _, err := client.Apps.CreateAppScreenshot(ctx, "home.png", int64(len(contents)), setID)
+	assert.NoError(t, err)
+	assert.Len(t, screenshot.Data.Attributes.UploadOperations, 5)
+
+	_, _, err = client.Apps.CommitAppScreenshot(ctx, screenshot.Data.ID, asc.Bool(true), asc.String("0"))
+	assert.NoError(t, err)
+
+	got, _, err := client.Apps.GetAppScreenshot(ctx, screenshot.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, asc.AssetStateFailed, *got.Data.Attributes.AssetDeliveryState.State)
+	assert.Equal(t, ErrorCodeUploadIncomplete, *got.Data.Attributes.AssetDeliveryState.Errors[0].Code)
+}
+
+func TestFaultsAndRateLimit(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.NewClient()
+	appID := server.Add("apps", nil, nil)
+
+	server.Inject(Fault{Method: http.MethodGet, Path: "apps/*", Status: http.StatusServiceUnavailable, Times: 2})
+
+	_, _, err := client.Apps.GetApp(ctx, appID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, server.Requests(), 3)
+
+	server.Inject(Fault{Path: "apps", Status: http.StatusForbidden})
+
+	_, _, err = client.Apps.ListApps(ctx, nil)
+	assert.ErrorIs(t, err, asc.ErrForbidden)
+
+	server.ClearFaults()
+	server.SetRateLimit(1)
+
+	_, resp, err := client.Apps.ListApps(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.Rate.Limit)
+	assert.Equal(t, 0, resp.Rate.Remaining)
+
+	client.SetRetryPolicy(asc.NoRetryPolicy())
+
+	_, _, err = client.Apps.ListApps(ctx, nil)
+	assert.ErrorIs(t, err, asc.ErrRateLimited)
+}
+
+func emailPtr(email asc.Email) *asc.Email {
+	return &email
+}
@@ -0,0 +1,264 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/castbox/asc-go/asc"
+)
+
+// Resource is a resource stored by a Server.
+type Resource struct {
+	// Type is the JSON:API type of the resource, such as "apps".
+	Type string
+	// ID is the ID of the resource.
+	ID string
+	// Attributes are the attributes of the resource, as decoded from JSON.
+	Attributes map[string]interface{}
+	// Relationships are the resources the resource is related to, by relationship name.
+	Relationships map[string]Relationship
+}
+
+// Decode decodes the attributes of the resource into v, such as an *asc.AppAttributes.
+func (r Resource) Decode(v interface{}) error {
+	b, err := json.Marshal(r.Attributes)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+func (r *Resource) clone() Resource {
+	c := Resource{
+		Type:          r.Type,
+		ID:            r.ID,
+		Attributes:    make(map[string]interface{}, len(r.Attributes)),
+		Relationships: make(map[string]Relationship, len(r.Relationships)),
+	}
+
+	for k, v := range r.Attributes {
+		c.Attributes[k] = v
+	}
+
+	for k, v := range r.Relationships {
+		c.Relationships[k] = Relationship{Many: v.Many, Data: append([]asc.RelationshipData(nil), v.Data...)}
+	}
+
+	return c
+}
+
+// Relationship links a resource to other resources.
+type Relationship struct {
+	// Data identifies the related resources.
+	Data []asc.RelationshipData
+	// Many reports whether the relationship is to many resources rather than to one.
+	Many bool
+}
+
+// ToOne returns a relationship to the resource of the given type and ID.
+func ToOne(typ string, id string) Relationship {
+	return Relationship{Data: []asc.RelationshipData{{Type: typ, ID: id}}}
+}
+
+// ToMany returns a relationship to the resources of the given type and IDs.
+func ToMany(typ string, ids ...string) Relationship {
+	rel := Relationship{Data: make([]asc.RelationshipData, 0, len(ids)), Many: true}
+	for _, id := range ids {
+		rel.Data = append(rel.Data, asc.RelationshipData{Type: typ, ID: id})
+	}
+
+	return rel
+}
+
+func (r Relationship) contains(typ string, id string) bool {
+	for _, data := range r.Data {
+		if data.Type == typ && data.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add stores a new resource of the given type with the given attributes and relationships, and
+// returns its ID. The attributes may be any value that encodes to a JSON object, such as an
+// asc.AppAttributes, or nil. Add panics if the attributes cannot be encoded.
+func (s *Server) Add(typ string, attributes interface{}, relationships map[string]Relationship) string {
+	attrs, err := toAttributes(attributes)
+	if err != nil {
+		panic(fmt.Sprintf("asctest: invalid attributes for %s: %v", typ, err))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := &Resource{Type: typ, ID: s.newID(), Attributes: attrs, Relationships: map[string]Relationship{}}
+	for name, rel := range relationships {
+		res.Relationships[name] = rel
+	}
+
+	s.insert(res)
+
+	return res.ID
+}
+
+// Resource returns a copy of the resource of the given type and ID, if it exists.
+func (s *Server) Resource(typ string, id string) (Resource, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := s.find(typ, id)
+	if res == nil {
+		return Resource{}, false
+	}
+
+	return res.clone(), true
+}
+
+// Resources returns a copy of every resource of the given type, in the order they were created.
+func (s *Server) Resources(typ string) []Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resources := make([]Resource, 0, len(s.resources[typ]))
+	for _, res := range s.resources[typ] {
+		resources = append(resources, res.clone())
+	}
+
+	return resources
+}
+
+func (s *Server) newID() string {
+	s.lastID++
+
+	return fmt.Sprint(s.lastID)
+}
+
+func (s *Server) insert(res *Resource) {
+	s.resources[res.Type] = append(s.resources[res.Type], res)
+}
+
+func (s *Server) find(typ string, id string) *Resource {
+	for _, res := range s.resources[typ] {
+		if res.ID == id {
+			return res
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) remove(typ string, id string) bool {
+	resources := s.resources[typ]
+	for i, res := range resources {
+		if res.ID == id {
+			s.resources[typ] = append(resources[:i:i], resources[i+1:]...)
+			delete(s.uploads, uploadKey(typ, id))
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// related returns the resources related to res through the relationship with the given name,
+// and whether the relationship is to many resources.
+//
+// Relationships declared by res are followed first. Otherwise, the related resources are those
+// that declare a relationship to res, and whose type is the name of the relationship, such as
+// "builds" for the builds of an app, or the name followed by "s" for a relationship to one, such
+// as "appStoreVersionSubmissions" for the submission of a version.
+func (s *Server) related(res *Resource, name string) ([]*Resource, bool) {
+	if rel, ok := res.Relationships[name]; ok {
+		related := make([]*Resource, 0, len(rel.Data))
+
+		for _, data := range rel.Data {
+			if r := s.find(data.Type, data.ID); r != nil {
+				related = append(related, r)
+			}
+		}
+
+		return related, rel.Many
+	}
+
+	many := strings.HasSuffix(name, "s")
+
+	typ := name
+	if !many {
+		typ += "s"
+	}
+
+	var related []*Resource
+
+	for _, candidate := range s.resources[typ] {
+		for _, rel := range candidate.Relationships {
+			if rel.contains(res.Type, res.ID) {
+				related = append(related, candidate)
+
+				break
+			}
+		}
+	}
+
+	if !many && len(related) > 1 {
+		related = related[:1]
+	}
+
+	return related, many
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+
+	return value, json.Unmarshal(b, &value)
+}
+
+func toAttributes(v interface{}) (map[string]interface{}, error) {
+	attrs := map[string]interface{}{}
+	if v == nil {
+		return attrs, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &attrs); err != nil {
+		return nil, err
+	}
+
+	if attrs == nil {
+		attrs = map[string]interface{}{}
+	}
+
+	return attrs, nil
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"crypto/md5" // nolint: gosec
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/castbox/asc-go/asc"
+)
+
+// Error codes of the delivery state of assets that the server fails to process.
+const (
+	// ErrorCodeUploadIncomplete is reported when an asset is committed before all of its parts
+	// were uploaded.
+	ErrorCodeUploadIncomplete = "UPLOAD_INCOMPLETE"
+	// ErrorCodeChecksumMismatch is reported when the checksum an asset is committed with does not
+	// match the parts that were uploaded.
+	ErrorCodeChecksumMismatch = "CHECKSUM_MISMATCH"
+)
+
+// upload holds the parts uploaded for an asset.
+type upload struct {
+	size   int
+	parts  map[int][]byte
+	result *asc.AppMediaAssetState
+}
+
+func uploadKey(typ string, id string) string {
+	return typ + "/" + id
+}
+
+// reserveUpload adds upload operations to a newly created resource that has a file name and a
+// file size, such as an app screenshot.
+func (s *Server) reserveUpload(res *Resource) {
+	size, ok := res.Attributes["fileSize"].(float64)
+	if _, named := res.Attributes["fileName"].(string); !ok || !named || size <= 0 {
+		return
+	}
+
+	u := &upload{size: int(size), parts: map[int][]byte{}}
+	s.uploads[uploadKey(res.Type, res.ID)] = u
+
+	var ops []asc.UploadOperation
+
+	for offset := 0; offset < u.size; offset += s.partSize {
+		length := s.partSize
+		if offset+length > u.size {
+			length = u.size - offset
+		}
+
+		ops = append(ops, asc.UploadOperation{
+			Method: asc.String(http.MethodPut),
+			URL:    asc.String(fmt.Sprintf("%s/upload/%s/%s?offset=%d", s.URL, res.Type, res.ID, offset)),
+			Offset: asc.Int(offset),
+			Length: asc.Int(length),
+			RequestHeaders: []asc.UploadOperationHeader{
+				{Name: asc.String("Content-Type"), Value: asc.String("application/octet-stream")},
+			},
+		})
+	}
+
+	res.Attributes["uploadOperations"], _ = toJSONValue(ops)
+	res.Attributes["assetDeliveryState"] = map[string]interface{}{"state": asc.AssetStateAwaitingUpload}
+}
+
+// servePart stores a part of an asset uploaded to a path such as "upload/appScreenshots/1".
+func (s *Server) servePart(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	segments := strings.Split(path, "/")
+	if len(segments) != 3 || segments[0] != "upload" || r.Method != http.MethodPut {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	u := s.uploads[uploadKey(segments[1], segments[2])]
+	if u == nil {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 || offset+len(body) > u.size {
+		http.Error(w, "invalid offset", http.StatusBadRequest)
+
+		return
+	}
+
+	u.parts[offset] = body
+	w.WriteHeader(http.StatusOK)
+}
+
+// commitUpload processes the asset if the resource has been marked as uploaded. The asset is
+// reported as UPLOAD_COMPLETE until it is next read.
+func (s *Server) commitUpload(res *Resource) {
+	u := s.uploads[uploadKey(res.Type, res.ID)]
+	if u == nil || u.result != nil || res.Attributes["uploaded"] != true {
+		return
+	}
+
+	u.result = &asc.AppMediaAssetState{State: asc.String(asc.AssetStateComplete)}
+
+	data, complete := u.data()
+	checksum, _ := res.Attributes["sourceFileChecksum"].(string)
+
+	switch {
+	case !complete:
+		u.result = failedDelivery(ErrorCodeUploadIncomplete, "Some parts of the asset were not uploaded.")
+	case checksum != "" && checksum != fmt.Sprintf("%x", md5.Sum(data)): // nolint: gosec
+		u.result = failedDelivery(ErrorCodeChecksumMismatch, "The checksum does not match the uploaded asset.")
+	case len(s.delivery) > 0:
+		u.result = &asc.AppMediaAssetState{State: asc.String(asc.AssetStateFailed), Errors: s.delivery}
+	}
+
+	delete(res.Attributes, "uploadOperations")
+	res.Attributes["assetDeliveryState"] = map[string]interface{}{"state": asc.AssetStateUploadComplete}
+}
+
+// processUploads moves the committed assets to their final delivery state.
+func (s *Server) processUploads() {
+	for key, u := range s.uploads {
+		if u.result == nil {
+			continue
+		}
+
+		typ, id, _ := strings.Cut(key, "/")
+		if res := s.find(typ, id); res != nil {
+			res.Attributes["assetDeliveryState"], _ = toJSONValue(u.result)
+		}
+	}
+}
+
+func failedDelivery(code string, description string) *asc.AppMediaAssetState {
+	return &asc.AppMediaAssetState{
+		State:  asc.String(asc.AssetStateFailed),
+		Errors: []asc.AppMediaStateError{{Code: asc.String(code), Description: asc.String(description)}},
+	}
+}
+
+// data returns the parts uploaded so far, and whether they cover the whole asset.
+func (u *upload) data() ([]byte, bool) {
+	data := make([]byte, 0, u.size)
+
+	for len(data) < u.size {
+		part, ok := u.parts[len(data)]
+		if !ok || len(part) == 0 {
+			return data, false
+		}
+
+		data = append(data, part...)
+	}
+
+	return data, true
+}
+
+// FailAssetDelivery makes the server fail to process the assets committed from now on, with the
+// given errors. Call it with no errors to process assets successfully again.
+func (s *Server) FailAssetDelivery(errs ...asc.AppMediaStateError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delivery = errs
+}
+
+// Uploaded returns the parts uploaded so far for the asset of the given type and ID, in order,
+// and whether they cover the whole asset.
+func (s *Server) Uploaded(typ string, id string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.uploads[uploadKey(typ, id)]
+	if u == nil {
+		return nil, false
+	}
+
+	return u.data()
+}
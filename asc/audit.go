@@ -0,0 +1,585 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrAuditTampered happens when the hash chain of an audit trail is broken, because a record was
+// modified, removed or inserted.
+var ErrAuditTampered = errors.New("audit trail has been tampered with")
+
+// auditStateLimit is the maximum number of fetched resources whose attributes are kept to compute
+// the diffs of audit records.
+const auditStateLimit = 10000
+
+// AuditRecord is an entry of the audit trail of a Client, written for every POST, PATCH and DELETE
+// request the client sends.
+type AuditRecord struct {
+	// Sequence is the position of the record in the trail, starting at 1.
+	Sequence int64 `json:"seq"`
+	// Time is when the request was sent, in UTC.
+	Time time.Time `json:"time"`
+	// KeyID is the ID of the API key that signed the request, if known.
+	KeyID string `json:"keyId,omitempty"`
+	// IssuerID is the issuer ID of the API key that signed the request. It is empty for individual
+	// keys.
+	IssuerID string `json:"issuerId,omitempty"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Path is the path of the request relative to the client's base URL, such as
+	// "appStoreVersions/1234".
+	Path string `json:"path"`
+	// ResourceType is the type of the resource the request targets, such as "appStoreVersions".
+	ResourceType string `json:"resourceType,omitempty"`
+	// ResourceID is the ID of the resource the request targets. For a created resource, it is the
+	// ID assigned by App Store Connect.
+	ResourceID string `json:"resourceId,omitempty"`
+	// Relationship is the name of the relationship the request modifies, if any.
+	Relationship string `json:"relationship,omitempty"`
+	// Body is the JSON request body, or nil if the request has none. The values of redacted
+	// attributes are replaced with "[REDACTED]".
+	Body json.RawMessage `json:"body,omitempty"`
+	// Diff lists the attributes an update changes, if the client fetched the resource before. The
+	// values of redacted attributes are replaced with "[REDACTED]".
+	Diff []AuditChange `json:"diff,omitempty"`
+	// Status is the HTTP status code of the response, or zero if none was received.
+	Status int `json:"status,omitempty"`
+	// ErrorIDs holds the IDs of the errors returned by App Store Connect, to quote to Apple.
+	ErrorIDs []string `json:"errorIds,omitempty"`
+	// Error describes why the request failed, if it did.
+	Error string `json:"error,omitempty"`
+	// PrevHash is the Hash of the previous record of the trail, or empty for the first record.
+	PrevHash string `json:"prevHash"`
+	// Hash is the hex-encoded SHA-256 hash of the JSON encoding of the record without its hash.
+	Hash string `json:"hash,omitempty"`
+}
+
+// AuditChange is a change of an attribute made by an update.
+type AuditChange struct {
+	// Attribute is the name of the attribute, such as "versionString".
+	Attribute string `json:"attribute"`
+	// Old is the value of the attribute when the resource was last fetched.
+	Old json.RawMessage `json:"old"`
+	// New is the value the update sets.
+	New json.RawMessage `json:"new"`
+}
+
+// AuditSink writes the audit trail of a Client as JSON lines, one AuditRecord per line. Each
+// record holds the hash of the previous one, so that changes to the trail can be detected with
+// VerifyAuditTrail. An AuditSink is safe for concurrent use.
+type AuditSink struct {
+	mu       sync.Mutex
+	w        io.Writer
+	file     *os.File
+	seq      int64
+	last     string
+	now      func() time.Time
+	states   *auditState
+	redacted logConfig
+}
+
+// AuditOption customizes what an AuditSink records.
+type AuditOption func(*AuditSink)
+
+// WithAuditRedactedFields replaces DefaultRedactedFields with the given JSON attribute names,
+// whose values are redacted from the bodies and diffs of audit records.
+func WithAuditRedactedFields(fields ...string) AuditOption {
+	return func(s *AuditSink) {
+		s.redacted.redacted = redactedFieldSet(fields)
+	}
+}
+
+// NewAuditSink returns an AuditSink writing to w. The trail starts a new hash chain, so w should
+// be empty; use OpenAuditFile to append to an existing trail. The values of DefaultRedactedFields
+// are redacted from the records, unless WithAuditRedactedFields is used.
+func NewAuditSink(w io.Writer, options ...AuditOption) *AuditSink {
+	sink := &AuditSink{
+		w:        w,
+		now:      time.Now,
+		states:   newAuditState(),
+		redacted: defaultLogConfig(),
+	}
+
+	for _, option := range options {
+		option(sink)
+	}
+
+	return sink
+}
+
+// OpenAuditFile returns an AuditSink appending to the file at path, which is created if it does
+// not exist. The records already in the file are verified, and new records continue their hash
+// chain. If the chain is broken, the error wraps ErrAuditTampered. Records are synced to disk as
+// they are written. Close the sink to close the file.
+func OpenAuditFile(path string, options ...AuditOption) (*AuditSink, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := verifyAuditTrail(f)
+	if err != nil {
+		f.Close()
+
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	sink := NewAuditSink(f, options...)
+	sink.file = f
+	sink.seq = last.Sequence
+	sink.last = last.Hash
+
+	return sink, nil
+}
+
+// Close closes the file of a sink opened with OpenAuditFile. It does nothing for other sinks.
+func (s *AuditSink) Close() error {
+	if s.file == nil {
+		return nil
+	}
+
+	return s.file.Close()
+}
+
+// SetAuditSink records every POST, PATCH and DELETE request sent by the client in sink, with its
+// outcome. The attributes of the resources the client fetches are remembered, so that the records
+// of later updates list the attributes they change. Requests captured in dry-run mode are not
+// recorded, as they change nothing. Pass nil to stop recording.
+//
+// If a record cannot be written, the request method returns an error even though the request was
+// sent, so that unaudited changes don't go unnoticed.
+func (c *Client) SetAuditSink(sink *AuditSink) {
+	c.audit = sink
+}
+
+// VerifyAuditTrail reads the records of an audit trail written by an AuditSink, and checks their
+// sequence numbers and hash chain. It returns the number of records read. If the chain is broken,
+// the error wraps ErrAuditTampered and reports the line of the first invalid record.
+func VerifyAuditTrail(r io.Reader) (int64, error) {
+	last, err := verifyAuditTrail(r)
+
+	return last.Sequence, err
+}
+
+func verifyAuditTrail(r io.Reader) (AuditRecord, error) {
+	var (
+		last    AuditRecord
+		scanner = bufio.NewScanner(r)
+		line    int
+	)
+
+	scanner.Buffer(nil, 64<<20)
+
+	for scanner.Scan() {
+		line++
+
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return last, fmt.Errorf("%w: line %d: %w", ErrAuditTampered, line, err)
+		}
+
+		switch {
+		case record.Sequence != last.Sequence+1:
+			return last, fmt.Errorf("%w: line %d: sequence %d follows %d", ErrAuditTampered, line, record.Sequence, last.Sequence)
+		case record.PrevHash != last.Hash:
+			return last, fmt.Errorf("%w: line %d: previous hash does not match", ErrAuditTampered, line)
+		}
+
+		hash, err := record.hash()
+		if err != nil {
+			return last, err
+		}
+
+		if hash != record.Hash {
+			return last, fmt.Errorf("%w: line %d: hash does not match", ErrAuditTampered, line)
+		}
+
+		last = record
+	}
+
+	return last, scanner.Err()
+}
+
+// hash returns the hash of the record without its Hash field.
+func (r AuditRecord) hash() (string, error) {
+	r.Hash = ""
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// write chains the record to the trail and writes it as a line.
+func (s *AuditSink) write(record *AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record.Sequence = s.seq + 1
+	record.PrevHash = s.last
+
+	hash, err := record.hash()
+	if err != nil {
+		return err
+	}
+
+	record.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		if err := s.file.Sync(); err != nil {
+			return err
+		}
+	}
+
+	s.seq = record.Sequence
+	s.last = record.Hash
+
+	return nil
+}
+
+// audited sends a mutating request and records it in the audit sink.
+func (c *Client) audited(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	sink := c.audit
+	record := &AuditRecord{
+		Time:   sink.now().UTC(),
+		Method: req.Method,
+		Path:   c.resourcePath(req.URL),
+	}
+
+	record.ResourceType, record.ResourceID, record.Relationship = parseResourcePath(record.Path)
+
+	if req.GetBody != nil && req.ContentLength > 0 {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			record.Body = bytes.TrimSpace(data)
+		}
+	}
+
+	if req.Method == http.MethodPatch && record.Relationship == "" {
+		record.Diff = sink.states.diff(record.ResourceType, record.ResourceID, record.Body)
+	}
+
+	sink.redact(record)
+
+	resp, err := c.send(ctx, req, v)
+	if err == nil {
+		err = c.checkSchema(ctx, req, v)
+	}
+
+	sent := req
+	if resp != nil {
+		record.Status = resp.StatusCode
+
+		if resp.Request != nil {
+			sent = resp.Request
+		}
+	}
+
+	record.KeyID, record.IssuerID = tokenIssuer(sent.Header.Get("Authorization"))
+
+	if err != nil {
+		record.Error = err.Error()
+
+		var erro *ErrorResponse
+		if errors.As(err, &erro) {
+			for _, e := range erro.Errors {
+				if e.ID != nil {
+					record.ErrorIDs = append(record.ErrorIDs, *e.ID)
+				}
+			}
+		}
+	} else {
+		c.updateAuditState(record, v)
+	}
+
+	if auditErr := sink.write(record); auditErr != nil {
+		return resp, errors.Join(err, fmt.Errorf("writing audit record: %w", auditErr))
+	}
+
+	return resp, err
+}
+
+// redact replaces the values of redacted attributes in the body and diff of a record.
+func (s *AuditSink) redact(record *AuditRecord) {
+	record.Body = s.redactJSON(record.Body)
+
+	for i, change := range record.Diff {
+		if s.redacted.redacted[strings.ToLower(change.Attribute)] {
+			change.Old = json.RawMessage(`"` + redacted + `"`)
+			change.New = json.RawMessage(`"` + redacted + `"`)
+		} else {
+			change.Old = s.redactJSON(change.Old)
+			change.New = s.redactJSON(change.New)
+		}
+
+		record.Diff[i] = change
+	}
+}
+
+// redactJSON redacts a JSON value as logged bodies are. Values that are not valid JSON are kept.
+func (s *AuditSink) redactJSON(data json.RawMessage) json.RawMessage {
+	if len(data) == 0 {
+		return data
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return data
+	}
+
+	redactedData, err := json.Marshal(s.redacted.redact(v))
+	if err != nil {
+		return data
+	}
+
+	return redactedData
+}
+
+// updateAuditState remembers the resources of a successful response, or forgets a deleted one.
+func (c *Client) updateAuditState(record *AuditRecord, v interface{}) {
+	states := c.audit.states
+
+	if record.Method == http.MethodDelete && record.Relationship == "" {
+		states.forget(record.ResourceType, record.ResourceID)
+
+		return
+	}
+
+	if created := states.remember(v); record.Method == http.MethodPost && record.Relationship == "" && len(created) == 1 {
+		record.ResourceID = created[0]
+	}
+}
+
+// rememberFetched remembers the attributes of the resources of a GET response, for the diffs of
+// the audit trail.
+func (c *Client) rememberFetched(req *http.Request, v interface{}) {
+	if c.audit != nil && req.Method == http.MethodGet {
+		c.audit.states.remember(v)
+	}
+}
+
+// tokenIssuer returns the key ID and issuer ID of the JWT of an Authorization header. The token
+// is not verified.
+func tokenIssuer(authorization string) (keyID string, issuerID string) {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok {
+		return "", ""
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ""
+	}
+
+	var (
+		header struct {
+			KeyID string `json:"kid"`
+		}
+		claims struct {
+			Issuer string `json:"iss"`
+		}
+	)
+
+	if data, err := base64.RawURLEncoding.DecodeString(parts[0]); err == nil {
+		_ = json.Unmarshal(data, &header)
+	}
+
+	if data, err := base64.RawURLEncoding.DecodeString(parts[1]); err == nil {
+		_ = json.Unmarshal(data, &claims)
+	}
+
+	return header.KeyID, claims.Issuer
+}
+
+// auditState holds the attributes of the resources fetched by a client, by type and ID, dropping
+// the oldest beyond auditStateLimit.
+type auditState struct {
+	mu         sync.Mutex
+	attributes map[string]map[string]json.RawMessage
+	order      []string
+}
+
+type auditResource struct {
+	Type       string                     `json:"type"`
+	ID         string                     `json:"id"`
+	Attributes map[string]json.RawMessage `json:"attributes"`
+}
+
+func newAuditState() *auditState {
+	return &auditState{attributes: map[string]map[string]json.RawMessage{}}
+}
+
+// remember stores the attributes of the resources of a response document, and returns their IDs.
+func (s *auditState) remember(v interface{}) []string {
+	if v == nil {
+		return nil
+	}
+
+	if _, ok := v.(io.Writer); ok {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var document struct {
+		Data     json.RawMessage `json:"data"`
+		Included []auditResource `json:"included"`
+	}
+
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil
+	}
+
+	var resources []auditResource
+
+	var one auditResource
+	if err := json.Unmarshal(document.Data, &one); err == nil {
+		resources = append(resources, one)
+	} else {
+		_ = json.Unmarshal(document.Data, &resources)
+	}
+
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, resource := range append(resources, document.Included...) {
+		if resource.Type == "" || resource.ID == "" || resource.Attributes == nil {
+			continue
+		}
+
+		key := resource.Type + "/" + resource.ID
+		if _, ok := s.attributes[key]; !ok {
+			s.order = append(s.order, key)
+		}
+
+		s.attributes[key] = resource.Attributes
+	}
+
+	for len(s.order) > auditStateLimit {
+		delete(s.attributes, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	return ids
+}
+
+func (s *auditState) forget(typ string, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attributes, typ+"/"+id)
+}
+
+// diff returns the attributes the update request body changes, compared to the last fetched state
+// of the resource, or nil if it was not fetched.
+func (s *auditState) diff(typ string, id string, body []byte) []AuditChange {
+	var request struct {
+		Data auditResource `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &request); err != nil || len(request.Data.Attributes) == 0 {
+		return nil
+	}
+
+	if request.Data.Type != "" {
+		typ = request.Data.Type
+	}
+
+	s.mu.Lock()
+	old, ok := s.attributes[typ+"/"+id]
+	s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	var changes []AuditChange
+
+	for _, name := range sortedKeys(request.Data.Attributes) {
+		value := request.Data.Attributes[name]
+
+		previous, ok := old[name]
+		if !ok {
+			previous = json.RawMessage("null")
+		}
+
+		if jsonEqual(previous, value) {
+			continue
+		}
+
+		changes = append(changes, AuditChange{Attribute: name, Old: previous, New: value})
+	}
+
+	return changes
+}
+
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return bytes.Equal(a, b)
+	}
+
+	ax, _ := json.Marshal(x)
+	by, _ := json.Marshal(y)
+
+	return bytes.Equal(ax, by)
+}
@@ -0,0 +1,340 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newAuditServer(t *testing.T) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"data":{"type":"appStoreVersions","id":"10","attributes":{"versionString":"1.0","copyright":"2020 Sky"}}}`))
+		case r.Method == http.MethodPatch:
+			_, _ = w.Write([]byte(`{"data":{"type":"appStoreVersions","id":"10","attributes":{"versionString":"2.0","copyright":"2020 Sky"}}}`))
+		case r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"type":"betaGroups","id":"g1","attributes":{"name":"QA"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"id":"e-1","status":"404","code":"NOT_FOUND","title":"Not found"}]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	auth, err := NewTokenConfigWithSigner("KEY1", "ISSUER1", time.Minute, key)
+	assert.NoError(t, err)
+
+	client := NewClient(auth.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	return client
+}
+
+func readAuditRecords(t *testing.T, r io.Reader) []AuditRecord {
+	t.Helper()
+
+	var records []AuditRecord
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var record AuditRecord
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func TestAuditTrail(t *testing.T) {
+	t.Parallel()
+
+	client := newAuditServer(t)
+
+	var buf bytes.Buffer
+
+	sink := NewAuditSink(&buf)
+	sink.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	client.SetAuditSink(sink)
+
+	ctx := context.Background()
+
+	_, _, err := client.Apps.GetAppStoreVersion(ctx, "10", nil)
+	assert.NoError(t, err)
+
+	_, _, err = client.Apps.UpdateAppStoreVersion(ctx, "10", &AppStoreVersionUpdateRequestAttributes{
+		VersionString: String("2.0"),
+		Copyright:     String("2020 Sky"),
+	}, nil)
+	assert.NoError(t, err)
+
+	_, _, err = client.TestFlight.CreateBetaGroup(ctx, BetaGroupCreateRequestAttributes{Name: "QA"}, "1", nil, nil)
+	assert.NoError(t, err)
+
+	resp, err := client.Provisioning.RevokeCertificate(ctx, "c1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	n, err := VerifyAuditTrail(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	records := readAuditRecords(t, &buf)
+	if !assert.Len(t, records, 3) {
+		return
+	}
+
+	update := records[0]
+	assert.Equal(t, int64(1), update.Sequence)
+	assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), update.Time)
+	assert.Equal(t, "KEY1", update.KeyID)
+	assert.Equal(t, "ISSUER1", update.IssuerID)
+	assert.Equal(t, "PATCH", update.Method)
+	assert.Equal(t, "appStoreVersions/10", update.Path)
+	assert.Equal(t, "appStoreVersions", update.ResourceType)
+	assert.Equal(t, "10", update.ResourceID)
+	assert.Contains(t, string(update.Body), `"versionString":"2.0"`)
+	assert.Equal(t, []AuditChange{{
+		Attribute: "versionString",
+		Old:       json.RawMessage(`"1.0"`),
+		New:       json.RawMessage(`"2.0"`),
+	}}, update.Diff)
+	assert.Equal(t, http.StatusOK, update.Status)
+	assert.Empty(t, update.PrevHash)
+	assert.Len(t, update.Hash, 64)
+
+	create := records[1]
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "betaGroups", create.ResourceType)
+	assert.Equal(t, "g1", create.ResourceID)
+	assert.Nil(t, create.Diff)
+	assert.Equal(t, http.StatusCreated, create.Status)
+	assert.Equal(t, update.Hash, create.PrevHash)
+
+	revoke := records[2]
+	assert.Equal(t, "DELETE", revoke.Method)
+	assert.Equal(t, "c1", revoke.ResourceID)
+	assert.Equal(t, http.StatusNotFound, revoke.Status)
+	assert.Equal(t, []string{"e-1"}, revoke.ErrorIDs)
+	assert.NotEmpty(t, revoke.Error)
+	assert.Equal(t, create.Hash, revoke.PrevHash)
+}
+
+func TestAuditTrailRedaction(t *testing.T) {
+	t.Parallel()
+
+	client := newAuditServer(t)
+
+	var buf bytes.Buffer
+
+	client.SetAuditSink(NewAuditSink(&buf))
+
+	ctx := context.Background()
+
+	_, err := client.Do(ctx, http.MethodPost, "betaTesters", nil, map[string]interface{}{
+		"type":       "betaTesters",
+		"attributes": map[string]string{"email": "tester@example.com", "firstName": "Jane", "inviteType": "EMAIL"},
+	}, nil)
+	assert.NoError(t, err)
+
+	records := readAuditRecords(t, &buf)
+	assert.Len(t, records, 1)
+	assert.JSONEq(t, `{"data":{"type":"betaTesters","attributes":{"email":"[REDACTED]","firstName":"[REDACTED]","inviteType":"EMAIL"}}}`, string(records[0].Body))
+
+	custom := NewAuditSink(&buf, WithAuditRedactedFields("versionString"))
+	client.SetAuditSink(custom)
+
+	_, _, err = client.Apps.GetAppStoreVersion(ctx, "10", nil)
+	assert.NoError(t, err)
+
+	_, _, err = client.Apps.UpdateAppStoreVersion(ctx, "10", &AppStoreVersionUpdateRequestAttributes{
+		VersionString: String("2.0"),
+		Copyright:     String("2021 Sky"),
+	}, nil)
+	assert.NoError(t, err)
+
+	records = readAuditRecords(t, &buf)
+	assert.Len(t, records, 1)
+	assert.NotContains(t, string(records[0].Body), "2.0")
+	assert.Equal(t, []AuditChange{
+		{Attribute: "copyright", Old: json.RawMessage(`"2020 Sky"`), New: json.RawMessage(`"2021 Sky"`)},
+		{Attribute: "versionString", Old: json.RawMessage(`"[REDACTED]"`), New: json.RawMessage(`"[REDACTED]"`)},
+	}, records[0].Diff)
+}
+
+func TestAuditTrailResourcePaths(t *testing.T) {
+	t.Parallel()
+
+	client := newAuditServer(t)
+	assert.NoError(t, client.SetBaseURL(client.baseURL.Scheme+"://"+client.baseURL.Host+"/proxy/asc/"))
+
+	var buf bytes.Buffer
+
+	client.SetAuditSink(NewAuditSink(&buf))
+
+	ctx := context.Background()
+
+	_, err := client.Do(ctx, http.MethodPost, "betaGroups/1/relationships/builds", nil, map[string]interface{}{}, nil)
+	assert.NoError(t, err)
+	_, err = client.Do(ctx, http.MethodPatch, "v2/inAppPurchases/5", nil, map[string]interface{}{}, nil)
+	assert.NoError(t, err)
+
+	records := readAuditRecords(t, &buf)
+	if !assert.Len(t, records, 2) {
+		return
+	}
+
+	assert.Equal(t, "betaGroups/1/relationships/builds", records[0].Path)
+	assert.Equal(t, "v2/inAppPurchases/5", records[1].Path)
+
+	for i, want := range [][3]string{{"betaGroups", "1", "builds"}, {"inAppPurchases", "5", ""}} {
+		assert.Equal(t, want, [3]string{records[i].ResourceType, records[i].ResourceID, records[i].Relationship})
+		assert.Equal(t, resourceTypes(records[i].Path)[0], records[i].ResourceType, "audit and cache classify paths the same way")
+	}
+}
+
+func TestAuditTrailSkipsDryRun(t *testing.T) {
+	t.Parallel()
+
+	client := newAuditServer(t)
+
+	var buf bytes.Buffer
+
+	client.SetAuditSink(NewAuditSink(&buf))
+	client.SetDryRun(true)
+
+	_, err := client.Provisioning.RevokeCertificate(context.Background(), "c1")
+	assert.NoError(t, err)
+	assert.Zero(t, buf.Len())
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestAuditTrailWriteFailure(t *testing.T) {
+	t.Parallel()
+
+	client := newAuditServer(t)
+	client.SetAuditSink(NewAuditSink(failingWriter{}))
+
+	res, resp, err := client.TestFlight.CreateBetaGroup(context.Background(), BetaGroupCreateRequestAttributes{Name: "QA"}, "1", nil, nil)
+	assert.ErrorContains(t, err, "writing audit record: disk full")
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "g1", res.Data.ID)
+}
+
+func TestOpenAuditFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	for i := 0; i < 2; i++ {
+		sink, err := OpenAuditFile(path)
+		assert.NoError(t, err)
+
+		for j := 0; j < 2; j++ {
+			assert.NoError(t, sink.write(&AuditRecord{Method: "DELETE", Path: "certificates/c1"}))
+		}
+
+		assert.NoError(t, sink.Close())
+	}
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	n, err := VerifyAuditTrail(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+
+	records := readAuditRecords(t, bytes.NewReader(data))
+	assert.Equal(t, int64(3), records[2].Sequence)
+	assert.Equal(t, records[1].Hash, records[2].PrevHash)
+
+	tampered := strings.Replace(string(data), "certificates/c1", "certificates/c2", 1)
+	assert.NoError(t, os.WriteFile(path, []byte(tampered), 0o600))
+
+	_, err = OpenAuditFile(path)
+	assert.ErrorIs(t, err, ErrAuditTampered)
+	assert.ErrorContains(t, err, "line 1: hash does not match")
+}
+
+func TestVerifyAuditTrailTampering(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	sink := NewAuditSink(&buf)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, sink.write(&AuditRecord{Method: "POST", Path: "betaGroups"}))
+	}
+
+	lines := strings.SplitAfter(buf.String(), "\n")
+
+	_, err := VerifyAuditTrail(strings.NewReader(lines[0] + lines[2]))
+	assert.ErrorIs(t, err, ErrAuditTampered)
+	assert.ErrorContains(t, err, "line 2: sequence 3 follows 1")
+
+	_, err = VerifyAuditTrail(strings.NewReader(lines[1] + lines[2]))
+	assert.ErrorContains(t, err, "line 1: sequence 2 follows 0")
+
+	_, err = VerifyAuditTrail(strings.NewReader("{"))
+	assert.ErrorIs(t, err, ErrAuditTampered)
+}
+
+func TestTokenIssuer(t *testing.T) {
+	t.Parallel()
+
+	keyID, issuerID := tokenIssuer("Bearer eyJhbGciOiJFUzI1NiIsImtpZCI6IktFWSJ9.eyJpc3MiOiJJU1MifQ.c2ln")
+	assert.Equal(t, "KEY", keyID)
+	assert.Equal(t, "ISS", issuerID)
+
+	keyID, issuerID = tokenIssuer("Basic abc")
+	assert.Empty(t, keyID)
+	assert.Empty(t, issuerID)
+}
@@ -21,25 +21,49 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/dgrijalva/jwt-go/v4"
 )
 
+// MaxTokenLifetime is the longest lifetime App Store Connect accepts for a token. Longer lifetimes
+// passed to NewTokenConfig are reduced to it.
+const MaxTokenLifetime = 20 * time.Minute
+
+// DefaultTokenRefreshMargin is how long before it expires a token is replaced by default.
+const DefaultTokenRefreshMargin = time.Minute
+
 // ErrMissingPEM happens when the bytes cannot be decoded as a PEM block.
 var ErrMissingPEM = errors.New("no PEM blob found")
 
 // ErrInvalidPrivateKey happens when a key cannot be parsed as a ECDSA PKCS8 private key.
 var ErrInvalidPrivateKey = errors.New("key could not be parsed as a valid ecdsa.PrivateKey")
 
+// ErrInvalidSigner happens when a crypto.Signer does not hold a P-256 ECDSA key, which is the only
+// kind of key App Store Connect accepts.
+var ErrInvalidSigner = errors.New("signer does not hold a P-256 ECDSA key")
+
+// ErrInvalidScope happens when a token scope is not a GET request to an API path, such as
+// "GET /v1/apps".
+var ErrInvalidScope = errors.New("token scope must be a GET request to an API path")
+
+// ErrOutOfScope happens when a request is sent with a scoped token that does not allow it. The
+// request is rejected before it is sent.
+var ErrOutOfScope = errors.New("request is outside of the scope of the token")
+
 // AuthTransport is an http.RoundTripper implementation that stores the JWT created.
-// If the token expires, the Rotate function should be called to update the stored token.
+// The token is regenerated shortly before it expires. An AuthTransport is safe for concurrent use.
 type AuthTransport struct {
 	Transport    http.RoundTripper
 	jwtGenerator jwtGenerator
@@ -54,26 +78,95 @@ type standardJWTGenerator struct {
 	keyID          string
 	issuerID       string
 	expireDuration time.Duration
-	privateKey     *ecdsa.PrivateKey
+	refreshMargin  time.Duration
+	signer         crypto.Signer
+	scope          []string
+	now            func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// TokenOption customizes the tokens created by an AuthTransport.
+type TokenOption func(*standardJWTGenerator)
 
-	token string
+// WithRefreshMargin sets how long before it expires a token is replaced by a new one, so that
+// requests in flight or retried are not sent with a token that expires on the way. It defaults to
+// DefaultTokenRefreshMargin, and is reduced to half of the lifetime of the token if it is longer.
+func WithRefreshMargin(margin time.Duration) TokenOption {
+	return func(g *standardJWTGenerator) {
+		g.refreshMargin = margin
+	}
+}
+
+// WithScope restricts tokens to the given GET requests, such as "GET /v1/apps" or
+// "GET /v1/salesReports?filter[vendorNumber]=123". App Store Connect rejects a scoped token used
+// for any other request, and the AuthTransport rejects such requests before sending them, with
+// ErrOutOfScope. A request matches a scope if it has the same path and every query parameter of
+// the scope; it may have other query parameters, such as a cursor.
+func WithScope(scope ...string) TokenOption {
+	return func(g *standardJWTGenerator) {
+		g.scope = append(g.scope, scope...)
+	}
 }
 
 // NewTokenConfig returns a new AuthTransport instance that customizes the Authentication header of the request during transport.
 // It can be customized further by supplying a custom http.RoundTripper instance to the Transport field.
-func NewTokenConfig(keyID string, issuerID string, expireDuration time.Duration, privateKey []byte) (*AuthTransport, error) {
+//
+// The private key is the PKCS#8 PEM file downloaded from App Store Connect. Tokens are valid for
+// expireDuration, up to MaxTokenLifetime, which is also used when expireDuration is not positive.
+func NewTokenConfig(keyID string, issuerID string, expireDuration time.Duration, privateKey []byte, opts ...TokenOption) (*AuthTransport, error) {
 	key, err := parsePrivateKey(privateKey)
 	if err != nil {
 		return nil, err
 	}
 
+	return NewTokenConfigWithSigner(keyID, issuerID, expireDuration, key, opts...)
+}
+
+// NewIndividualTokenConfig is like NewTokenConfig, but for an individual API key, which acts on
+// behalf of the user who created it and has no issuer ID.
+func NewIndividualTokenConfig(keyID string, expireDuration time.Duration, privateKey []byte, opts ...TokenOption) (*AuthTransport, error) {
+	return NewTokenConfig(keyID, "", expireDuration, privateKey, opts...)
+}
+
+// NewTokenConfigWithSigner is like NewTokenConfig, but signs tokens with any crypto.Signer holding
+// a P-256 ECDSA key, such as a key kept in a KMS, an HSM or an agent, instead of a PEM file. An
+// empty issuerID creates tokens for an individual API key.
+func NewTokenConfigWithSigner(keyID string, issuerID string, expireDuration time.Duration, signer crypto.Signer, opts ...TokenOption) (*AuthTransport, error) {
+	if pub, ok := signer.Public().(*ecdsa.PublicKey); !ok || pub.Curve != elliptic.P256() {
+		return nil, ErrInvalidSigner
+	}
+
+	if expireDuration <= 0 || expireDuration > MaxTokenLifetime {
+		expireDuration = MaxTokenLifetime
+	}
+
 	gen := &standardJWTGenerator{
 		keyID:          keyID,
 		issuerID:       issuerID,
-		privateKey:     key,
+		signer:         signer,
 		expireDuration: expireDuration,
+		refreshMargin:  DefaultTokenRefreshMargin,
+		now:            time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(gen)
 	}
-	_, err = gen.Token()
+
+	if gen.refreshMargin > gen.expireDuration/2 {
+		gen.refreshMargin = gen.expireDuration / 2
+	}
+
+	for _, scope := range gen.scope {
+		if _, _, err := parseScope(scope); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := gen.Token()
 
 	return &AuthTransport{
 		Transport:    newTransport(),
@@ -101,6 +194,12 @@ func parsePrivateKey(blob []byte) (*ecdsa.PrivateKey, error) {
 
 // RoundTrip implements the http.RoundTripper interface to set the Authorization header.
 func (t AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if gen, ok := t.jwtGenerator.(*standardJWTGenerator); ok {
+		if err := gen.allows(req); err != nil {
+			return nil, err
+		}
+	}
+
 	token, err := t.jwtGenerator.Token()
 	if err != nil {
 		return nil, err
@@ -124,50 +223,115 @@ func (t *AuthTransport) transport() http.RoundTripper {
 	return t.Transport
 }
 
+// Token returns the current token, or signs a new one if the current token expires within the
+// refresh margin. Concurrent callers wait for a single new token to be signed.
 func (g *standardJWTGenerator) Token() (string, error) {
-	if g.IsValid() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := g.now()
+	if g.token != "" && now.Before(g.expiresAt.Add(-g.refreshMargin)) {
 		return g.token, nil
 	}
 
-	t := jwt.NewWithClaims(jwt.SigningMethodES256, g.claims())
+	expiresAt := now.Add(g.expireDuration)
+
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, g.claims(now, expiresAt))
 	t.Header["kid"] = g.keyID
 
-	token, err := t.SignedString(g.privateKey)
+	token, err := t.SignedString(g.signer)
 	if err != nil {
 		return "", err
 	}
 
 	g.token = token
+	g.expiresAt = expiresAt
 
 	return token, nil
 }
 
+// IsValid reports whether the current token has not expired yet.
 func (g *standardJWTGenerator) IsValid() bool {
-	if g.token == "" {
-		return false
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.token != "" && g.now().Before(g.expiresAt)
+}
+
+// tokenClaims are the claims of a token. Team keys have an issuer, while individual keys have the
+// "user" subject instead.
+type tokenClaims struct {
+	jwt.StandardClaims
+	Scope []string `json:"scope,omitempty"`
+}
+
+func (g *standardJWTGenerator) claims(issuedAt time.Time, expiresAt time.Time) jwt.Claims {
+	claims := tokenClaims{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
+			Issuer:    g.issuerID,
+			IssuedAt:  jwt.At(issuedAt),
+			ExpiresAt: jwt.At(expiresAt),
+		},
+		Scope: g.scope,
+	}
+
+	if g.issuerID == "" {
+		claims.Subject = "user"
 	}
 
-	parsed, err := jwt.Parse(
-		g.token,
-		jwt.KnownKeyfunc(jwt.SigningMethodES256, g.privateKey),
-		jwt.WithAudience("appstoreconnect-v1"),
-		jwt.WithIssuer(g.issuerID),
-	)
+	return claims
+}
+
+// allows returns ErrOutOfScope if the token is scoped and none of its scopes match req.
+func (g *standardJWTGenerator) allows(req *http.Request) error {
+	if len(g.scope) == 0 {
+		return nil
+	}
+
+	for _, scope := range g.scope {
+		if scopeMatches(scope, req) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s %s", ErrOutOfScope, req.Method, req.URL.Path)
+}
+
+// parseScope splits a scope such as "GET /v1/apps?filter[platform]=IOS" into its path and query.
+func parseScope(scope string) (string, url.Values, error) {
+	method, target, ok := strings.Cut(scope, " ")
+	if !ok || method != http.MethodGet || !strings.HasPrefix(target, "/") {
+		return "", nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+	}
+
+	path, rawQuery, _ := strings.Cut(target, "?")
+
+	query, err := url.ParseQuery(rawQuery)
 	if err != nil {
-		return false
+		return "", nil, fmt.Errorf("%w: %q: %w", ErrInvalidScope, scope, err)
 	}
 
-	return parsed.Valid
+	return path, query, nil
 }
 
-func (g *standardJWTGenerator) claims() jwt.Claims {
-	expiry := time.Now().Add(g.expireDuration)
+// scopeMatches reports whether req is a GET request to the path of the scope, with every query
+// parameter of the scope. The path may be preceded by the path of a proxy.
+func scopeMatches(scope string, req *http.Request) bool {
+	path, query, err := parseScope(scope)
+	if err != nil || req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, path) {
+		return false
+	}
+
+	reqQuery := req.URL.Query()
 
-	return jwt.StandardClaims{
-		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
-		Issuer:    g.issuerID,
-		ExpiresAt: jwt.At(expiry),
+	for name, values := range query {
+		if strings.Join(reqQuery[name], ",") != strings.Join(values, ",") {
+			return false
+		}
 	}
+
+	return true
 }
 
 func newTransport() http.RoundTripper {
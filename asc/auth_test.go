@@ -21,12 +21,22 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/dgrijalva/jwt-go/v4"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -120,6 +130,184 @@ func TestAuthTransportCustomTransport(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestTokenRefresh(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signer := &countingSigner{Signer: key}
+
+	auth, err := NewTokenConfigWithSigner("TEST", "TEST", time.Hour, signer, WithRefreshMargin(2*time.Minute))
+	assert.NoError(t, err)
+
+	gen := auth.jwtGenerator.(*standardJWTGenerator)
+	assert.Equal(t, MaxTokenLifetime, gen.expireDuration)
+
+	now := time.Now()
+	gen.now = func() time.Time { return now }
+	gen.token = ""
+
+	tok, err := gen.Token()
+	assert.NoError(t, err)
+	assert.True(t, gen.IsValid())
+
+	claims := new(jwt.StandardClaims)
+	_, err = jwt.ParseWithClaims(tok, claims, jwt.KnownKeyfunc(jwt.SigningMethodES256, &key.PublicKey), jwt.WithAudience("appstoreconnect-v1"))
+	assert.NoError(t, err)
+	assert.Equal(t, now.Unix(), claims.IssuedAt.Unix())
+	assert.Equal(t, now.Add(MaxTokenLifetime).Unix(), claims.ExpiresAt.Unix())
+	assert.Equal(t, "TEST", claims.Issuer)
+
+	now = now.Add(17 * time.Minute)
+	cached, err := gen.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, tok, cached)
+
+	now = now.Add(time.Minute + time.Second)
+	refreshed, err := gen.Token()
+	assert.NoError(t, err)
+	assert.NotEqual(t, tok, refreshed)
+	assert.Equal(t, int32(3), signer.calls.Load())
+
+	now = now.Add(MaxTokenLifetime)
+	assert.False(t, gen.IsValid())
+}
+
+func TestTokenConcurrentUse(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signer := &countingSigner{Signer: key}
+
+	auth, err := NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, signer)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := auth.jwtGenerator.Token()
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int32(1), signer.calls.Load())
+}
+
+func TestNewTokenConfigWithSignerRejectsNonECDSAKeys(t *testing.T) {
+	t.Parallel()
+
+	key, _ := rsa.GenerateKey(rand.Reader, 1024)
+	_, err := NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, key)
+	assert.ErrorIs(t, err, ErrInvalidSigner)
+
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	_, err = NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, p384)
+	assert.ErrorIs(t, err, ErrInvalidSigner)
+}
+
+func TestIndividualTokenConfig(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	auth, err := NewTokenConfigWithSigner("TEST", "", 20*time.Minute, key)
+	assert.NoError(t, err)
+
+	tok, err := auth.jwtGenerator.Token()
+	assert.NoError(t, err)
+
+	claims := new(tokenClaims)
+	_, err = jwt.ParseWithClaims(tok, claims, jwt.KnownKeyfunc(jwt.SigningMethodES256, &key.PublicKey), jwt.WithAudience("appstoreconnect-v1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "user", claims.Subject)
+	assert.Empty(t, claims.Issuer)
+	assert.Empty(t, claims.Scope)
+}
+
+func TestScopedToken(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	scope := []string{"GET /v1/apps", "GET /v1/salesReports?filter[vendorNumber]=123"}
+
+	auth, err := NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, key, WithScope(scope...))
+	assert.NoError(t, err)
+
+	tok, err := auth.jwtGenerator.Token()
+	assert.NoError(t, err)
+
+	claims := new(tokenClaims)
+	_, err = jwt.ParseWithClaims(tok, claims, jwt.KnownKeyfunc(jwt.SigningMethodES256, &key.PublicKey), jwt.WithAudience("appstoreconnect-v1"))
+	assert.NoError(t, err)
+	assert.Equal(t, scope, claims.Scope)
+
+	var sent []string
+
+	auth.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = append(sent, req.URL.RequestURI())
+
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	client := auth.Client()
+
+	for _, target := range []string{
+		"https://api.appstoreconnect.apple.com/v1/apps?cursor=abc",
+		"https://api.appstoreconnect.apple.com/v1/salesReports?filter[vendorNumber]=123&filter[frequency]=DAILY",
+	} {
+		resp, err := client.Get(target) // nolint: noctx
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	for _, target := range []string{
+		"https://api.appstoreconnect.apple.com/v1/builds",
+		"https://api.appstoreconnect.apple.com/v1/salesReports?filter[vendorNumber]=456",
+	} {
+		_, err := client.Get(target) // nolint: noctx,bodyclose
+		assert.ErrorIs(t, err, ErrOutOfScope)
+	}
+
+	_, err = client.Post("https://api.appstoreconnect.apple.com/v1/apps", "application/json", nil) // nolint: noctx,bodyclose
+	assert.ErrorIs(t, err, ErrOutOfScope)
+
+	assert.Len(t, sent, 2)
+}
+
+func TestScopedTokenInvalidScope(t *testing.T) {
+	t.Parallel()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	_, err := NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, key, WithScope("PATCH /v1/apps/1"))
+	assert.ErrorIs(t, err, ErrInvalidScope)
+
+	_, err = NewTokenConfigWithSigner("TEST", "TEST", 20*time.Minute, key, WithScope("apps"))
+	assert.ErrorIs(t, err, ErrInvalidScope)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type countingSigner struct {
+	crypto.Signer
+	calls atomic.Int32
+}
+
+func (s *countingSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.calls.Add(1)
+
+	return s.Signer.Sign(rand, digest, opts)
+}
+
 type mockJWTGenerator struct {
 	token string
 }
@@ -55,6 +55,8 @@ type BuildAttributes struct {
 	UploadedDate            *DateTime   `json:"uploadedDate,omitempty"`
 	UsesNonExemptEncryption *bool       `json:"usesNonExemptEncryption,omitempty"`
 	Version                 *string     `json:"version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BuildRelationships defines model for Build.Relationships
@@ -70,6 +72,8 @@ type BuildRelationships struct {
 	Icons                    *PagedRelationship `json:"icons,omitempty"`
 	IndividualTesters        *PagedRelationship `json:"individualTesters,omitempty"`
 	PreReleaseVersion        *Relationship      `json:"preReleaseVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BuildResponse defines model for BuildResponse.
@@ -141,104 +145,112 @@ type BuildIndividualTestersLinkagesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_builds
 type ListBuildsQuery struct {
-	FieldsAppEncryptionDeclarations          []string `url:"fields[appEncryptionDeclarations],omitempty"`
-	FieldsApps                               []string `url:"fields[apps],omitempty"`
-	FieldsBetaTesters                        []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds                             []string `url:"fields[builds],omitempty"`
-	FieldsPreReleaseVersions                 []string `url:"fields[preReleaseVersions],omitempty"`
-	FieldsBuildBetaDetails                   []string `url:"fields[buildBetaDetails],omitempty"`
-	FieldsBetaAppReviewSubmissions           []string `url:"fields[betaAppReviewSubmissions],omitempty"`
-	FieldsBetaBuildLocalizations             []string `url:"fields[betaBuildLocalizations],omitempty"`
-	FieldsDiagnosticSignatures               []string `url:"fields[diagnosticSignatures],omitempty"`
-	FieldsAppStoreVersions                   []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsPerfPowerMetrics                   []string `url:"fields[perfPowerMetrics],omitempty"`
-	FieldsBuildIcons                         []string `url:"fields[buildIcons],omitempty"`
-	FilterApp                                []string `url:"filter[app],omitempty"`
-	FilterExpired                            []string `url:"filter[expired],omitempty"`
-	FilterID                                 []string `url:"filter[id],omitempty"`
-	FilterPreReleaseVersion                  []string `url:"filter[preReleaseVersion],omitempty"`
-	FilterProcessingState                    []string `url:"filter[processingState],omitempty"`
-	FilterVersion                            []string `url:"filter[version],omitempty"`
-	FilterUsesNonExemptEncryption            []string `url:"filter[usesNonExemptEncryption],omitempty"`
-	FilterPreReleaseVersionVersion           []string `url:"filter[preReleaseVersion.version],omitempty"`
-	FilterPreReleaseVersionPlatform          []string `url:"filter[preReleaseVersion.platform],omitempty"`
-	FilterBetaGroups                         []string `url:"filter[betaGroups],omitempty"`
-	FilterBetaAppReviewSubmissionReviewState []string `url:"filter[betaAppReviewSubmission.betaReviewState],omitempty"`
-	FilterAppStoreVersion                    []string `url:"filter[appStoreVersion],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	Sort                                     []string `url:"sort,omitempty"`
-	Limit                                    int      `url:"limit,omitempty"`
-	LimitIndividualTesters                   int      `url:"limit[individualTesters],omitempty"`
-	LimitBetaBuildLocalizations              int      `url:"limit[betaBuildLocalizations],omitempty"`
-	LimitIcons                               int      `url:"limit[icons],omitempty"`
-	Cursor                                   string   `url:"cursor,omitempty"`
+	FieldsAppEncryptionDeclarations          []string     `url:"fields[appEncryptionDeclarations],omitempty"`
+	FieldsApps                               []string     `url:"fields[apps],omitempty"`
+	FieldsBetaTesters                        []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds                             []string     `url:"fields[builds],omitempty"`
+	FieldsPreReleaseVersions                 []string     `url:"fields[preReleaseVersions],omitempty"`
+	FieldsBuildBetaDetails                   []string     `url:"fields[buildBetaDetails],omitempty"`
+	FieldsBetaAppReviewSubmissions           []string     `url:"fields[betaAppReviewSubmissions],omitempty"`
+	FieldsBetaBuildLocalizations             []string     `url:"fields[betaBuildLocalizations],omitempty"`
+	FieldsDiagnosticSignatures               []string     `url:"fields[diagnosticSignatures],omitempty"`
+	FieldsAppStoreVersions                   []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsPerfPowerMetrics                   []string     `url:"fields[perfPowerMetrics],omitempty"`
+	FieldsBuildIcons                         []string     `url:"fields[buildIcons],omitempty"`
+	FilterApp                                []string     `url:"filter[app],omitempty"`
+	FilterExpired                            []string     `url:"filter[expired],omitempty"`
+	FilterID                                 []string     `url:"filter[id],omitempty"`
+	FilterPreReleaseVersion                  []string     `url:"filter[preReleaseVersion],omitempty"`
+	FilterProcessingState                    []string     `url:"filter[processingState],omitempty"`
+	FilterVersion                            []string     `url:"filter[version],omitempty"`
+	FilterUsesNonExemptEncryption            []string     `url:"filter[usesNonExemptEncryption],omitempty"`
+	FilterPreReleaseVersionVersion           []string     `url:"filter[preReleaseVersion.version],omitempty"`
+	FilterPreReleaseVersionPlatform          []string     `url:"filter[preReleaseVersion.platform],omitempty"`
+	FilterBetaGroups                         []string     `url:"filter[betaGroups],omitempty"`
+	FilterBetaAppReviewSubmissionReviewState []string     `url:"filter[betaAppReviewSubmission.betaReviewState],omitempty"`
+	FilterAppStoreVersion                    []string     `url:"filter[appStoreVersion],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Sort                                     []string     `url:"sort,omitempty"`
+	Limit                                    int          `url:"limit,omitempty"`
+	LimitIndividualTesters                   int          `url:"limit[individualTesters],omitempty"`
+	LimitBetaBuildLocalizations              int          `url:"limit[betaBuildLocalizations],omitempty"`
+	LimitIcons                               int          `url:"limit[icons],omitempty"`
+	Cursor                                   string       `url:"cursor,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // ListBuildsForAppQuery are query options for ListBuildsForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_of_an_app
 type ListBuildsForAppQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
-	Limit        int      `url:"limit,omitempty"`
-	Cursor       string   `url:"cursor,omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Limit        int          `url:"limit,omitempty"`
+	Cursor       string       `url:"cursor,omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // GetBuildQuery are query options for GetBuilds
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_build_information
 type GetBuildQuery struct {
-	FieldsAppEncryptionDeclarations []string `url:"fields[appEncryptionDeclarations],omitempty"`
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	FieldsBetaTesters               []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds                    []string `url:"fields[builds],omitempty"`
-	FieldsPreReleaseVersions        []string `url:"fields[preReleaseVersions],omitempty"`
-	FieldsBuildBetaDetails          []string `url:"fields[buildBetaDetails],omitempty"`
-	FieldsBetaAppReviewSubmissions  []string `url:"fields[betaAppReviewSubmissions],omitempty"`
-	FieldsBetaBuildLocalizations    []string `url:"fields[betaBuildLocalizations],omitempty"`
-	FieldsDiagnosticSignatures      []string `url:"fields[diagnosticSignatures],omitempty"`
-	FieldsAppStoreVersions          []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsPerfPowerMetrics          []string `url:"fields[perfPowerMetrics],omitempty"`
-	FieldsBuildIcons                []string `url:"fields[buildIcons],omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	LimitIndividualTesters          int      `url:"limit[individualTesters],omitempty"`
-	LimitBetaBuildLocalizations     int      `url:"limit[betaBuildLocalizations],omitempty"`
-	LimitIcons                      int      `url:"limit[icons],omitempty"`
+	FieldsAppEncryptionDeclarations []string     `url:"fields[appEncryptionDeclarations],omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	FieldsBetaTesters               []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds                    []string     `url:"fields[builds],omitempty"`
+	FieldsPreReleaseVersions        []string     `url:"fields[preReleaseVersions],omitempty"`
+	FieldsBuildBetaDetails          []string     `url:"fields[buildBetaDetails],omitempty"`
+	FieldsBetaAppReviewSubmissions  []string     `url:"fields[betaAppReviewSubmissions],omitempty"`
+	FieldsBetaBuildLocalizations    []string     `url:"fields[betaBuildLocalizations],omitempty"`
+	FieldsDiagnosticSignatures      []string     `url:"fields[diagnosticSignatures],omitempty"`
+	FieldsAppStoreVersions          []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsPerfPowerMetrics          []string     `url:"fields[perfPowerMetrics],omitempty"`
+	FieldsBuildIcons                []string     `url:"fields[buildIcons],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	LimitIndividualTesters          int          `url:"limit[individualTesters],omitempty"`
+	LimitBetaBuildLocalizations     int          `url:"limit[betaBuildLocalizations],omitempty"`
+	LimitIcons                      int          `url:"limit[icons],omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // GetAppForBuildQuery are query options for GetAppForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_build
 type GetAppForBuildQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // GetAppStoreVersionForBuildQuery are query options for GetAppStoreVersionForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_information_of_a_build
 type GetAppStoreVersionForBuildQuery struct {
-	FieldsAppStoreVersions []string `url:"fields[appStoreVersions],omitempty"`
+	FieldsAppStoreVersions []string     `url:"fields[appStoreVersions],omitempty"`
+	Query                  QueryEncoder `url:"-"`
 }
 
 // GetBuildForAppStoreVersionQuery are query options for GetBuildForAppStoreVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_an_app_store_version
 type GetBuildForAppStoreVersionQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // ListResourceIDsForIndividualTestersForBuildQuery are query options for ListResourceIDsForIndividualTestersForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_resource_ids_of_individual_testers_for_a_build
 type ListResourceIDsForIndividualTestersForBuildQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // GetAppEncryptionDeclarationForBuildQuery are query options for GetAppEncryptionDeclarationForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_encryption_declaration_of_a_build
 type GetAppEncryptionDeclarationForBuildQuery struct {
-	FieldsAppEncryptionDeclarations []string `url:"fields[appEncryptionDeclarations],omitempty"`
+	FieldsAppEncryptionDeclarations []string     `url:"fields[appEncryptionDeclarations],omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // ListBuilds finds and lists builds for all apps in App Store Connect.
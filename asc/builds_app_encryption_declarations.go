@@ -70,6 +70,8 @@ type AppEncryptionDeclarationAttributes struct {
 	Platform                        *Platform                      `json:"platform,omitempty"`
 	UploadedDate                    *DateTime                      `json:"uploadedDate,omitempty"`
 	UsesEncryption                  *bool                          `json:"usesEncryption,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppEncryptionDeclarationRelationships defines model for AppEncryptionDeclaration.Relationships
@@ -77,6 +79,8 @@ type AppEncryptionDeclarationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration/relationships
 type AppEncryptionDeclarationRelationships struct {
 	App *Relationship `json:"app,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppEncryptionDeclarationResponse defines model for AppEncryptionDeclarationResponse.
@@ -102,30 +106,33 @@ type AppEncryptionDeclarationsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_encryption_declarations
 type ListAppEncryptionDeclarationsQuery struct {
-	FieldsAppEncryptionDeclarations []string `url:"fields[appEncryptionDeclarations],omitempty"`
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	FilterApp                       []string `url:"filter[app],omitempty"`
-	FilterBuilds                    []string `url:"filter[builds],omitempty"`
-	FilterPlatforms                 []string `url:"filter[platforms],omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	Limit                           int      `url:"limit,omitempty"`
-	Cursor                          *string  `url:"cursor,omitempty"`
+	FieldsAppEncryptionDeclarations []string     `url:"fields[appEncryptionDeclarations],omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	FilterApp                       []string     `url:"filter[app],omitempty"`
+	FilterBuilds                    []string     `url:"filter[builds],omitempty"`
+	FilterPlatforms                 []string     `url:"filter[platforms],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Limit                           int          `url:"limit,omitempty"`
+	Cursor                          *string      `url:"cursor,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // GetAppEncryptionDeclarationQuery are query options for GetAppEncryptionDeclaration
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_encryption_declaration_information
 type GetAppEncryptionDeclarationQuery struct {
-	FieldsAppEncryptionDeclarations []string `url:"fields[appEncryptionDeclarations],omitempty"`
-	FieldsApps                      []string `url:"fields[apps],omitempty"`
-	Include                         []string `url:"include,omitempty"`
+	FieldsAppEncryptionDeclarations []string     `url:"fields[appEncryptionDeclarations],omitempty"`
+	FieldsApps                      []string     `url:"fields[apps],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // GetAppForEncryptionDeclarationQuery are query options for GetAppForEncryptionDeclaration
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_an_app_encryption_declaration
 type GetAppForEncryptionDeclarationQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListAppEncryptionDeclarations finds and lists all available app encryption declarations.
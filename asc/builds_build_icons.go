@@ -59,6 +59,8 @@ type BuildIcon struct {
 type BuildIconAttributes struct {
 	IconAsset *ImageAsset    `json:"iconAsset,omitempty"`
 	IconType  *IconAssetType `json:"iconType,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BuildIconsResponse defines model for BuildIconsResponse.
@@ -74,9 +76,10 @@ type BuildIconsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_icons_for_a_build
 type ListIconsQuery struct {
-	FieldsBuildIcons []string `url:"fields[buildIcons],omitempty"`
-	Limit            int      `url:"limit,omitempty"`
-	Cursor           string   `url:"cursor,omitempty"`
+	FieldsBuildIcons []string     `url:"fields[buildIcons],omitempty"`
+	Limit            int          `url:"limit,omitempty"`
+	Cursor           string       `url:"cursor,omitempty"`
+	Query            QueryEncoder `url:"-"`
 }
 
 // ListIconsForBuild lists all the icons for various platforms delivered with a build.
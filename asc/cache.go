@@ -0,0 +1,495 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CacheEntry is a response stored in a CacheStore.
+type CacheEntry struct {
+	// Key identifies the request the response was received for. For a Client sending requests
+	// through a CredentialPool, the URL is prefixed by the team of the keys that sent it.
+	Key string `json:"key"`
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+	// Header holds the headers of the response.
+	Header http.Header `json:"header"`
+	// Body is the raw body of the response.
+	Body []byte `json:"body"`
+	// Expires is the time after which the entry is no longer used.
+	Expires time.Time `json:"expires"`
+}
+
+// CacheStore is a backend for the response cache of a Client. Implementations must be safe for
+// concurrent use.
+type CacheStore interface {
+	// Get returns the entry stored for key, if any.
+	Get(key string) (CacheEntry, bool)
+	// Set stores an entry under its key, replacing any existing entry.
+	Set(entry CacheEntry)
+	// Delete removes the entry stored for key, if any.
+	Delete(key string)
+	// Keys returns the keys of every stored entry.
+	Keys() []string
+}
+
+// CacheOptions configures the response cache of a Client.
+type CacheOptions struct {
+	// Store holds the cached responses. It defaults to a new MemoryCache.
+	Store CacheStore
+	// TTL is how long responses are cached for, by the type of the resources they contain, such
+	// as "territories". It defaults to DefaultCacheTTL.
+	TTL map[string]time.Duration
+	// DefaultTTL is how long responses for resource types missing from TTL are cached for. If it is
+	// zero, they are not cached.
+	DefaultTTL time.Duration
+}
+
+// CacheStats counts the lookups made in the response cache of a Client.
+type CacheStats struct {
+	// Hits is the number of requests answered from the cache.
+	Hits int64
+	// Misses is the number of cacheable requests sent to the API because no fresh entry was found.
+	Misses int64
+	// Invalidations is the number of entries removed because the client modified their resources.
+	Invalidations int64
+}
+
+// HitRate returns the fraction of cacheable requests answered from the cache.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// DefaultCacheTTL returns how long responses for resources that rarely change are cached by default.
+func DefaultCacheTTL() map[string]time.Duration {
+	return map[string]time.Duration{
+		"territories":    24 * time.Hour,
+		"appCategories":  24 * time.Hour,
+		"appPriceTiers":  24 * time.Hour,
+		"appPricePoints": 24 * time.Hour,
+		"users":          time.Hour,
+		"bundleIds":      time.Hour,
+	}
+}
+
+type responseCache struct {
+	store      CacheStore
+	ttl        map[string]time.Duration
+	defaultTTL time.Duration
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	invalidations atomic.Int64
+}
+
+// SetCache enables a read-through cache of GET responses, for resources that rarely change such as
+// territories, app categories, price tiers, users and bundle IDs. When the client modifies a
+// resource with a POST, PATCH or DELETE request, cached responses containing resources of that type
+// are removed. Pass nil to disable the cache.
+//
+// When requests are sent through a CredentialPool, responses are cached separately for each team of
+// the pool, and requests that may be sent with the keys of several teams are not cached.
+func (c *Client) SetCache(opts *CacheOptions) {
+	if opts == nil {
+		c.cache = nil
+
+		return
+	}
+
+	cache := &responseCache{
+		store:      opts.Store,
+		ttl:        opts.TTL,
+		defaultTTL: opts.DefaultTTL,
+	}
+
+	if cache.store == nil {
+		cache.store = NewMemoryCache()
+	}
+
+	if cache.ttl == nil {
+		cache.ttl = DefaultCacheTTL()
+	}
+
+	c.cache = cache
+}
+
+// CacheStats returns the number of cache hits, misses and invalidations since the cache was set.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+
+	return CacheStats{
+		Hits:          c.cache.hits.Load(),
+		Misses:        c.cache.misses.Load(),
+		Invalidations: c.cache.invalidations.Load(),
+	}
+}
+
+// cachedResponse decodes a fresh cached response to req into v, if there is one.
+func (c *Client) cachedResponse(req *http.Request, v interface{}) (*Response, bool, error) {
+	if c.cache == nil || req.Method != http.MethodGet || c.cacheTTL(req.URL) <= 0 {
+		return nil, false, nil
+	}
+
+	key, ok := c.cacheKey(req)
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry, ok := c.cache.store.Get(key)
+	if ok && time.Now().After(entry.Expires) {
+		c.cache.store.Delete(key)
+
+		ok = false
+	}
+
+	if !ok {
+		c.cache.misses.Add(1)
+
+		return nil, false, nil
+	}
+
+	c.cache.hits.Add(1)
+
+	response := newResponse(&http.Response{
+		Status:        http.StatusText(entry.StatusCode),
+		StatusCode:    entry.StatusCode,
+		Header:        entry.Header,
+		Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       req,
+	})
+	response.Cached = true
+
+	return response, true, decodeBody(bytes.NewReader(entry.Body), v)
+}
+
+// cacheResponse stores the body of a successful response to a GET request, or invalidates the
+// cached responses for the resource modified by any other request.
+func (c *Client) cacheResponse(req *http.Request, resp *Response, body []byte) {
+	if c.cache == nil {
+		return
+	}
+
+	if req.Method != http.MethodGet {
+		c.invalidateCache(req.URL)
+
+		return
+	}
+
+	ttl := c.cacheTTL(req.URL)
+	if ttl <= 0 {
+		return
+	}
+
+	key, ok := c.cacheKey(req)
+	if !ok {
+		return
+	}
+
+	c.cache.store.Set(CacheEntry{
+		Key:        key,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		Expires:    time.Now().Add(ttl),
+	})
+}
+
+// invalidateCache removes every cached response whose path refers to the resource type of u.
+func (c *Client) invalidateCache(u *url.URL) {
+	modified := resourceType(c.resourcePath(u))
+	if modified == "" {
+		return
+	}
+
+	for _, key := range c.cache.store.Keys() {
+		cached, err := url.Parse(key[strings.LastIndexByte(key, ' ')+1:])
+		if err != nil {
+			continue
+		}
+
+		for _, typ := range resourceTypes(c.resourcePath(cached)) {
+			if typ == modified {
+				c.cache.store.Delete(key)
+				c.cache.invalidations.Add(1)
+
+				break
+			}
+		}
+	}
+}
+
+// cacheKey returns the key of the cached response to req, and reports false if the response must
+// not be cached because the key that sends req belongs to an unknown team of a CredentialPool.
+func (c *Client) cacheKey(req *http.Request) (string, bool) {
+	pool := c.credentialPool()
+	if pool == nil {
+		return req.URL.String(), true
+	}
+
+	scope, ok := pool.cacheScope(req.Context())
+	if !ok {
+		return "", false
+	}
+
+	return scope + " " + req.URL.String(), true
+}
+
+func (c *Client) cacheTTL(u *url.URL) time.Duration {
+	if ttl, ok := c.cache.ttl[resourceType(c.resourcePath(u))]; ok {
+		return ttl
+	}
+
+	return c.cache.defaultTTL
+}
+
+// resourceSegments returns the segments of a resource path that follow its version, if any, such
+// as "apps", "1", "relationships" and "builds" for "v2/apps/1/relationships/builds".
+func resourceSegments(path string) []string {
+	path = strings.Trim(path, "/")
+	if _, ok := pathVersion(path); ok {
+		_, path, _ = strings.Cut(path, "/")
+	}
+
+	var segments []string
+
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	return segments
+}
+
+// resourceTypes returns the resource types and relationships named by a resource path, such as
+// "bundleIds" and "profiles" for "bundleIds/1/relationships/profiles".
+func resourceTypes(path string) []string {
+	var segments []string
+
+	for _, segment := range resourceSegments(path) {
+		if segment != "relationships" {
+			segments = append(segments, segment)
+		}
+	}
+
+	var types []string
+
+	for i := 0; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return types
+}
+
+// parseResourcePath splits a resource path such as "v2/apps/1/relationships/builds" into the type
+// and ID of the resource it addresses, and the name of the relationship.
+func parseResourcePath(path string) (typ string, id string, relationship string) {
+	segments := resourceSegments(path)
+
+	if len(segments) > 0 {
+		typ = segments[0]
+	}
+
+	if len(segments) > 1 {
+		id = segments[1]
+	}
+
+	if len(segments) > 3 && segments[2] == "relationships" {
+		relationship = segments[3]
+	}
+
+	return typ, id, relationship
+}
+
+// resourceType returns the type of the resources returned for a resource path, which is the last
+// type or relationship it names.
+func resourceType(path string) string {
+	types := resourceTypes(path)
+	if len(types) == 0 {
+		return ""
+	}
+
+	return types[len(types)-1]
+}
+
+// MemoryCache is an in-memory CacheStore.
+type MemoryCache struct {
+	mu      sync.RWMutex
+	entries map[string]CacheEntry
+}
+
+// NewMemoryCache creates a new, empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]CacheEntry{}}
+}
+
+// Get returns the entry stored for key, if any.
+func (m *MemoryCache) Get(key string) (CacheEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entry, ok := m.entries[key]
+
+	return entry, ok
+}
+
+// Set stores an entry under its key, replacing any existing entry.
+func (m *MemoryCache) Set(entry CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[entry.Key] = entry
+}
+
+// Delete removes the entry stored for key, if any.
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.entries, key)
+}
+
+// Keys returns the keys of every stored entry.
+func (m *MemoryCache) Keys() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]string, 0, len(m.entries))
+	for key := range m.entries {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// DiskCache is a CacheStore that keeps each entry in a JSON file of a directory, so that cached
+// responses are shared across processes and survive restarts.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a DiskCache storing entries in dir, creating the directory if needed.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get returns the entry stored for key, if any.
+func (d *DiskCache) Get(key string) (CacheEntry, bool) {
+	entry, err := d.read(d.path(key))
+	if err != nil || entry.Key != key {
+		return CacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// Set stores an entry under its key, replacing any existing entry. Errors writing the entry are
+// ignored, as the entry will be fetched again.
+func (d *DiskCache) Set(entry CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	f, err := os.CreateTemp(d.dir, ".entry-*")
+	if err != nil {
+		return
+	}
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), d.path(entry.Key))
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
+
+// Delete removes the entry stored for key, if any.
+func (d *DiskCache) Delete(key string) {
+	_ = os.Remove(d.path(key))
+}
+
+// Keys returns the keys of every stored entry.
+func (d *DiskCache) Keys() []string {
+	paths, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		if entry, err := d.read(path); err == nil {
+			keys = append(keys, entry.Key)
+		}
+	}
+
+	return keys
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (d *DiskCache) read(path string) (CacheEntry, error) {
+	var entry CacheEntry
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return entry, err
+	}
+
+	err = json.Unmarshal(data, &entry)
+
+	return entry, err
+}
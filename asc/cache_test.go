@@ -0,0 +1,193 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newCountingServer serves a territory or bundle ID for any request, counting the requests received.
+func newCountingServer(t *testing.T, store CacheStore) (*Client, *atomic.Int64) {
+	t.Helper()
+
+	var requests atomic.Int64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"data":[{"id":"USA","type":"territories"}],"links":{"self":"%s"}}`, r.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+	client.SetCache(&CacheOptions{Store: store})
+
+	return client, &requests
+}
+
+func TestCacheHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	client, requests := newCountingServer(t, nil)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, resp, err := client.Pricing.ListTerritories(ctx, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "USA", res.Data[0].ID)
+		assert.Equal(t, i > 0, resp.Cached)
+	}
+
+	_, _, err := client.Pricing.ListTerritories(ctx, &ListTerritoriesQuery{Limit: 200})
+	assert.NoError(t, err)
+
+	_, _, err = client.Builds.ListBuilds(ctx, nil)
+	assert.NoError(t, err)
+	_, _, err = client.Builds.ListBuilds(ctx, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(4), requests.Load())
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 2}, client.CacheStats())
+	assert.InDelta(t, 0.5, client.CacheStats().HitRate(), 0.001)
+}
+
+func TestCacheInvalidation(t *testing.T) {
+	t.Parallel()
+
+	client, requests := newCountingServer(t, nil)
+	ctx := context.Background()
+
+	_, _, err := client.Provisioning.ListBundleIDs(ctx, nil)
+	assert.NoError(t, err)
+	_, err = client.get(ctx, "bundleIds/1", nil, nil)
+	assert.NoError(t, err)
+	_, _, err = client.Pricing.ListTerritories(ctx, nil)
+	assert.NoError(t, err)
+
+	_, err = client.Provisioning.DeleteBundleID(ctx, "1")
+	assert.NoError(t, err)
+
+	_, _, err = client.Provisioning.ListBundleIDs(ctx, nil)
+	assert.NoError(t, err)
+	_, _, err = client.Pricing.ListTerritories(ctx, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(5), requests.Load())
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 4, Invalidations: 2}, client.CacheStats())
+}
+
+func TestCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	store := NewMemoryCache()
+	client, requests := newCountingServer(t, store)
+	client.SetCache(&CacheOptions{Store: store, TTL: map[string]time.Duration{"territories": time.Hour}})
+
+	_, _, err := client.Pricing.ListTerritories(context.Background(), nil)
+	assert.NoError(t, err)
+
+	for _, key := range store.Keys() {
+		entry, _ := store.Get(key)
+		entry.Expires = time.Now().Add(-time.Second)
+		store.Set(entry)
+	}
+
+	_, resp, err := client.Pricing.ListTerritories(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.False(t, resp.Cached)
+	assert.Equal(t, int64(2), requests.Load())
+}
+
+func TestDiskCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	store, err := NewDiskCache(dir)
+	assert.NoError(t, err)
+
+	client, requests := newCountingServer(t, store)
+
+	_, _, err = client.Pricing.ListTerritories(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, store.Keys(), 1)
+
+	reopened, err := NewDiskCache(dir)
+	assert.NoError(t, err)
+	client.SetCache(&CacheOptions{Store: reopened})
+
+	res, resp, err := client.Pricing.ListTerritories(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.True(t, resp.Cached)
+	assert.Equal(t, "USA", res.Data[0].ID)
+	assert.Equal(t, int64(1), requests.Load())
+
+	reopened.Delete(store.Keys()[0])
+	assert.Empty(t, reopened.Keys())
+}
+
+func TestResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"bundleIds", "profiles"}, resourceTypes("bundleIds/1/relationships/profiles"))
+	assert.Equal(t, []string{"inAppPurchases"}, resourceTypes("v2/inAppPurchases/1"))
+	assert.Equal(t, "territories", resourceType("territories"))
+	assert.Equal(t, "", resourceType(""))
+	assert.Equal(t, []string{"apps", "builds"}, resourceTypes("/v1/apps/1/builds"))
+}
+
+func TestParseResourcePath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path, typ, id, relationship string
+	}{
+		{"apps", "apps", "", ""},
+		{"apps/1", "apps", "1", ""},
+		{"betaGroups/1/relationships/builds", "betaGroups", "1", "builds"},
+		{"v2/inAppPurchases/5", "inAppPurchases", "5", ""},
+		{"videos/1", "videos", "1", ""},
+		{"/v1/apps/1/relationships/builds", "apps", "1", "builds"},
+		{"", "", "", ""},
+	}
+
+	for _, tt := range tests {
+		typ, id, relationship := parseResourcePath(tt.path)
+		assert.Equal(t, tt.typ, typ, tt.path)
+		assert.Equal(t, tt.id, id, tt.path)
+		assert.Equal(t, tt.relationship, relationship, tt.path)
+	}
+}
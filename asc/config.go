@@ -0,0 +1,408 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ErrInvalidConfig happens when LoadConfig cannot resolve a complete and valid configuration.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// DefaultProfile is the profile loaded by LoadConfig when none is selected.
+const DefaultProfile = "default"
+
+// Config holds the credentials and settings of a Client, as resolved by LoadConfig.
+//
+// In a config file, each profile is a table whose keys are the toml tags of the fields:
+//
+//	[default]
+//	key_id = "2X9R4HXF34"
+//	issuer_id = "57246542-96fe-1a63-e053-0824d011072a"
+//	private_key_path = "~/.config/asc/AuthKey_2X9R4HXF34.p8"
+//
+//	[dashboard]
+//	key_id = "8Q7X2Y3Z4A"
+//	private_key_path = "~/.config/asc/AuthKey_8Q7X2Y3Z4A.p8"
+//	timeout = "30s"
+//	rate_limit = true
+type Config struct {
+	// Profile is the name of the profile the configuration was loaded from.
+	Profile string `toml:"-"`
+	// KeyID is the ID of the API key.
+	KeyID string `toml:"key_id"`
+	// IssuerID is the issuer ID of a team key. It is empty for individual keys.
+	IssuerID string `toml:"issuer_id"`
+	// PrivateKey is the content of the .p8 private key. It takes precedence over PrivateKeyPath.
+	PrivateKey string `toml:"private_key"`
+	// PrivateKeyPath is the path to the .p8 private key. A leading "~/" is expanded to the home
+	// directory of the user.
+	PrivateKeyPath string `toml:"private_key_path"`
+	// TokenLifetime is the lifetime of tokens, up to and defaulting to MaxTokenLifetime.
+	TokenLifetime time.Duration `toml:"token_lifetime"`
+	// BaseURL is the root URL of the API, as passed to Client.SetBaseURL.
+	BaseURL string `toml:"base_url"`
+	// Timeout is the time limit of each HTTP request. Zero means no limit.
+	Timeout time.Duration `toml:"timeout"`
+	// MaxAttempts is the maximum number of times a request is sent, as in RetryPolicy. Zero keeps
+	// the default retry policy.
+	MaxAttempts int `toml:"max_attempts"`
+	// RateLimit enables a RateLimiter on the client. It is a pointer so that a later source can
+	// turn off a rate limiter enabled by an earlier one.
+	RateLimit *bool `toml:"rate_limit"`
+	// RateLimitReserve is the Reserve of the RateLimiter.
+	RateLimitReserve int `toml:"rate_limit_reserve"`
+	// DryRun enables dry-run mode on the client, as with Client.SetDryRun. It is a pointer so that
+	// a later source can turn off dry-run mode enabled by an earlier one.
+	DryRun *bool `toml:"dry_run"`
+
+	key *ecdsa.PrivateKey
+}
+
+// ConfigOption customizes how LoadConfig resolves a Config.
+type ConfigOption func(*configLoader)
+
+type configLoader struct {
+	path      string
+	profile   string
+	envPrefix string
+	overrides Config
+}
+
+// WithConfigFile loads profiles from the config file at path, instead of the file named by the
+// ASC_CONFIG_FILE environment variable or the default file. The file must exist.
+func WithConfigFile(path string) ConfigOption {
+	return func(l *configLoader) {
+		l.path = path
+	}
+}
+
+// WithProfile selects the profile to load, instead of the one named by the ASC_PROFILE
+// environment variable or DefaultProfile. The profile must exist in the config file.
+func WithProfile(name string) ConfigOption {
+	return func(l *configLoader) {
+		l.profile = name
+	}
+}
+
+// WithEnvPrefix sets the prefix of the environment variables read by LoadConfig, which defaults
+// to "ASC_".
+func WithEnvPrefix(prefix string) ConfigOption {
+	return func(l *configLoader) {
+		l.envPrefix = prefix
+	}
+}
+
+// WithOverrides sets fields of the configuration that take precedence over the config file and
+// the environment, such as values of command-line flags. Only non-zero fields are used.
+func WithOverrides(overrides Config) ConfigOption {
+	return func(l *configLoader) {
+		l.overrides = overrides
+	}
+}
+
+// LoadConfig resolves the configuration of a Client from, in increasing order of precedence:
+//
+//  1. a profile of the config file, which is ~/.config/asc/config.toml on Linux, or the
+//     equivalent returned by os.UserConfigDir on other systems;
+//  2. the ASC_KEY_ID, ASC_ISSUER_ID, ASC_PRIVATE_KEY, ASC_PRIVATE_KEY_PATH, ASC_TOKEN_LIFETIME,
+//     ASC_BASE_URL, ASC_TIMEOUT, ASC_MAX_ATTEMPTS, ASC_RATE_LIMIT, ASC_RATE_LIMIT_RESERVE and
+//     ASC_DRY_RUN environment variables;
+//  3. the overrides set with WithOverrides.
+//
+// A missing default config file is ignored. The private key is read and parsed, so that a missing
+// or invalid key is reported before any request is made.
+func LoadConfig(opts ...ConfigOption) (*Config, error) {
+	loader := &configLoader{envPrefix: "ASC_"}
+
+	for _, opt := range opts {
+		opt(loader)
+	}
+
+	cfg := &Config{}
+
+	if err := loader.loadFile(cfg); err != nil {
+		return nil, err
+	}
+
+	env, err := loader.loadEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.merge(env)
+	cfg.merge(loader.overrides)
+
+	if err := cfg.loadKey(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (l *configLoader) loadFile(cfg *Config) error {
+	path, required := l.path, l.path != ""
+	if path == "" {
+		path, required = l.getenv("CONFIG_FILE"), l.getenv("CONFIG_FILE") != ""
+	}
+
+	if path == "" {
+		dir, err := os.UserConfigDir()
+		if err != nil {
+			return nil
+		}
+
+		path = filepath.Join(dir, "asc", "config.toml")
+	}
+
+	cfg.Profile = l.profile
+	if cfg.Profile == "" {
+		cfg.Profile = l.getenv("PROFILE")
+	}
+
+	if cfg.Profile == "" {
+		cfg.Profile = DefaultProfile
+	}
+
+	var profiles map[string]Config
+
+	_, err := toml.DecodeFile(expandHome(path), &profiles)
+	if errors.Is(err, fs.ErrNotExist) && !required {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	profile, ok := profiles[cfg.Profile]
+	if !ok && cfg.Profile != DefaultProfile {
+		return fmt.Errorf("%w: profile %q not found in %s", ErrInvalidConfig, cfg.Profile, path)
+	}
+
+	cfg.merge(profile)
+
+	return nil
+}
+
+func (l *configLoader) loadEnv() (Config, error) {
+	env := Config{
+		KeyID:          l.getenv("KEY_ID"),
+		IssuerID:       l.getenv("ISSUER_ID"),
+		PrivateKey:     l.getenv("PRIVATE_KEY"),
+		PrivateKeyPath: l.getenv("PRIVATE_KEY_PATH"),
+		BaseURL:        l.getenv("BASE_URL"),
+	}
+
+	var err error
+
+	if s := l.getenv("TOKEN_LIFETIME"); s != "" {
+		if env.TokenLifetime, err = time.ParseDuration(s); err != nil {
+			return env, fmt.Errorf("%w: %sTOKEN_LIFETIME: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+	}
+
+	if s := l.getenv("TIMEOUT"); s != "" {
+		if env.Timeout, err = time.ParseDuration(s); err != nil {
+			return env, fmt.Errorf("%w: %sTIMEOUT: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+	}
+
+	if s := l.getenv("MAX_ATTEMPTS"); s != "" {
+		if env.MaxAttempts, err = strconv.Atoi(s); err != nil {
+			return env, fmt.Errorf("%w: %sMAX_ATTEMPTS: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+	}
+
+	if s := l.getenv("RATE_LIMIT"); s != "" {
+		rateLimit, err := strconv.ParseBool(s)
+		if err != nil {
+			return env, fmt.Errorf("%w: %sRATE_LIMIT: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+
+		env.RateLimit = &rateLimit
+	}
+
+	if s := l.getenv("RATE_LIMIT_RESERVE"); s != "" {
+		if env.RateLimitReserve, err = strconv.Atoi(s); err != nil {
+			return env, fmt.Errorf("%w: %sRATE_LIMIT_RESERVE: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+	}
+
+	if s := l.getenv("DRY_RUN"); s != "" {
+		dryRun, err := strconv.ParseBool(s)
+		if err != nil {
+			return env, fmt.Errorf("%w: %sDRY_RUN: %w", ErrInvalidConfig, l.envPrefix, err)
+		}
+
+		env.DryRun = &dryRun
+	}
+
+	return env, nil
+}
+
+func (l *configLoader) getenv(name string) string {
+	return os.Getenv(l.envPrefix + name)
+}
+
+// merge replaces the fields of c with the non-zero fields of o, and with the booleans o sets,
+// even to false. A private key of o replaces both the private key and the private key path of c.
+func (c *Config) merge(o Config) {
+	if o.PrivateKey != "" || o.PrivateKeyPath != "" {
+		c.PrivateKey, c.PrivateKeyPath = o.PrivateKey, o.PrivateKeyPath
+	}
+
+	if o.KeyID != "" {
+		c.KeyID = o.KeyID
+	}
+
+	if o.IssuerID != "" {
+		c.IssuerID = o.IssuerID
+	}
+
+	if o.TokenLifetime != 0 {
+		c.TokenLifetime = o.TokenLifetime
+	}
+
+	if o.BaseURL != "" {
+		c.BaseURL = o.BaseURL
+	}
+
+	if o.Timeout != 0 {
+		c.Timeout = o.Timeout
+	}
+
+	if o.MaxAttempts != 0 {
+		c.MaxAttempts = o.MaxAttempts
+	}
+
+	if o.RateLimit != nil {
+		c.RateLimit = o.RateLimit
+	}
+
+	if o.RateLimitReserve != 0 {
+		c.RateLimitReserve = o.RateLimitReserve
+	}
+
+	if o.DryRun != nil {
+		c.DryRun = o.DryRun
+	}
+}
+
+// loadKey reads and parses the private key of the configuration.
+func (c *Config) loadKey() error {
+	if c.KeyID == "" {
+		return fmt.Errorf("%w: profile %q: no key ID", ErrInvalidConfig, c.Profile)
+	}
+
+	blob := []byte(c.PrivateKey)
+
+	if c.PrivateKey == "" {
+		if c.PrivateKeyPath == "" {
+			return fmt.Errorf("%w: profile %q: no private key or private key path", ErrInvalidConfig, c.Profile)
+		}
+
+		var err error
+
+		blob, err = os.ReadFile(expandHome(c.PrivateKeyPath))
+		if err != nil {
+			return fmt.Errorf("%w: profile %q: %w", ErrInvalidConfig, c.Profile, err)
+		}
+	}
+
+	key, err := parsePrivateKey(blob)
+	if err != nil {
+		return fmt.Errorf("%w: profile %q: %w", ErrInvalidConfig, c.Profile, err)
+	}
+
+	c.key = key
+
+	return nil
+}
+
+// AuthTransport returns an AuthTransport that authenticates requests with the key of the
+// configuration.
+func (c *Config) AuthTransport(opts ...TokenOption) (*AuthTransport, error) {
+	if c.key == nil {
+		if err := c.loadKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewTokenConfigWithSigner(c.KeyID, c.IssuerID, c.TokenLifetime, c.key, opts...)
+}
+
+// NewClient returns a Client authenticated with the key of the configuration, with its base URL,
+// timeout, retry policy, rate limiter and dry-run mode set from the configuration.
+func (c *Config) NewClient(opts ...TokenOption) (*Client, error) {
+	auth, err := c.AuthTransport(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := auth.Client()
+	httpClient.Timeout = c.Timeout
+
+	client := NewClient(httpClient)
+
+	if c.BaseURL != "" {
+		if err := client.SetBaseURL(c.BaseURL); err != nil {
+			return nil, fmt.Errorf("%w: base URL: %w", ErrInvalidConfig, err)
+		}
+	}
+
+	if c.MaxAttempts > 0 {
+		policy := DefaultRetryPolicy()
+		policy.MaxAttempts = c.MaxAttempts
+		client.SetRetryPolicy(policy)
+	}
+
+	if c.RateLimit != nil && *c.RateLimit {
+		client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{Reserve: c.RateLimitReserve}))
+	}
+
+	client.SetDryRun(c.DryRun != nil && *c.DryRun)
+
+	return client, nil
+}
+
+// expandHome replaces a leading "~/" in path with the home directory of the user.
+func expandHome(path string) string {
+	rest, ok := strings.CutPrefix(path, "~/")
+	if !ok {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, rest)
+}
@@ -0,0 +1,191 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestKey(t *testing.T, dir string) string {
+	t.Helper()
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	path := filepath.Join(dir, "AuthKey_TEST.p8")
+	err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+	assert.NoError(t, err)
+
+	return path
+}
+
+func writeTestConfig(t *testing.T, dir string, keyPath string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "config.toml")
+	err := os.WriteFile(path, []byte(`
+[default]
+key_id = "DEFAULT"
+issuer_id = "ISSUER"
+private_key_path = "`+keyPath+`"
+timeout = "30s"
+
+[dashboard]
+key_id = "DASHBOARD"
+private_key_path = "`+keyPath+`"
+base_url = "https://proxy.example.com/asc/"
+max_attempts = 2
+rate_limit = true
+rate_limit_reserve = 100
+`), 0o600)
+	assert.NoError(t, err)
+
+	return path
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestConfig(t, dir, writeTestKey(t, dir))
+
+	t.Setenv("ASC_TEST_PRECEDENCE_KEY_ID", "ENV")
+	t.Setenv("ASC_TEST_PRECEDENCE_TIMEOUT", "1m")
+
+	cfg, err := LoadConfig(
+		WithConfigFile(path),
+		WithEnvPrefix("ASC_TEST_PRECEDENCE_"),
+		WithOverrides(Config{IssuerID: "OVERRIDE"}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultProfile, cfg.Profile)
+	assert.Equal(t, "ENV", cfg.KeyID)
+	assert.Equal(t, "OVERRIDE", cfg.IssuerID)
+	assert.Equal(t, time.Minute, cfg.Timeout)
+	assert.NotNil(t, cfg.key)
+
+	auth, err := cfg.AuthTransport()
+	assert.NoError(t, err)
+	assert.Equal(t, "OVERRIDE", auth.jwtGenerator.(*standardJWTGenerator).issuerID)
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestConfig(t, dir, writeTestKey(t, dir))
+
+	t.Setenv("ASC_TEST_PROFILE_CONFIG_FILE", path)
+	t.Setenv("ASC_TEST_PROFILE_PROFILE", "dashboard")
+
+	cfg, err := LoadConfig(WithEnvPrefix("ASC_TEST_PROFILE_"))
+	assert.NoError(t, err)
+	assert.Equal(t, "dashboard", cfg.Profile)
+	assert.Equal(t, "DASHBOARD", cfg.KeyID)
+	assert.Empty(t, cfg.IssuerID)
+
+	client, err := cfg.NewClient()
+	assert.NoError(t, err)
+	assert.Equal(t, "https://proxy.example.com/asc/v1/", client.baseURL.String())
+	assert.Equal(t, 2, client.retryPolicy.MaxAttempts)
+	assert.NotNil(t, client.rateLimiter)
+	assert.Nil(t, client.Plan())
+
+	_, err = LoadConfig(WithEnvPrefix("ASC_TEST_PROFILE_"), WithProfile("missing"))
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestLoadConfigEnvironmentOnly(t *testing.T) {
+	dir := t.TempDir()
+	keyPath := writeTestKey(t, dir)
+
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("ASC_TEST_ENV_KEY_ID", "ENV")
+	t.Setenv("ASC_TEST_ENV_PRIVATE_KEY_PATH", keyPath)
+	t.Setenv("ASC_TEST_ENV_DRY_RUN", "true")
+
+	cfg, err := LoadConfig(WithEnvPrefix("ASC_TEST_ENV_"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ENV", cfg.KeyID)
+	assert.Equal(t, Bool(true), cfg.DryRun)
+
+	client, err := cfg.NewClient()
+	assert.NoError(t, err)
+	assert.Equal(t, defaultBaseURL, client.baseURL.String())
+	assert.Nil(t, client.rateLimiter)
+	assert.NotNil(t, client.Plan())
+
+	_, err = LoadConfig(WithEnvPrefix("ASC_TEST_ENV_"), WithConfigFile(filepath.Join(dir, "missing.toml")))
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestLoadConfigDisablesBooleans(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestConfig(t, dir, writeTestKey(t, dir))
+
+	t.Setenv("ASC_TEST_BOOL_PROFILE", "dashboard")
+	t.Setenv("ASC_TEST_BOOL_RATE_LIMIT", "false")
+	t.Setenv("ASC_TEST_BOOL_DRY_RUN", "true")
+
+	cfg, err := LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_BOOL_"))
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(false), cfg.RateLimit)
+	assert.Equal(t, Bool(true), cfg.DryRun)
+
+	cfg, err = LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_BOOL_"),
+		WithOverrides(Config{RateLimit: Bool(true), DryRun: Bool(false)}))
+	assert.NoError(t, err)
+
+	client, err := cfg.NewClient()
+	assert.NoError(t, err)
+	assert.NotNil(t, client.rateLimiter)
+	assert.Nil(t, client.Plan())
+
+	t.Setenv("ASC_TEST_BOOL_RATE_LIMIT", "")
+
+	cfg, err = LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_BOOL_"))
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), cfg.RateLimit)
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+	path := writeTestConfig(t, dir, filepath.Join(dir, "missing.p8"))
+
+	_, err := LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_INVALID_"))
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_INVALID_"), WithOverrides(Config{PrivateKey: "TEST"}))
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	assert.ErrorIs(t, err, ErrMissingPEM)
+
+	t.Setenv("ASC_TEST_INVALID_TIMEOUT", "soon")
+
+	_, err = LoadConfig(WithConfigFile(path), WithEnvPrefix("ASC_TEST_INVALID_"))
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
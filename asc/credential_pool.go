@@ -0,0 +1,436 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultCredentialCooldown is how long a key rejected as unauthorized is left unused by default.
+const defaultCredentialCooldown = 10 * time.Minute
+
+// ErrNoCredential happens when a CredentialPool has no credential that can send a request, because
+// none matches the label or team attached to its context, allows it, and has not been revoked.
+var ErrNoCredential = errors.New("no credential available for the request")
+
+// Credential is an API key held by a CredentialPool.
+type Credential struct {
+	// Label identifies the key within the pool, such as "acme-ci". Labels must be unique.
+	Label string
+	// Team groups the keys of the same App Store Connect account, usually by issuer ID or by name.
+	// Requests restricted to a team with ContextWithTeam are only sent with its keys.
+	Team string
+	// Auth creates the tokens of the key, as returned by NewTokenConfig and its variants. Its
+	// Transport is not used; requests are sent with the Transport of the pool.
+	Auth *AuthTransport
+}
+
+// CredentialStatus reports the usage of a key held by a CredentialPool.
+type CredentialStatus struct {
+	Label string
+	Team  string
+	// Budget is the request budget of the key, as reported by the latest response to a request
+	// sent with it.
+	Budget RateBudget
+	// Requests is the number of requests sent with the key.
+	Requests int
+	// Revoked reports whether App Store Connect recently rejected a token of the key as
+	// unauthorized, in which case the key is only used again after RetryAt.
+	Revoked bool
+	// RetryAt is when a revoked key is tried again. It is zero unless the key is revoked.
+	RetryAt time.Time
+}
+
+// PoolStrategy determines which key of a CredentialPool sends a request, among the keys that can.
+type PoolStrategy int
+
+const (
+	// RoundRobin uses each key in turn.
+	RoundRobin PoolStrategy = iota
+	// LeastUsed uses the key with the most requests remaining in its hourly rate limit, as reported
+	// by the latest response to a request sent with it. Keys that have not been used yet come first.
+	LeastUsed
+)
+
+// CredentialPool is an http.RoundTripper that authenticates each request with one of several API
+// keys, so that a single Client can serve several App Store Connect accounts, or spread its requests
+// across several keys of the same account. A CredentialPool is safe for concurrent use.
+//
+// The key of a request can be chosen with ContextWithCredential or ContextWithTeam; otherwise it is
+// chosen by the strategy of the pool. When App Store Connect rejects a key as unauthorized, the key
+// is marked as revoked for the Cooldown and the request is sent again with another key. Requests
+// pinned to a key with ContextWithCredential are always sent with it, and a rejection is returned
+// as an error without revoking the key.
+//
+// Each key has its own RateLimiter, which paces the requests sent with it. Keys whose budget is
+// exhausted are only chosen when no other key can send the request.
+//
+// A Client finds the pool when it is the Transport of its http.Client, or when that Transport wraps
+// the pool and returns it, directly or not, from an Unwrap() http.RoundTripper method, as an
+// asctest.Recorder does.
+type CredentialPool struct {
+	Transport http.RoundTripper
+	// Cooldown is how long a key rejected as unauthorized is left unused before it is tried again.
+	// Defaults to 10 minutes.
+	Cooldown time.Duration
+
+	strategy PoolStrategy
+
+	mu    sync.Mutex
+	creds []*pooledCredential
+	next  int
+}
+
+type pooledCredential struct {
+	Credential
+
+	limiter   *RateLimiter
+	requests  int
+	revokedAt time.Time
+}
+
+type credentialContextKey struct{}
+
+type teamContextKey struct{}
+
+// NewCredentialPool creates a CredentialPool holding the given keys.
+func NewCredentialPool(strategy PoolStrategy, credentials ...Credential) (*CredentialPool, error) {
+	pool := &CredentialPool{
+		Transport: newTransport(),
+		strategy:  strategy,
+	}
+
+	labels := map[string]bool{}
+
+	for _, cred := range credentials {
+		if cred.Auth == nil || cred.Auth.jwtGenerator == nil {
+			return nil, fmt.Errorf("credential %q has no token configuration", cred.Label)
+		}
+
+		if labels[cred.Label] {
+			return nil, fmt.Errorf("duplicate credential label %q", cred.Label)
+		}
+
+		labels[cred.Label] = true
+		pool.creds = append(pool.creds, &pooledCredential{
+			Credential: cred,
+			limiter:    NewRateLimiter(RateLimiterOptions{}),
+		})
+	}
+
+	return pool, nil
+}
+
+// ContextWithCredential returns a context that sends the requests made with it with the key of a
+// CredentialPool with the given label.
+func ContextWithCredential(ctx context.Context, label string) context.Context {
+	return context.WithValue(ctx, credentialContextKey{}, label)
+}
+
+// ContextWithTeam returns a context that sends the requests made with it with one of the keys of a
+// CredentialPool of the given team.
+func ContextWithTeam(ctx context.Context, team string) context.Context {
+	return context.WithValue(ctx, teamContextKey{}, team)
+}
+
+// Client returns a new http.Client instance for use with asc.Client.
+func (p *CredentialPool) Client() *http.Client {
+	return &http.Client{Transport: p}
+}
+
+// credentialPool returns the CredentialPool the client sends its requests through, if any, unwrapping
+// the transports that wrap it.
+func (c *Client) credentialPool() *CredentialPool {
+	transport := c.client.Transport
+
+	for transport != nil {
+		if pool, ok := transport.(*CredentialPool); ok {
+			return pool
+		}
+
+		wrapper, ok := transport.(interface{ Unwrap() http.RoundTripper })
+		if !ok {
+			return nil
+		}
+
+		transport = wrapper.Unwrap()
+	}
+
+	return nil
+}
+
+// Status reports the usage of every key of the pool, in the order they were added.
+func (p *CredentialPool) Status() []CredentialStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := make([]CredentialStatus, len(p.creds))
+	now := time.Now()
+
+	for i, cred := range p.creds {
+		status[i] = CredentialStatus{
+			Label:    cred.Label,
+			Team:     cred.Team,
+			Budget:   cred.limiter.Budget(),
+			Requests: cred.requests,
+			Revoked:  p.revoked(cred, now),
+		}
+
+		if status[i].Revoked {
+			status[i].RetryAt = cred.revokedAt.Add(p.cooldown())
+		}
+	}
+
+	return status
+}
+
+// RoundTrip implements the http.RoundTripper interface to authenticate the request with a key of
+// the pool.
+func (p *CredentialPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	label, pinned := req.Context().Value(credentialContextKey{}).(string)
+	team, _ := req.Context().Value(teamContextKey{}).(string)
+
+	var resp *http.Response
+
+	for {
+		cred, err := p.choose(req, label, pinned, team)
+		if err != nil {
+			if resp != nil {
+				// Every other key has been revoked as well.
+				return resp, nil
+			}
+
+			return nil, err
+		}
+
+		if resp != nil {
+			discardBody(resp)
+		}
+
+		if err := cred.limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+
+		token, err := cred.Auth.jwtGenerator.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		r := req.Clone(req.Context())
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		if resp != nil {
+			if err := rewindBody(r); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = p.transport().RoundTrip(r)
+		if err != nil {
+			return resp, err
+		}
+
+		if !p.update(cred, resp, pinned) || !canResend(req) {
+			return resp, nil
+		}
+	}
+}
+
+// choose selects the key that sends req, and counts the request against it. A pinned key is chosen
+// even if it has been revoked. Keys with an exhausted budget are only chosen if no other key can
+// send req, the one replenished first.
+func (p *CredentialPool) choose(req *http.Request, label string, pinned bool, team string) (*pooledCredential, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		chosen, exhausted *pooledCredential
+		resetAt           time.Time
+	)
+
+	now := time.Now()
+
+	for i := range p.creds {
+		// Round robin starts from the key after the one used last.
+		cred := p.creds[(p.next+i)%len(p.creds)]
+
+		if !p.serves(cred, label, pinned, team) || (!pinned && p.revoked(cred, now)) {
+			continue
+		}
+
+		if gen, ok := cred.Auth.jwtGenerator.(*standardJWTGenerator); ok && gen.allows(req) != nil {
+			continue
+		}
+
+		if reset := cred.limiter.Budget().blockedUntil(); reset.After(now) {
+			if exhausted == nil || reset.Before(resetAt) {
+				exhausted, resetAt = cred, reset
+			}
+
+			continue
+		}
+
+		if chosen == nil || (p.strategy == LeastUsed && lessUsed(cred, chosen)) {
+			chosen = cred
+		}
+
+		if p.strategy == RoundRobin {
+			break
+		}
+	}
+
+	if chosen == nil {
+		chosen = exhausted
+	}
+
+	if chosen == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoCredential, req.Method, req.URL.Path)
+	}
+
+	for i, cred := range p.creds {
+		if cred == chosen {
+			p.next = i + 1
+		}
+	}
+
+	chosen.requests++
+
+	return chosen, nil
+}
+
+// update records the rate limit of the response sent with cred, and reports whether the response
+// revoked the key. A key pinned with ContextWithCredential is not revoked, and any other response
+// than a rejection restores a revoked key.
+func (p *CredentialPool) update(cred *pooledCredential, resp *http.Response, pinned bool) bool {
+	cred.limiter.Update(parseRate(resp))
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		cred.limiter.exceed(parseRetryAfter(resp))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		cred.revokedAt = time.Time{}
+
+		return false
+	}
+
+	if pinned {
+		return false
+	}
+
+	cred.revokedAt = time.Now()
+
+	return true
+}
+
+// revoked reports whether cred was rejected as unauthorized less than the cooldown ago. p.mu must
+// be held.
+func (p *CredentialPool) revoked(cred *pooledCredential, now time.Time) bool {
+	return !cred.revokedAt.IsZero() && now.Before(cred.revokedAt.Add(p.cooldown()))
+}
+
+// serves reports whether cred may send the requests pinned to label or restricted to team.
+func (p *CredentialPool) serves(cred *pooledCredential, label string, pinned bool, team string) bool {
+	return (!pinned || cred.Label == label) && (team == "" || cred.Team == team)
+}
+
+// cacheScope returns the team whose keys send the requests made with ctx, which scopes the responses
+// cached for them. Keys without a team are their own scope. It reports false if the requests may be
+// sent with the keys of several teams.
+func (p *CredentialPool) cacheScope(ctx context.Context) (string, bool) {
+	label, pinned := ctx.Value(credentialContextKey{}).(string)
+	team, _ := ctx.Value(teamContextKey{}).(string)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		scope string
+		found bool
+	)
+
+	for _, cred := range p.creds {
+		if !p.serves(cred, label, pinned, team) {
+			continue
+		}
+
+		s := cred.Team
+		if s == "" {
+			s = cred.Label
+		}
+
+		if found && s != scope {
+			return "", false
+		}
+
+		scope, found = s, true
+	}
+
+	return scope, found
+}
+
+func (p *CredentialPool) cooldown() time.Duration {
+	if p.Cooldown <= 0 {
+		return defaultCredentialCooldown
+	}
+
+	return p.Cooldown
+}
+
+func (p *CredentialPool) transport() http.RoundTripper {
+	if p.Transport == nil {
+		return http.DefaultTransport
+	}
+
+	return p.Transport
+}
+
+// lessUsed reports whether a has more requests remaining in its rate limit than b. Keys without a
+// known rate limit have the most.
+func lessUsed(a *pooledCredential, b *pooledCredential) bool {
+	remaining := func(c *pooledCredential) int {
+		rate := c.limiter.Budget().Rate
+		if rate.Limit == 0 {
+			return int(^uint(0) >> 1)
+		}
+
+		return rate.Remaining
+	}
+
+	if remaining(a) != remaining(b) {
+		return remaining(a) > remaining(b)
+	}
+
+	return a.requests < b.requests
+}
+
+// canResend reports whether the body of req can be sent again.
+func canResend(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
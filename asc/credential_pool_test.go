@@ -0,0 +1,341 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// poolServer answers requests with the status and remaining rate limit set for the key of their
+// token, and records the label of the key of every request.
+type poolServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	labels    map[string]string
+	status    map[string]int
+	remaining map[string]int
+	got       []string
+}
+
+func newPoolServer(t *testing.T, labels ...string) (*poolServer, []Credential) {
+	t.Helper()
+
+	server := &poolServer{
+		labels:    map[string]string{},
+		status:    map[string]int{},
+		remaining: map[string]int{},
+	}
+
+	creds := make([]Credential, len(labels))
+
+	for i, label := range labels {
+		key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+		auth, err := NewTokenConfigWithSigner(label, "issuer", 20*time.Minute, key)
+		assert.NoError(t, err)
+
+		token, _ := auth.jwtGenerator.Token()
+		server.labels[token] = label
+
+		team, _, _ := strings.Cut(label, "-")
+		creds[i] = Credential{Label: label, Team: team, Auth: auth}
+	}
+
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		label := server.labels[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+		server.got = append(server.got, label)
+
+		if remaining, ok := server.remaining[label]; ok {
+			w.Header().Set(headerRateLimit, fmt.Sprintf("user-hour-lim:3600;user-hour-rem:%d;", remaining))
+		}
+
+		if status, ok := server.status[label]; ok {
+			w.WriteHeader(status)
+		}
+
+		fmt.Fprint(w, `{"data":[]}`)
+	}))
+
+	return server, creds
+}
+
+func (s *poolServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.got...)
+}
+
+func newPoolClient(t *testing.T, server *poolServer, strategy PoolStrategy, creds []Credential) (*Client, *CredentialPool) {
+	t.Helper()
+
+	pool, err := NewCredentialPool(strategy, creds...)
+	assert.NoError(t, err)
+
+	client := NewClient(pool.Client())
+	client.SetRetryPolicy(NoRetryPolicy())
+	_ = client.SetBaseURL(server.URL + "/")
+
+	return client, pool
+}
+
+func TestCredentialPoolRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b", "globex-a")
+	defer server.Close()
+
+	client, pool := newPoolClient(t, server, RoundRobin, creds)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.get(context.Background(), "apps", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	_, err := client.get(ContextWithCredential(context.Background(), "globex-a"), "apps", nil, nil)
+	assert.NoError(t, err)
+
+	_, err = client.get(ContextWithTeam(context.Background(), "globex"), "apps", nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"acme-a", "acme-b", "globex-a", "acme-a", "globex-a", "globex-a"}, server.requests())
+	assert.Equal(t, 2, pool.Status()[0].Requests)
+	assert.Equal(t, 3, pool.Status()[2].Requests)
+
+	_, err = client.get(ContextWithTeam(context.Background(), "initech"), "apps", nil, nil)
+	assert.ErrorIs(t, err, ErrNoCredential)
+}
+
+func TestCredentialPoolLeastUsed(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b")
+	defer server.Close()
+
+	server.remaining["acme-a"] = 100
+	server.remaining["acme-b"] = 3000
+
+	client, pool := newPoolClient(t, server, LeastUsed, creds)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.get(context.Background(), "apps", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"acme-a", "acme-b", "acme-b", "acme-b"}, server.requests())
+	assert.Equal(t, Rate{Limit: 3600, Remaining: 3000}, pool.Status()[1].Budget.Rate)
+}
+
+func TestCredentialPoolFailover(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b")
+	defer server.Close()
+
+	server.status["acme-a"] = http.StatusUnauthorized
+
+	client, pool := newPoolClient(t, server, RoundRobin, creds)
+
+	_, err := client.post(context.Background(), "apps", newRequestBody(mockBody{"TEST"}), nil)
+	assert.NoError(t, err)
+
+	_, err = client.get(context.Background(), "apps", nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"acme-a", "acme-b", "acme-b"}, server.requests())
+	assert.True(t, pool.Status()[0].Revoked)
+	assert.False(t, pool.Status()[0].RetryAt.IsZero())
+
+	_, err = client.get(ContextWithCredential(context.Background(), "acme-a"), "apps", nil, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	server.status["acme-b"] = http.StatusUnauthorized
+
+	_, err = client.get(context.Background(), "apps", nil, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestCredentialPoolCooldown(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b")
+	defer server.Close()
+
+	server.status["acme-a"] = http.StatusUnauthorized
+
+	client, pool := newPoolClient(t, server, RoundRobin, creds)
+	pool.Cooldown = 20 * time.Millisecond
+
+	_, err := client.get(context.Background(), "apps", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, pool.Status()[0].Revoked)
+
+	server.mu.Lock()
+	delete(server.status, "acme-a")
+	server.mu.Unlock()
+
+	time.Sleep(pool.Cooldown)
+	assert.False(t, pool.Status()[0].Revoked)
+
+	_, err = client.get(context.Background(), "apps", nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"acme-a", "acme-b", "acme-a"}, server.requests())
+	assert.False(t, pool.Status()[0].Revoked)
+	assert.Zero(t, pool.Status()[0].RetryAt)
+}
+
+func TestCredentialPoolPinnedUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b")
+	defer server.Close()
+
+	server.status["acme-a"] = http.StatusUnauthorized
+
+	client, pool := newPoolClient(t, server, RoundRobin, creds)
+
+	_, err := client.get(ContextWithCredential(context.Background(), "acme-a"), "apps", nil, nil)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	assert.Equal(t, []string{"acme-a"}, server.requests())
+	assert.False(t, pool.Status()[0].Revoked)
+}
+
+func TestCredentialPoolRateBudget(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b")
+	defer server.Close()
+
+	server.remaining["acme-a"] = 0
+	server.remaining["acme-b"] = 3000
+
+	client, pool := newPoolClient(t, server, RoundRobin, creds)
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{}))
+
+	for i := 0; i < 4; i++ {
+		_, err := client.get(context.Background(), "apps", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"acme-a", "acme-b", "acme-b", "acme-b"}, server.requests())
+
+	status := pool.Status()
+	assert.Equal(t, 0, status[0].Budget.Available)
+	assert.False(t, status[0].Budget.ResetAt.IsZero())
+	assert.Equal(t, 3000, status[1].Budget.Available)
+	assert.Equal(t, RateBudget{}, client.RateBudget())
+
+	ctx, cancel := context.WithTimeout(ContextWithCredential(context.Background(), "acme-a"), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.get(ctx, "apps", nil, nil)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Len(t, server.requests(), 4)
+}
+
+func TestCredentialPoolCache(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-b", "globex-a")
+	defer server.Close()
+
+	client, _ := newPoolClient(t, server, RoundRobin, creds)
+	client.SetCache(&CacheOptions{DefaultTTL: time.Hour})
+
+	acme := ContextWithTeam(context.Background(), "acme")
+	globex := ContextWithCredential(context.Background(), "globex-a")
+
+	for _, ctx := range []context.Context{acme, acme, globex, globex, context.Background(), context.Background()} {
+		_, err := client.get(ctx, "apps", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"acme-a", "globex-a", "acme-a", "acme-b"}, server.requests())
+	assert.Equal(t, CacheStats{Hits: 2, Misses: 2}, client.CacheStats())
+}
+
+// wrappingTransport wraps a transport the way logging or recording transports do.
+type wrappingTransport struct {
+	http.RoundTripper
+}
+
+func (t wrappingTransport) Unwrap() http.RoundTripper {
+	return t.RoundTripper
+}
+
+func TestCredentialPoolWrapped(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "globex-a")
+	defer server.Close()
+
+	pool, err := NewCredentialPool(RoundRobin, creds...)
+	assert.NoError(t, err)
+
+	client := NewClient(&http.Client{Transport: wrappingTransport{wrappingTransport{pool}}})
+	client.SetRetryPolicy(NoRetryPolicy())
+	client.SetCache(&CacheOptions{DefaultTTL: time.Hour})
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{}))
+	_ = client.SetBaseURL(server.URL + "/")
+
+	assert.Same(t, pool, client.credentialPool())
+	assert.Equal(t, RateBudget{}, client.RateBudget(), "the keys of the pool are paced by the pool")
+
+	for _, label := range []string{"acme-a", "globex-a", "acme-a", "globex-a"} {
+		_, err := client.get(ContextWithCredential(context.Background(), label), "apps", nil, nil)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"acme-a", "globex-a"}, server.requests(), "responses are cached per team")
+
+	assert.Nil(t, NewClient(&http.Client{Transport: wrappingTransport{http.DefaultTransport}}).credentialPool())
+}
+
+func TestNewCredentialPoolDuplicateLabel(t *testing.T) {
+	t.Parallel()
+
+	server, creds := newPoolServer(t, "acme-a", "acme-a")
+	server.Close()
+
+	_, err := NewCredentialPool(RoundRobin, creds...)
+	assert.Error(t, err)
+
+	_, err = NewCredentialPool(RoundRobin, Credential{Label: "empty"})
+	assert.Error(t, err)
+}
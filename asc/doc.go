@@ -81,20 +81,184 @@ to look a little more like this:
 		})
 	}
 
-The authenticated client created here will automatically regenerate the token if it expires.
+The authenticated client created here will automatically regenerate the token shortly before it
+expires, which can be tuned with WithRefreshMargin. To keep the private key in a KMS, an HSM or an
+agent, pass any crypto.Signer holding it to NewTokenConfigWithSigner instead.
+
+Individual API keys, which act on behalf of the user who created them, have no issuer ID and are
+used with NewIndividualTokenConfig. Tokens can be restricted to specific GET requests with WithScope,
+in which case requests outside of the scope fail with ErrOutOfScope before they are sent.
+
+	auth, err := asc.NewTokenConfig(keyID, issuerID, 20*time.Minute, privateKey,
+		asc.WithScope("GET /v1/apps", "GET /v1/salesReports?filter[vendorNumber]="+vendorNumber))
+
+To serve several accounts with one Client, or to spread requests across several keys of the same
+account, authenticate with a CredentialPool. It chooses a key for each request in turn or by its
+remaining rate limit, unless the context of the request selects one with ContextWithCredential or
+ContextWithTeam. Each key is paced by its own RateLimiter, and keys that App Store Connect rejects
+as unauthorized are left unused for a cooldown.
+
+	pool, err := asc.NewCredentialPool(asc.LeastUsed,
+		asc.Credential{Label: "acme-ci", Team: "acme", Auth: acmeAuth},
+		asc.Credential{Label: "globex-ci", Team: "globex", Auth: globexAuth},
+	)
+	client := asc.NewClient(pool.Client())
+	apps, _, err := client.Apps.ListApps(asc.ContextWithTeam(ctx, "globex"), nil)
 Also note that all App Store Connect APIs are scoped to the credentials of the pre-configured key,
 so you can't use this API to make queries against the entire App Store. For more information on
 creating the necessary credentials for the App Store Connect API, see the documentation at
 https://developer.apple.com/documentation/appstoreconnectapi/creating_api_keys_for_app_store_connect_api.
 
+Configuration
+
+LoadConfig resolves credentials and client settings from a named profile of a config file such as
+~/.config/asc/config.toml, the ASC_* environment variables and explicit overrides, in increasing
+order of precedence, and checks the private key before any request is made.
+
+	cfg, err := asc.LoadConfig(asc.WithProfile("ci"), asc.WithOverrides(asc.Config{KeyID: *keyID}))
+	if err != nil {
+		return err
+	}
+	client, err := cfg.NewClient()
+
 Rate Limiting
 
 Apple imposes a rate limit on all API clients. The returned Response.Rate value contains the rate
 limit information from the most recent API call. If the API produces a rate limit error, it will be
 identifiable as an ErrorResponse with an error code of 429.
 
+To stay within the limit, set a RateLimiter on the client. It is shared by every service, adapts its
+pace to the rate limit reported by each response, and blocks requests until they can be sent once the
+hourly budget runs out. After a rate limit error, it holds requests back for as long as the failed
+one waits to be retried. A request whose wait would outlast the MaxElapsedTime of the RetryPolicy
+fails with ErrRateLimited instead. RateBudget reports the current budget, which can be used to plan
+bulk jobs.
+
+	client.SetRateLimiter(asc.NewRateLimiter(asc.RateLimiterOptions{Reserve: 100}))
+	...
+	fmt.Println("time to send 500 requests:", client.RateBudget().Forecast(500))
+
 Learn more about rate limiting at https://developer.apple.com/documentation/appstoreconnectapi/identifying_rate_limits.
 
+Retries
+
+Requests rejected with a rate limit error are retried with exponential backoff, honoring the
+Retry-After header when Apple sends one. Server errors and transient network errors are retried
+as well, but only for idempotent methods such as GET and DELETE. The number of attempts made is
+available on Response.Attempts. Use SetRetryPolicy to customize this behavior, or to disable it
+with NoRetryPolicy.
+
+Errors
+
+When the API rejects a request, the returned error is an *ErrorResponse holding every error reported
+by Apple. It can be classified with errors.Is against sentinel errors such as ErrNotFound, ErrConflict,
+ErrRateLimited or ErrAttributeInvalid, and each individual ErrorResponseError can be extracted with
+errors.As. Error codes are hierarchical, and HasCode matches any code under a given prefix.
+
+	if errors.Is(err, asc.ErrAttributeInvalid) {
+		fmt.Println("invalid attributes:", asc.InvalidAttributes(err))
+	}
+
+Middleware
+
+Middleware added with Use runs for every request made by the client. Its hooks see the method,
+resource path, JSON body and decoded target of each request, as well as the decoded ErrorResponse
+when a request fails, which makes them suitable for auditing, metrics, header injection, circuit
+breaking or fault injection.
+
+	client.Use(asc.Middleware{
+		AfterResponse: func(ctx context.Context, req *asc.RequestInfo, resp *asc.Response) {
+			log.Printf("%s %s: %d", req.Method, req.Path, resp.StatusCode)
+		},
+	})
+
+Caching
+
+Resources that rarely change, such as territories, app categories, price tiers, users and bundle
+IDs, can be cached with SetCache so that repeated requests don't spend the rate limit. Responses are
+cached for a duration that depends on the type of resource, in memory or on disk with NewDiskCache.
+Cached responses of a resource type are discarded when the client modifies a resource of that type.
+Response.Cached reports whether a response came from the cache, and CacheStats counts hits and misses.
+
+	store, _ := asc.NewDiskCache(filepath.Join(os.TempDir(), "asc-cache"))
+	client.SetCache(&asc.CacheOptions{Store: store})
+
+Dry Run
+
+To preview what a script would change, enable dry-run mode with SetDryRun, or dry_run in a config
+profile. GET requests are still sent, but POST, PATCH and DELETE requests and asset uploads are
+captured as PlannedChange values in the client's Plan and answered with synthesized responses, with
+Response.DryRun set. Resources that would be created are given placeholder IDs starting with
+"dry-run-". The plan can be rendered as text for review, or as JSON for approval in CI.
+
+	client.SetDryRun(true)
+	_, _, err := client.TestFlight.CreateBetaGroup(ctx, asc.BetaGroupCreateRequestAttributes{Name: "QA"}, appID, nil, nil)
+	// ...
+	err = client.Plan().WriteText(os.Stdout)
+
+Audit Trail
+
+SetAuditSink records every POST, PATCH and DELETE request sent by the client as a line of JSON,
+with its time, the key ID and issuer ID of the key that signed it, the resource it targets, its
+body, its response status and the IDs of the errors Apple returned. Updates of resources the client
+fetched before also list the attributes they change. Each record holds the hash of the previous one,
+so that VerifyAuditTrail detects records that were modified, removed or inserted. OpenAuditFile
+appends to a file and continues its chain, and NewAuditSink writes to any io.Writer. As in logs,
+the values of DefaultRedactedFields are redacted from bodies and diffs; pass
+WithAuditRedactedFields to either function to redact other attributes.
+
+	sink, err := asc.OpenAuditFile("/var/log/asc/audit.jsonl")
+	if err != nil {
+		return err
+	}
+	defer sink.Close()
+	client.SetAuditSink(sink)
+
+Uploads
+
+Assets such as screenshots and previews are uploaded in parts described by the UploadOperation values
+of their reservation. Upload sends them several at a time, reads each part from the file as it is
+sent, and retries parts that fail. With WithUploadCheckpoint, the uploaded parts are recorded in a
+file so that an interrupted upload can be resumed by calling Upload again. When parts fail, the
+returned *UploadError lists every failed operation.
+
+	err := client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file,
+		asc.WithUploadConcurrency(8), asc.WithUploadCheckpoint(file.Name()+".upload"))
+
+WithUploadProgress reports the bytes sent for each part and overall, with an estimate of the time
+remaining, and WithUploadSummary reports the throughput and retries of the upload once it returns.
+
+	var summary asc.UploadSummary
+	err := client.Upload(ctx, ops, file,
+		asc.WithUploadProgress(func(p asc.UploadProgress) { log.Print(p) }),
+		asc.WithUploadSummary(&summary))
+	log.Print(summary)
+
+UploadAsset and UploadAssetFile run the whole workflow for an asset: they reserve it, upload its
+parts, commit it with the MD5 checksum of the file, and wait until App Store Connect has processed
+it. The kind of asset is one of AppScreenshotAsset, AppPreviewAsset, AppStoreReviewAttachmentAsset,
+RoutingAppCoverageAsset, GameCenterAchievementImageAsset and GameCenterLeaderboardImageAsset; the
+screenshots and previews of custom product pages go to the sets of their localizations. If Apple
+fails to process the asset, the returned error is an *AssetDeliveryError holding Apple's errors. If
+it is not processed within 15 minutes, or the timeout set with WithAssetDeliveryTimeout, the error
+is an *AssetDeliveryTimeoutError holding the asset.
+
+	screenshot, err := asc.UploadAssetFile(ctx, client, asc.AppScreenshotAsset, setID, "home.png")
+	var deliveryErr *asc.AssetDeliveryError
+	if errors.As(err, &deliveryErr) && deliveryErr.HasCode("IMAGE_INCORRECT_DIMENSIONS") {
+		...
+	}
+
+Logging
+
+Set a *slog.Logger on the client with SetLogger to log every request with its method, path, status,
+duration and remaining rate limit, as well as the IDs of any errors returned by Apple. At the Debug
+level, headers and bodies are logged as well. Authorization headers and personal information such as
+tester emails are always redacted, and large or binary bodies such as uploads and gzipped reports
+are truncated or omitted.
+
+	client.SetLogger(slog.Default(), asc.WithMaxBodySize(1024))
+
 Pagination
 
 All requests for resource collections (apps, builds, beta groups, etc.) support pagination.
@@ -128,5 +292,100 @@ the response's Meta field of type PagingInformation.
 		opt.Cursor = cursor
 	}
 
+Rather than following cursors by hand, the Pages, Items and CollectAll helpers follow the next
+link of each page for you. Items yields every resource across every page, stopping early if the
+loop is broken. Pass WithPrefetch to request the next page while the current one is consumed, and
+WithMaxItems to cap the number of resources returned. CollectAll merges every page into a single
+response, including the included resources of each page.
+
+	for app, err := range asc.Items[asc.App](ctx, client, func(ctx context.Context) (*asc.AppsResponse, *asc.Response, error) {
+		return client.Apps.ListApps(ctx, opt)
+	}, asc.WithPrefetch()) {
+		if err != nil {
+			return err
+		}
+		fmt.Println(app.ID)
+	}
+
+Included Resources
+
+Resources requested with the include parameter are returned in the Included field of a response.
+An IncludedGraph indexes them by type and ID, so that the relationships of a resource can be
+resolved to the included resources they refer to with Resolve and ResolveOne. The generic Resource
+type decodes resources with any attributes and relationships.
+
+	graph, err := asc.NewIncludedGraph(builds)
+	for _, build := range builds.Data {
+		app, err := asc.ResolveOne[asc.App](graph, build.Relationships.App)
+		...
+	}
+
+Schema Changes
+
+Apple adds attributes, relationships and enum values to the API over time. Members of a resource
+that this package does not model are kept in the Unknown field of its attributes or relationships,
+and are encoded again when the model is sent back, so that a resource can be read, modified and
+updated without losing them. Use SetStrictMode to log a warning or return a *SchemaDriftError when
+a response contains unknown fields or enum values, such as a new AppStoreVersionState.
+
+	client.SetStrictMode(asc.StrictModeFail)
+	version, _, err := client.Apps.GetAppStoreVersion(ctx, id, nil)
+	if errors.Is(err, asc.ErrSchemaDrift) {
+		...
+	}
+
+API Versions
+
+Most resources are served from version 1 of the API, but newer ones such as in-app purchases
+and app price points live under v2 and v3. Service methods know which version each endpoint
+belongs to, and paths passed to Client.Do, Get or List may start with a version, as returned by
+APIVersion.Path, to address other versions. Use SetBaseURL to send requests for every version
+through a proxy or to a test server.
+
+	client.SetBaseURL("https://proxy.example.com/asc/")
+	iap, _, err := asc.Get[asc.Document[InAppPurchaseV2]](ctx, client, asc.APIv2.Path("inAppPurchases/"+id), nil)
+
+Queries
+
+As an alternative to the List*Query structs, Query builds the parameters of a request from typed
+fields, relationships, sort keys and filters, such as BuildFieldVersion or BuildSortUploadedDate.
+A Query validates combinations of parameters before the request is sent, and encodes multiple values
+as comma-separated lists. Pass it to Client.Do, Get or List, or set it as the Query field of the
+parameters of a service method, for the resource type it was created for; List requests the
+following pages with their next link alone.
+
+	q := asc.NewBuildsQuery().
+		Include(asc.BuildIncludeApp).
+		Fields(asc.BuildFieldVersion, asc.AppFieldName).
+		Sort(asc.BuildSortUploadedDate.Desc()).
+		Limit(10)
+	builds, _, err := asc.Get[asc.BuildsResponse](ctx, client, "builds", q)
+	builds, _, err = client.Builds.ListBuilds(ctx, &asc.ListBuildsQuery{Query: q})
+
+Raw Requests
+
+Endpoints that have no service method yet can still be called with Client.Do, which shares the
+authentication, retries, middleware and error handling of the rest of the client. The generic Get
+and List functions decode responses into any type, such as a Document or PagedDocument of your own
+resource type, with List following the next link of each page.
+
+	for widget, err := range asc.List[Widget](ctx, client, "apps/1/widgets", url.Values{"limit": {"200"}}) {
+		if err != nil {
+			return err
+		}
+		fmt.Println(widget.ID)
+	}
+
+Testing
+
+Every service has an interface, such as AppsAPI for AppsService, generated from its methods so that
+they stay in sync. Client.Services returns the services of a client as a Services struct of these
+interfaces. Code that accepts a Services instead of a *Client can be tested with the generated mocks
+of package ascmock, which record every call, or against the fake server of package asctest.
+
+	func Release(ctx context.Context, services asc.Services, appID string) error { ... }
+
+	err := Release(ctx, client.Services(), appID)
+
 */
 package asc
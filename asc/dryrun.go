@@ -0,0 +1,419 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ChangeAction is the kind of change a PlannedChange would make.
+type ChangeAction string
+
+const (
+	// ChangeCreate creates a resource, with a POST request to a collection.
+	ChangeCreate ChangeAction = "create"
+	// ChangeUpdate modifies a resource, with a PATCH request.
+	ChangeUpdate ChangeAction = "update"
+	// ChangeDelete deletes a resource, with a DELETE request.
+	ChangeDelete ChangeAction = "delete"
+	// ChangeAddRelationship adds resources to a to-many relationship, with a POST request.
+	ChangeAddRelationship ChangeAction = "add-relationship"
+	// ChangeReplaceRelationship replaces the resources of a relationship, with a PATCH request.
+	ChangeReplaceRelationship ChangeAction = "replace-relationship"
+	// ChangeRemoveRelationship removes resources from a to-many relationship, with a DELETE request.
+	ChangeRemoveRelationship ChangeAction = "remove-relationship"
+	// ChangeUpload uploads a part of an asset.
+	ChangeUpload ChangeAction = "upload"
+)
+
+// dryRunIDPrefix prefixes the IDs of the resources a Client in dry-run mode pretends to create.
+const dryRunIDPrefix = "dry-run-"
+
+// PlannedChange is a request that a Client in dry-run mode captured instead of sending it.
+type PlannedChange struct {
+	// Action is the kind of change the request would make.
+	Action ChangeAction `json:"action"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Path is the path of the request relative to the client's base URL, such as
+	// "appStoreVersions/1234", or the URL of an upload without its query.
+	Path string `json:"path"`
+	// Query holds the query parameters of the request, if any.
+	Query url.Values `json:"query,omitempty"`
+	// Body is the JSON request body, or nil if the request has none.
+	Body json.RawMessage `json:"body,omitempty"`
+	// ResourceType is the type of the resource the request targets, such as "appStoreVersions".
+	ResourceType string `json:"resourceType,omitempty"`
+	// ResourceID is the ID of the resource the request targets. Resources that would be created
+	// are given a placeholder ID starting with "dry-run-".
+	ResourceID string `json:"resourceId,omitempty"`
+	// Relationship is the name of the relationship the request modifies, if any.
+	Relationship string `json:"relationship,omitempty"`
+	// Length is the number of bytes an upload would send.
+	Length int64 `json:"length,omitempty"`
+}
+
+// Target describes the resource targeted by the change, such as "appStoreVersions/1234" or
+// "betaGroups/1/relationships/builds".
+func (c PlannedChange) Target() string {
+	target := c.ResourceType
+	if c.ResourceID != "" {
+		target += "/" + c.ResourceID
+	}
+
+	if c.Relationship != "" {
+		target += "/relationships/" + c.Relationship
+	}
+
+	if target == "" {
+		return c.Path
+	}
+
+	return target
+}
+
+// Plan is the change set captured by a Client in dry-run mode. It is safe for concurrent use.
+type Plan struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+	created int
+}
+
+// SetDryRun enables or disables dry-run mode. In dry-run mode, GET requests are sent as usual, but
+// POST, PATCH and DELETE requests are captured in the client's Plan instead of being sent, and are
+// answered with synthesized responses: resources that would be created or updated are echoed back
+// from the request, with a placeholder ID for new ones, and other requests get an empty response.
+// Upload records the parts of assets instead of sending them. Enabling dry-run mode starts a new
+// Plan.
+func (c *Client) SetDryRun(flag bool) {
+	if flag {
+		c.plan = &Plan{}
+	} else {
+		c.plan = nil
+	}
+}
+
+// Plan returns the changes captured since dry-run mode was enabled, or nil if it is disabled.
+func (c *Client) Plan() *Plan {
+	return c.plan
+}
+
+// Changes returns the captured changes, in the order the requests were made.
+func (p *Plan) Changes() []PlannedChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedChange(nil), p.changes...)
+}
+
+// Len returns the number of captured changes.
+func (p *Plan) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.changes)
+}
+
+// Empty reports whether the plan captured no changes.
+func (p *Plan) Empty() bool {
+	return p.Len() == 0
+}
+
+// Reset forgets the captured changes.
+func (p *Plan) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changes = nil
+	p.created = 0
+}
+
+// Summary returns the number of captured changes for each action.
+func (p *Plan) Summary() map[ChangeAction]int {
+	summary := map[ChangeAction]int{}
+	for _, change := range p.Changes() {
+		summary[change.Action]++
+	}
+
+	return summary
+}
+
+// String returns the plan rendered as text by WriteText.
+func (p *Plan) String() string {
+	var buf strings.Builder
+
+	_ = p.WriteText(&buf)
+
+	return buf.String()
+}
+
+// WriteText renders the plan for review, with one numbered entry per change and its JSON body
+// indented below it:
+//
+//	Plan: 2 changes (1 create, 1 update)
+//
+//	1. create betaGroups/dry-run-1
+//	   POST betaGroups
+//	   {
+//	     "data": {
+//	   ...
+func (p *Plan) WriteText(w io.Writer) error {
+	changes := p.Changes()
+	buf := new(bytes.Buffer)
+
+	if len(changes) == 0 {
+		buf.WriteString("Plan: no changes\n")
+	} else {
+		fmt.Fprintf(buf, "Plan: %d %s (%s)\n", len(changes), plural(len(changes), "change"), summarize(changes))
+	}
+
+	for i, change := range changes {
+		fmt.Fprintf(buf, "\n%d. %s %s\n", i+1, change.Action, change.Target())
+
+		path := change.Path
+		if len(change.Query) > 0 {
+			path += "?" + change.Query.Encode()
+		}
+
+		fmt.Fprintf(buf, "   %s %s\n", change.Method, path)
+
+		if change.Length > 0 {
+			fmt.Fprintf(buf, "   %s\n", formatBytes(change.Length))
+		}
+
+		if len(change.Body) > 0 {
+			var body bytes.Buffer
+			if err := json.Indent(&body, change.Body, "   ", "  "); err != nil {
+				body.Reset()
+				body.Write(change.Body)
+			}
+
+			fmt.Fprintf(buf, "   %s\n", body.String())
+		}
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+// planReport is the JSON representation of a Plan.
+type planReport struct {
+	Summary map[ChangeAction]int `json:"summary"`
+	Changes []PlannedChange      `json:"changes"`
+}
+
+// MarshalJSON encodes the plan as an object with the number of changes for each action in
+// "summary" and the changes in "changes".
+func (p *Plan) MarshalJSON() ([]byte, error) {
+	changes := p.Changes()
+	if changes == nil {
+		changes = []PlannedChange{}
+	}
+
+	return json.Marshal(planReport{Summary: p.Summary(), Changes: changes})
+}
+
+// WriteJSON renders the plan as indented JSON, as encoded by MarshalJSON.
+func (p *Plan) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(p)
+}
+
+func (p *Plan) add(change PlannedChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changes = append(p.changes, change)
+}
+
+// newID returns a placeholder ID for a resource that would be created.
+func (p *Plan) newID() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.created++
+
+	return dryRunIDPrefix + strconv.Itoa(p.created)
+}
+
+// capture records the request in the plan and decodes a synthesized response into v.
+func (c *Client) capture(req *http.Request, v interface{}) (*Response, error) {
+	change := PlannedChange{
+		Method: req.Method,
+		Path:   c.resourcePath(req.URL),
+		Query:  req.URL.Query(),
+	}
+
+	if len(change.Query) == 0 {
+		change.Query = nil
+	}
+
+	if req.GetBody != nil && req.ContentLength > 0 {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+
+		change.Body = bytes.TrimSpace(data)
+	}
+
+	change.ResourceType, change.ResourceID, change.Relationship = parseResourcePath(change.Path)
+
+	var document map[string]json.RawMessage
+	if len(change.Body) > 0 {
+		_ = json.Unmarshal(change.Body, &document)
+	}
+
+	status := http.StatusNoContent
+
+	switch {
+	case change.Relationship != "":
+		change.Action = map[string]ChangeAction{
+			http.MethodPost:   ChangeAddRelationship,
+			http.MethodPatch:  ChangeReplaceRelationship,
+			http.MethodDelete: ChangeRemoveRelationship,
+		}[req.Method]
+		document = nil
+	case req.Method == http.MethodPost:
+		change.Action = ChangeCreate
+		change.ResourceID = c.plan.newID()
+		status = http.StatusCreated
+	case req.Method == http.MethodPatch:
+		change.Action = ChangeUpdate
+		status = http.StatusOK
+	default:
+		change.Action = ChangeDelete
+		document = nil
+	}
+
+	var body []byte
+
+	if data, ok := document["data"]; ok {
+		var resource map[string]json.RawMessage
+		if err := json.Unmarshal(data, &resource); err == nil {
+			if change.Action == ChangeCreate {
+				resource["id"], _ = json.Marshal(change.ResourceID)
+			}
+
+			if typ, ok := resource["type"]; ok {
+				_ = json.Unmarshal(typ, &change.ResourceType)
+			}
+
+			data, _ = json.Marshal(resource)
+		}
+
+		body, _ = json.Marshal(map[string]json.RawMessage{"data": data})
+	} else {
+		status = http.StatusNoContent
+	}
+
+	c.plan.add(change)
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+
+	if len(body) > 0 {
+		resp.Header.Set("Content-Type", "application/json")
+	}
+
+	response := newResponse(resp)
+	response.DryRun = true
+
+	if len(body) == 0 {
+		return response, nil
+	}
+
+	return response, decodeBody(bytes.NewReader(body), v)
+}
+
+// captureUpload records the parts of an upload in the plan.
+func (c *Client) captureUpload(ops []UploadOperation) {
+	for _, op := range ops {
+		change := PlannedChange{Action: ChangeUpload}
+
+		if op.Method != nil {
+			change.Method = *op.Method
+		}
+
+		// The query of the URL holds its signature, which has no place in a report.
+		if op.URL != nil {
+			change.Path, _, _ = strings.Cut(*op.URL, "?")
+		}
+
+		if op.Length != nil {
+			change.Length = int64(*op.Length)
+		}
+
+		c.plan.add(change)
+	}
+}
+
+// summarize returns the number of changes for each action, in the order the actions first appear.
+func summarize(changes []PlannedChange) string {
+	var (
+		order  []ChangeAction
+		counts = map[ChangeAction]int{}
+	)
+
+	for _, change := range changes {
+		if counts[change.Action] == 0 {
+			order = append(order, change.Action)
+		}
+
+		counts[change.Action]++
+	}
+
+	parts := make([]string, len(order))
+	for i, action := range order {
+		parts[i] = fmt.Sprintf("%d %s", counts[action], action)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+
+	return word + "s"
+}
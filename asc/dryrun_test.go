@@ -0,0 +1,266 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDryRunServer(t *testing.T) (*Client, *atomic.Int32) {
+	t.Helper()
+
+	var mutations atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			mutations.Add(1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"type":"apps","id":"1","attributes":{"name":"App"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+
+	return client, &mutations
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	client, mutations := newDryRunServer(t)
+	client.SetDryRun(true)
+
+	ctx := context.Background()
+
+	app, resp, err := client.Apps.GetApp(ctx, "1", nil)
+	assert.NoError(t, err)
+	assert.False(t, resp.DryRun)
+	assert.Equal(t, "1", app.Data.ID)
+
+	group, resp, err := client.TestFlight.CreateBetaGroup(ctx, BetaGroupCreateRequestAttributes{Name: "QA"}, "1", nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "dry-run-1", group.Data.ID)
+	assert.Equal(t, "QA", *group.Data.Attributes.Name)
+
+	version, resp, err := client.Apps.UpdateAppStoreVersion(ctx, "10", &AppStoreVersionUpdateRequestAttributes{VersionString: String("2.0")}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "10", version.Data.ID)
+	assert.Equal(t, "2.0", *version.Data.Attributes.VersionString)
+
+	resp, err = client.TestFlight.AddBuildsToBetaGroup(ctx, group.Data.ID, []string{"b1"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = client.Provisioning.RevokeCertificate(ctx, "c1")
+	assert.NoError(t, err)
+	assert.True(t, resp.DryRun)
+
+	assert.Zero(t, mutations.Load())
+
+	changes := client.Plan().Changes()
+	if !assert.Len(t, changes, 4) {
+		return
+	}
+
+	assert.Equal(t, PlannedChange{
+		Action:       ChangeCreate,
+		Method:       "POST",
+		Path:         "betaGroups",
+		Body:         changes[0].Body,
+		ResourceType: "betaGroups",
+		ResourceID:   "dry-run-1",
+	}, changes[0])
+	assert.Contains(t, string(changes[0].Body), `"name":"QA"`)
+	assert.Equal(t, "appStoreVersions/10", changes[1].Target())
+	assert.Equal(t, ChangeUpdate, changes[1].Action)
+	assert.Equal(t, ChangeAddRelationship, changes[2].Action)
+	assert.Equal(t, "betaGroups/dry-run-1/relationships/builds", changes[2].Target())
+	assert.Equal(t, PlannedChange{Action: ChangeDelete, Method: "DELETE", Path: "certificates/c1", ResourceType: "certificates", ResourceID: "c1"}, changes[3])
+
+	assert.Equal(t, map[ChangeAction]int{ChangeCreate: 1, ChangeUpdate: 1, ChangeAddRelationship: 1, ChangeDelete: 1}, client.Plan().Summary())
+
+	client.SetDryRun(false)
+	assert.Nil(t, client.Plan())
+
+	_, err = client.Provisioning.RevokeCertificate(ctx, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), mutations.Load())
+}
+
+func TestDryRunRawRequests(t *testing.T) {
+	t.Parallel()
+
+	client, mutations := newDryRunServer(t)
+	client.SetDryRun(true)
+
+	var out Document[map[string]interface{}]
+
+	resp, err := client.Do(context.Background(), "PATCH", APIv2.Path("inAppPurchases/5"), url.Values{"a": {"b"}}, map[string]interface{}{
+		"type": "inAppPurchases",
+		"id":   "5",
+	}, &out)
+	assert.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, "5", out.Data["id"])
+	assert.Zero(t, mutations.Load())
+
+	changes := client.Plan().Changes()
+	if assert.Len(t, changes, 1) {
+		assert.Equal(t, "v2/inAppPurchases/5", changes[0].Path)
+		assert.Equal(t, "inAppPurchases/5", changes[0].Target())
+		assert.Equal(t, "b", changes[0].Query.Get("a"))
+	}
+}
+
+func TestDryRunUpload(t *testing.T) {
+	t.Parallel()
+
+	client, mutations := newDryRunServer(t)
+	client.SetDryRun(true)
+
+	ops := []UploadOperation{{
+		Method: String("PUT"),
+		URL:    String("https://upload.example.com/part?signature=secret"),
+		Length: Int(4),
+		Offset: Int(0),
+	}}
+
+	err := client.Upload(context.Background(), ops, strings.NewReader("data"))
+	assert.NoError(t, err)
+	assert.Zero(t, mutations.Load())
+
+	assert.Equal(t, []PlannedChange{{
+		Action: ChangeUpload,
+		Method: "PUT",
+		Path:   "https://upload.example.com/part",
+		Length: 4,
+	}}, client.Plan().Changes())
+}
+
+func TestDryRunUploadAsset(t *testing.T) {
+	t.Parallel()
+
+	client, mutations := newDryRunServer(t)
+	client.SetDryRun(true)
+
+	screenshot, err := UploadAsset(context.Background(), client, AppScreenshotAsset, "set1", "home.png", strings.NewReader("image"), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "dry-run-1", screenshot.ID)
+	assert.Zero(t, mutations.Load())
+
+	changes := client.Plan().Changes()
+	if assert.Len(t, changes, 3) {
+		assert.Equal(t, ChangeCreate, changes[0].Action)
+		assert.Equal(t, PlannedChange{
+			Action:       ChangeUpload,
+			Method:       "PUT",
+			Path:         "home.png",
+			ResourceType: "appScreenshots",
+			ResourceID:   "dry-run-1",
+			Length:       5,
+		}, changes[1])
+		assert.Equal(t, ChangeUpdate, changes[2].Action)
+		assert.Equal(t, "appScreenshots/dry-run-1", changes[2].Target())
+	}
+}
+
+func TestPlanReport(t *testing.T) {
+	t.Parallel()
+
+	plan := &Plan{}
+	assert.Equal(t, "Plan: no changes\n", plan.String())
+
+	out, err := json.Marshal(plan)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"summary":{},"changes":[]}`, string(out))
+
+	plan.add(PlannedChange{
+		Action:       ChangeCreate,
+		Method:       "POST",
+		Path:         "betaGroups",
+		Body:         json.RawMessage(`{"data":{"type":"betaGroups"}}`),
+		ResourceType: "betaGroups",
+		ResourceID:   plan.newID(),
+	})
+	plan.add(PlannedChange{
+		Action:       ChangeDelete,
+		Method:       "DELETE",
+		Path:         "certificates/c1",
+		ResourceType: "certificates",
+		ResourceID:   "c1",
+	})
+	plan.add(PlannedChange{Action: ChangeUpload, Method: "PUT", Path: "https://upload.example.com/part", Length: 2048})
+
+	assert.Equal(t, `Plan: 3 changes (1 create, 1 delete, 1 upload)
+
+1. create betaGroups/dry-run-1
+   POST betaGroups
+   {
+     "data": {
+       "type": "betaGroups"
+     }
+   }
+
+2. delete certificates/c1
+   DELETE certificates/c1
+
+3. upload https://upload.example.com/part
+   PUT https://upload.example.com/part
+   2.0 KiB
+`, plan.String())
+
+	var buf bytes.Buffer
+	assert.NoError(t, plan.WriteJSON(&buf))
+
+	var report struct {
+		Summary map[string]int  `json:"summary"`
+		Changes []PlannedChange `json:"changes"`
+	}
+
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &report))
+	assert.Equal(t, map[string]int{"create": 1, "delete": 1, "upload": 1}, report.Summary)
+
+	if assert.Len(t, report.Changes, 3) {
+		assert.JSONEq(t, `{"data":{"type":"betaGroups"}}`, string(report.Changes[0].Body))
+		report.Changes[0].Body = plan.Changes()[0].Body
+		assert.Equal(t, plan.Changes(), report.Changes)
+	}
+
+	plan.Reset()
+	assert.True(t, plan.Empty())
+	assert.Equal(t, "dry-run-1", plan.newID())
+}
@@ -0,0 +1,309 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// errorClass is a category of API errors, identified by an HTTP status code, a set of
+// hierarchical error codes, or both.
+type errorClass struct {
+	name   string
+	status int
+	codes  []string
+}
+
+func (c *errorClass) Error() string {
+	return c.name
+}
+
+func (c *errorClass) matches(status int, code string) bool {
+	if c.status != 0 && c.status == status {
+		return true
+	}
+
+	for _, prefix := range c.codes {
+		if hasCodePrefix(code, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sentinel errors that classify an ErrorResponse. Use them with errors.Is:
+//
+//	if errors.Is(err, asc.ErrNotFound) {
+//		...
+//	}
+//
+// An ErrorResponse matches a sentinel if the status of the response, or the status or code of
+// any of its errors (including associated errors), belongs to the sentinel's category.
+var (
+	// ErrUnauthorized happens when the request is not authenticated, for example because the token has expired.
+	ErrUnauthorized error = &errorClass{name: "not authorized", status: http.StatusUnauthorized, codes: []string{"NOT_AUTHORIZED"}}
+	// ErrForbidden happens when the API key is not allowed to perform the request.
+	ErrForbidden error = &errorClass{name: "forbidden", status: http.StatusForbidden, codes: []string{"FORBIDDEN_ERROR"}}
+	// ErrNotFound happens when the requested resource does not exist or is not visible to the API key.
+	ErrNotFound error = &errorClass{name: "not found", status: http.StatusNotFound, codes: []string{"NOT_FOUND"}}
+	// ErrConflict happens when the request conflicts with the current state of the resource.
+	ErrConflict error = &errorClass{name: "conflict", status: http.StatusConflict}
+	// ErrRateLimited happens when the rate limit of the API key has been exceeded.
+	ErrRateLimited error = &errorClass{name: "rate limit exceeded", status: http.StatusTooManyRequests, codes: []string{"RATE_LIMIT_EXCEEDED"}}
+	// ErrEntityStateInvalid happens when the resource is not in a state that allows the request.
+	ErrEntityStateInvalid error = &errorClass{name: "entity state invalid", codes: []string{"STATE_ERROR", "ENTITY_ERROR.STATE"}}
+	// ErrAttributeInvalid happens when an attribute of the request entity is invalid. The offending
+	// attribute can be found in the Source.Pointer of the matching ErrorResponseError, or with InvalidAttributes.
+	ErrAttributeInvalid error = &errorClass{name: "attribute invalid", codes: []string{"ENTITY_ERROR.ATTRIBUTE.INVALID"}}
+)
+
+// remediationHints maps error codes to advice on how to resolve them. The most specific
+// matching code wins.
+var remediationHints = map[string]string{
+	"NOT_AUTHORIZED":                    "Check that the API key exists, has not been revoked, and that the token is signed with the right key ID and issuer.",
+	"FORBIDDEN_ERROR":                   "The role of the API key does not allow this request. Use a key with a role that grants access to the resource.",
+	"NOT_FOUND":                         "The resource does not exist or the API key cannot see it. Check the ID, and that the key has access to the app.",
+	"RATE_LIMIT_EXCEEDED":               "The hourly request limit has been reached. Wait before retrying, or set a RateLimiter on the Client.",
+	"PARAMETER_ERROR":                   "A query parameter is invalid. Check the parameter named in Source.Parameter.",
+	"PARAMETER_ERROR.INVALID":           "A query parameter has an invalid value. Check the value of the parameter named in Source.Parameter.",
+	"ENTITY_ERROR":                      "The request entity is invalid. Check the attribute or relationship named in Source.Pointer.",
+	"ENTITY_ERROR.ATTRIBUTE.INVALID":    "An attribute has an invalid value. Check the attribute named in Source.Pointer.",
+	"ENTITY_ERROR.ATTRIBUTE.REQUIRED":   "A required attribute is missing. Set the attribute named in Source.Pointer.",
+	"ENTITY_ERROR.RELATIONSHIP.INVALID": "A relationship refers to a resource that does not exist or cannot be linked. Check the IDs in the request.",
+	"STATE_ERROR":                       "The resource is not in a state that allows this request, for example a version that is already in review.",
+}
+
+// Error returns a one-line summary of the error.
+func (e ErrorResponseError) Error() string {
+	return fmt.Sprintf("%s %s – %s", e.Status, e.Code, e.Title)
+}
+
+// StatusCode returns the HTTP status code of the error, or 0 if it cannot be parsed.
+func (e ErrorResponseError) StatusCode() int {
+	status, err := strconv.Atoi(e.Status)
+	if err != nil {
+		return 0
+	}
+
+	return status
+}
+
+// HasCode reports whether the error's code is code, or a more specific code under it. Codes are
+// hierarchical, with levels separated by the '.' character, so an error with the code
+// ENTITY_ERROR.ATTRIBUTE.INVALID has the codes ENTITY_ERROR and ENTITY_ERROR.ATTRIBUTE.
+func (e ErrorResponseError) HasCode(code string) bool {
+	return hasCodePrefix(e.Code, code)
+}
+
+// Is allows the error to be compared to the sentinel errors of this package with errors.Is.
+func (e ErrorResponseError) Is(target error) bool {
+	class, ok := target.(*errorClass)
+
+	return ok && class.matches(e.StatusCode(), e.Code)
+}
+
+// Retryable reports whether the request that produced the error can be sent again unchanged.
+func (e ErrorResponseError) Retryable() bool {
+	return isRetryableStatus(e.StatusCode())
+}
+
+// Hint returns advice on how to resolve the error, or an empty string if there is none for its code.
+func (e ErrorResponseError) Hint() string {
+	code := e.Code
+	for code != "" {
+		if hint, ok := remediationHints[code]; ok {
+			return hint
+		}
+
+		i := strings.LastIndex(code, ".")
+		if i < 0 {
+			break
+		}
+
+		code = code[:i]
+	}
+
+	return ""
+}
+
+// Is allows the response to be compared to the sentinel errors of this package with errors.Is,
+// based on its HTTP status code. The individual errors are compared through Unwrap.
+func (e ErrorResponse) Is(target error) bool {
+	class, ok := target.(*errorClass)
+
+	return ok && e.Response != nil && class.matches(e.Response.StatusCode, "")
+}
+
+// Unwrap returns every error in the response, including associated errors, so that they can be
+// inspected with errors.Is and errors.As.
+func (e ErrorResponse) Unwrap() []error {
+	flattened := e.Flatten()
+	errs := make([]error, len(flattened))
+
+	for i, err := range flattened {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// HasCode reports whether any error in the response, including associated errors, has the given
+// code or a more specific code under it.
+func (e ErrorResponse) HasCode(code string) bool {
+	for _, err := range e.Flatten() {
+		if err.HasCode(code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Retryable reports whether the request that produced the response can be sent again unchanged,
+// such as after a rate limit or server error.
+func (e ErrorResponse) Retryable() bool {
+	if e.Response != nil {
+		return isRetryableStatus(e.Response.StatusCode)
+	}
+
+	for _, err := range e.Errors {
+		if err.Retryable() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErrorNode is an error in the tree formed by the errors of a response and their associated errors.
+type ErrorNode struct {
+	// Route is the route the error is associated with. It is empty for the top-level errors of a response.
+	Route string
+	// Error is the error itself.
+	Error ErrorResponseError
+	// Parent is the error this error is associated with. It is nil for the top-level errors of a response.
+	Parent *ErrorNode
+	// Children are the errors associated with this error.
+	Children []*ErrorNode
+}
+
+// Tree returns the errors of the response as a tree, where the children of each error are its
+// associated errors. Associated errors are ordered by route.
+func (e ErrorResponse) Tree() []*ErrorNode {
+	return newErrorNodes(e.Errors, "", nil)
+}
+
+// Walk calls fn for the node and each of its descendants, depth first.
+func (n *ErrorNode) Walk(fn func(*ErrorNode)) {
+	fn(n)
+
+	for _, child := range n.Children {
+		child.Walk(fn)
+	}
+}
+
+// Depth returns the number of ancestors of the node.
+func (n *ErrorNode) Depth() int {
+	depth := 0
+	for p := n.Parent; p != nil; p = p.Parent {
+		depth++
+	}
+
+	return depth
+}
+
+// Flatten returns every error in the response, including associated errors, depth first.
+func (e ErrorResponse) Flatten() []ErrorResponseError {
+	var errs []ErrorResponseError
+
+	for _, node := range e.Tree() {
+		node.Walk(func(n *ErrorNode) {
+			errs = append(errs, n.Error)
+		})
+	}
+
+	return errs
+}
+
+// InvalidAttributes returns the JSON pointers of every invalid attribute reported by err, if err
+// is or wraps an ErrorResponse.
+func InvalidAttributes(err error) []string {
+	var resp *ErrorResponse
+	if !errors.As(err, &resp) {
+		return nil
+	}
+
+	var pointers []string
+
+	for _, e := range resp.Flatten() {
+		if errors.Is(e, ErrAttributeInvalid) && e.Source != nil && e.Source.Pointer != "" {
+			pointers = append(pointers, e.Source.Pointer)
+		}
+	}
+
+	return pointers
+}
+
+// IsRetryable reports whether err is or wraps an ErrorResponse for a request that can be sent again unchanged.
+func IsRetryable(err error) bool {
+	var resp *ErrorResponse
+
+	return errors.As(err, &resp) && resp.Retryable()
+}
+
+func newErrorNodes(errs []ErrorResponseError, route string, parent *ErrorNode) []*ErrorNode {
+	nodes := make([]*ErrorNode, len(errs))
+
+	for i, err := range errs {
+		node := &ErrorNode{Route: route, Error: err, Parent: parent}
+
+		if err.Meta != nil {
+			routes := make([]string, 0, len(err.Meta.AssociatedErrors))
+			for r := range err.Meta.AssociatedErrors {
+				routes = append(routes, r)
+			}
+
+			sort.Strings(routes)
+
+			for _, r := range routes {
+				node.Children = append(node.Children, newErrorNodes(err.Meta.AssociatedErrors[r], r, node)...)
+			}
+		}
+
+		nodes[i] = node
+	}
+
+	return nodes
+}
+
+func hasCodePrefix(code, prefix string) bool {
+	return code == prefix || strings.HasPrefix(code, prefix+".")
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
@@ -0,0 +1,164 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const marshaledEntityErrorResponse = `{
+	"errors": [
+		{
+			"id": "a",
+			"code": "ENTITY_ERROR.ATTRIBUTE.INVALID",
+			"status": "409",
+			"title": "An attribute value is invalid.",
+			"detail": "The version string is invalid.",
+			"source": {"pointer": "/data/attributes/versionString"},
+			"meta": {
+				"associatedErrors": {
+					"/v1/appStoreVersionLocalizations/2": [
+						{
+							"id": "c",
+							"code": "STATE_ERROR.SCREENSHOT_UPLOADS_IN_PROGRESS",
+							"status": "409",
+							"title": "Screenshots are still uploading."
+						}
+					],
+					"/v1/appStoreVersionLocalizations/1": [
+						{
+							"id": "b",
+							"code": "ENTITY_ERROR.ATTRIBUTE.INVALID",
+							"status": "409",
+							"source": {"pointer": "/data/attributes/whatsNew"}
+						}
+					]
+				}
+			}
+		}
+	]
+}`
+
+func TestErrorResponseClassification(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledEntityErrorResponse, http.StatusConflict, false)
+	defer server.Close()
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+	wrapped := fmt.Errorf("updating version: %w", err)
+
+	assert.ErrorIs(t, wrapped, ErrConflict)
+	assert.ErrorIs(t, wrapped, ErrAttributeInvalid)
+	assert.ErrorIs(t, wrapped, ErrEntityStateInvalid)
+	assert.NotErrorIs(t, wrapped, ErrNotFound)
+	assert.NotErrorIs(t, wrapped, ErrRateLimited)
+	assert.False(t, IsRetryable(wrapped))
+
+	var apiErr ErrorResponseError
+	assert.ErrorAs(t, wrapped, &apiErr)
+	assert.Equal(t, "/data/attributes/versionString", apiErr.Source.Pointer)
+
+	assert.Equal(t, []string{"/data/attributes/versionString", "/data/attributes/whatsNew"}, InvalidAttributes(wrapped))
+	assert.Nil(t, InvalidAttributes(errors.New("not an API error")))
+
+	var resp *ErrorResponse
+	assert.ErrorAs(t, wrapped, &resp)
+	assert.True(t, resp.HasCode("ENTITY_ERROR"))
+	assert.True(t, resp.HasCode("STATE_ERROR"))
+	assert.False(t, resp.HasCode("ENTITY"))
+}
+
+func TestErrorResponseStatusOnly(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("{}", http.StatusNotFound, false)
+	defer server.Close()
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrConflict)
+}
+
+func TestErrorResponseRetryable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ErrorResponse{Response: &http.Response{StatusCode: 429}}.Retryable())
+	assert.True(t, ErrorResponse{Response: &http.Response{StatusCode: 503}}.Retryable())
+	assert.False(t, ErrorResponse{Response: &http.Response{StatusCode: 409}}.Retryable())
+	assert.True(t, ErrorResponse{Errors: []ErrorResponseError{{Status: "500"}}}.Retryable())
+	assert.False(t, ErrorResponse{Errors: []ErrorResponseError{{Status: "bad"}}}.Retryable())
+	assert.True(t, IsRetryable(&ErrorResponse{Response: &http.Response{StatusCode: 429}}))
+	assert.ErrorIs(t, ErrorResponseError{Code: "RATE_LIMIT_EXCEEDED"}, ErrRateLimited)
+}
+
+func TestErrorResponseTree(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledEntityErrorResponse, http.StatusConflict, false)
+	defer server.Close()
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+
+	var resp *ErrorResponse
+	assert.ErrorAs(t, err, &resp)
+
+	tree := resp.Tree()
+	assert.Len(t, tree, 1)
+	assert.Empty(t, tree[0].Route)
+	assert.Nil(t, tree[0].Parent)
+	assert.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "/v1/appStoreVersionLocalizations/1", tree[0].Children[0].Route)
+	assert.Same(t, tree[0], tree[0].Children[0].Parent)
+	assert.Equal(t, 1, tree[0].Children[1].Depth())
+
+	ids := []string{}
+	for _, e := range resp.Flatten() {
+		ids = append(ids, *e.ID)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
+
+func TestErrorResponseErrorHint(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, remediationHints["ENTITY_ERROR.ATTRIBUTE.INVALID"], ErrorResponseError{Code: "ENTITY_ERROR.ATTRIBUTE.INVALID"}.Hint())
+	assert.Equal(t, remediationHints["ENTITY_ERROR"], ErrorResponseError{Code: "ENTITY_ERROR.INCLUDED.INVALID"}.Hint())
+	assert.Equal(t, remediationHints["STATE_ERROR"], ErrorResponseError{Code: "STATE_ERROR.SCREENSHOT_UPLOADS_IN_PROGRESS"}.Hint())
+	assert.Empty(t, ErrorResponseError{Code: "SOMETHING_NEW"}.Hint())
+	assert.Empty(t, ErrorResponseError{}.Hint())
+}
+
+func TestErrorResponseErrorString(t *testing.T) {
+	t.Parallel()
+
+	err := ErrorResponseError{Status: "404", Code: "NOT_FOUND", Title: "The specified resource does not exist"}
+	assert.Equal(t, "404 NOT_FOUND – The specified resource does not exist", err.Error())
+	assert.Equal(t, 404, err.StatusCode())
+	assert.Equal(t, "not found", ErrNotFound.Error())
+}
@@ -45,6 +45,8 @@ type GameCenterAchievementImageAttributes struct {
 	FileSize           *int                `json:"fileSize,omitempty"`
 	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
 	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementImageRelationships defines model for GameCenterAchievementImage.Relationships
@@ -52,6 +54,8 @@ type GameCenterAchievementImageAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage/relationships
 type GameCenterAchievementImageRelationships struct {
 	GameCenterAchievementLocalization *Relationship `json:"gameCenterAchievementLocalization,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementImageCreateRequest defines model for GameCenterAchievementImageCreateRequest.
@@ -69,6 +73,8 @@ type gameCenterAchievementImageCreateRequest struct {
 type GameCenterAchievementImageCreateRequestAttributes struct {
 	FileName string `json:"fileName"`
 	FileSize int    `json:"fileSize"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementImageCreateRequestRelationships are relationships for GameCenterAchievementImageCreateRequest
@@ -92,6 +98,8 @@ type gameCenterAchievementImageUpdateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageupdaterequest/data/attributes
 type GameCenterAchievementImageUpdateRequestAttributes struct {
 	Uploaded *bool `json:"uploaded,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementImageResponse defines model for GameCenterAchievementImageResponse.
@@ -111,9 +119,10 @@ type GameCenterAchievementImageResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_image_information
 type GetGameCenterAchievementImageQuery struct {
-	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
-	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
+	FieldsGameCenterAchievementImages        []string     `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string     `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterAchievementImage creates a new image for an achievement localization.
@@ -44,6 +44,8 @@ type GameCenterAchievementLocalizationAttributes struct {
 	Name                    *string `json:"name,omitempty"`
 	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
 	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementLocalizationRelationships defines model for GameCenterAchievementLocalization.Relationships
@@ -52,6 +54,8 @@ type GameCenterAchievementLocalizationAttributes struct {
 type GameCenterAchievementLocalizationRelationships struct {
 	GameCenterAchievement      *Relationship `json:"gameCenterAchievement,omitempty"`
 	GameCenterAchievementImage *Relationship `json:"gameCenterAchievementImage,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementLocalizationCreateRequest defines model for GameCenterAchievementLocalizationCreateRequest.
@@ -71,6 +75,8 @@ type GameCenterAchievementLocalizationCreateRequestAttributes struct {
 	Name                    string `json:"name"`
 	BeforeEarnedDescription string `json:"beforeEarnedDescription"`
 	AfterEarnedDescription  string `json:"afterEarnedDescription"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementLocalizationCreateRequestRelationships are relationships for GameCenterAchievementLocalizationCreateRequest
@@ -96,6 +102,8 @@ type GameCenterAchievementLocalizationUpdateRequestAttributes struct {
 	Name                    *string `json:"name,omitempty"`
 	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
 	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementLocalizationResponse defines model for GameCenterAchievementLocalizationResponse.
@@ -139,23 +147,25 @@ func (i *GameCenterAchievementLocalizationResponseIncluded) GameCenterAchievemen
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_achievement
 type ListGameCenterAchievementLocalizationsQuery struct {
-	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
-	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
-	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
-	FilterLocale                             []string `url:"filter[locale],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	Limit                                    int      `url:"limit,omitempty"`
-	Cursor                                   string   `url:"cursor,omitempty"`
+	FieldsGameCenterAchievementImages        []string     `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string     `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievements             []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FilterLocale                             []string     `url:"filter[locale],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Limit                                    int          `url:"limit,omitempty"`
+	Cursor                                   string       `url:"cursor,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // GetGameCenterAchievementLocalizationQuery defines model for GetGameCenterAchievementLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_localization_information
 type GetGameCenterAchievementLocalizationQuery struct {
-	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
-	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
-	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
+	FieldsGameCenterAchievementImages        []string     `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string     `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievements             []string     `url:"fields[gameCenterAchievements],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterAchievementLocalization creates a new localization for an achievement.
@@ -41,6 +41,8 @@ type GameCenterAchievementRelease struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/attributes
 type GameCenterAchievementReleaseAttributes struct {
 	Live *bool `json:"live,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementReleaseRelationships defines model for GameCenterAchievementRelease.Relationships
@@ -49,6 +51,8 @@ type GameCenterAchievementReleaseAttributes struct {
 type GameCenterAchievementReleaseRelationships struct {
 	GameCenterAchievement *Relationship `json:"gameCenterAchievement,omitempty"`
 	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementReleaseCreateRequest defines model for GameCenterAchievementReleaseCreateRequest.
@@ -94,24 +98,26 @@ type GameCenterAchievementReleaseResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_achievement_releases
 type ListGameCenterAchievementReleasesQuery struct {
-	FieldsGameCenterAchievementReleases []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements        []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
-	FilterGameCenterAchievement         []string `url:"filter[gameCenterAchievement],omitempty"`
-	FilterLive                          []string `url:"filter[live],omitempty"`
-	Include                             []string `url:"include,omitempty"`
-	Limit                               int      `url:"limit,omitempty"`
-	Cursor                              string   `url:"cursor,omitempty"`
+	FieldsGameCenterAchievementReleases []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements        []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails             []string     `url:"fields[gameCenterDetails],omitempty"`
+	FilterGameCenterAchievement         []string     `url:"filter[gameCenterAchievement],omitempty"`
+	FilterLive                          []string     `url:"filter[live],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	Limit                               int          `url:"limit,omitempty"`
+	Cursor                              string       `url:"cursor,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // GetGameCenterAchievementReleaseQuery defines model for GetGameCenterAchievementRelease
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_release_information
 type GetGameCenterAchievementReleaseQuery struct {
-	FieldsGameCenterAchievementReleases []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements        []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
-	Include                             []string `url:"include,omitempty"`
+	FieldsGameCenterAchievementReleases []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements        []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails             []string     `url:"fields[gameCenterDetails],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterAchievementRelease creates a new release for an achievement.
@@ -51,6 +51,8 @@ type GameCenterAchievementAttributes struct {
 	Points           *int    `json:"points,omitempty"`
 	ShowBeforeEarned *bool   `json:"showBeforeEarned,omitempty"`
 	Repeatable       *bool   `json:"repeatable,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementRelationships defines model for GameCenterAchievement.Relationships
@@ -62,6 +64,8 @@ type GameCenterAchievementRelationships struct {
 	GroupAchievement *Relationship      `json:"groupAchievement,omitempty"`
 	Localizations    *PagedRelationship `json:"localizations,omitempty"`
 	Releases         *PagedRelationship `json:"releases,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementCreateRequest defines model for GameCenterAchievementCreateRequest.
@@ -82,6 +86,8 @@ type GameCenterAchievementCreateRequestAttributes struct {
 	Points           int    `json:"points"`
 	ShowBeforeEarned bool   `json:"showBeforeEarned"`
 	Repeatable       bool   `json:"repeatable"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterAchievementCreateRequestRelationships are relationships for GameCenterAchievementCreateRequest
@@ -110,6 +116,8 @@ type GameCenterAchievementUpdateRequestAttributes struct {
 	ShowBeforeEarned *bool   `json:"showBeforeEarned,omitempty"`
 	Repeatable       *bool   `json:"repeatable,omitempty"`
 	Archived         *bool   `json:"archived,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterAchievementResponse defines model for GameCenterAchievementResponse.
@@ -139,35 +147,37 @@ type GameCenterAchievementResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements
 type ListGameCenterAchievementsQuery struct {
-	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
-	FieldsGameCenterAchievementReleases      []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
-	FilterArchived                           []string `url:"filter[archived],omitempty"`
-	FilterID                                 []string `url:"filter[id],omitempty"`
-	FilterReferenceName                      []string `url:"filter[referenceName],omitempty"`
-	FilterVendorIdentifier                   []string `url:"filter[vendorIdentifier],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	Limit                                    int      `url:"limit,omitempty"`
-	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
-	LimitReleases                            int      `url:"limit[releases],omitempty"`
-	Sort                                     []string `url:"sort,omitempty"`
-	Cursor                                   string   `url:"cursor,omitempty"`
+	FieldsGameCenterAchievementLocalizations []string     `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievementReleases      []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements             []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails                  []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string     `url:"fields[gameCenterGroups],omitempty"`
+	FilterArchived                           []string     `url:"filter[archived],omitempty"`
+	FilterID                                 []string     `url:"filter[id],omitempty"`
+	FilterReferenceName                      []string     `url:"filter[referenceName],omitempty"`
+	FilterVendorIdentifier                   []string     `url:"filter[vendorIdentifier],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Limit                                    int          `url:"limit,omitempty"`
+	LimitLocalizations                       int          `url:"limit[localizations],omitempty"`
+	LimitReleases                            int          `url:"limit[releases],omitempty"`
+	Sort                                     []string     `url:"sort,omitempty"`
+	Cursor                                   string       `url:"cursor,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // GetGameCenterAchievementQuery defines model for GetGameCenterAchievement
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_information
 type GetGameCenterAchievementQuery struct {
-	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
-	FieldsGameCenterAchievementReleases      []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails                  []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups                   []string `url:"fields[gameCenterGroups],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
-	LimitReleases                            int      `url:"limit[releases],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string     `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievementReleases      []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements             []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails                  []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                   []string     `url:"fields[gameCenterGroups],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	LimitLocalizations                       int          `url:"limit[localizations],omitempty"`
+	LimitReleases                            int          `url:"limit[releases],omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterAchievement creates a new achievement for a Game Center detail.
@@ -45,6 +45,8 @@ type GameCenterDetailAttributes struct {
 	DefaultGroupLeaderboard *bool `json:"defaultGroupLeaderboard,omitempty"`
 	DefaultLeaderboard      *bool `json:"defaultLeaderboard,omitempty"`
 	GameCenterEnabled       *bool `json:"gameCenterEnabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterDetailRelationships defines model for GameCenterDetail.Relationships
@@ -64,6 +66,8 @@ type GameCenterDetailRelationships struct {
 	GameCenterLeaderboardsV2    *PagedRelationship `json:"gameCenterLeaderboardsV2,omitempty"`
 	LeaderboardReleases         *PagedRelationship `json:"leaderboardReleases,omitempty"`
 	LeaderboardSetReleases      *PagedRelationship `json:"leaderboardSetReleases,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterDetailCreateRequest defines model for GameCenterDetailCreateRequest.
@@ -96,6 +100,8 @@ type gameCenterDetailUpdateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data/attributes
 type GameCenterDetailUpdateRequestAttributes struct {
 	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterDetailUpdateRequestRelationships are relationships for GameCenterDetailUpdateRequest
@@ -138,50 +144,52 @@ func (i *GameCenterDetailResponseIncluded) GameCenterLeaderboard() *GameCenterLe
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_details
 type GetGameCenterDetailQuery struct {
-	FieldsGameCenterAchievementReleases    []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements           []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterAppVersions            []string `url:"fields[gameCenterAppVersions],omitempty"`
-	FieldsGameCenterDetails                []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups                 []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboardReleases    []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
-	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
-	FieldsGameCenterLeaderboardSets        []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
-	FieldsGameCenterLeaderboards           []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	Include                                []string `url:"include,omitempty"`
-	LimitAchievementReleases               int      `url:"limit[achievementReleases],omitempty"`
-	LimitGameCenterAchievements            int      `url:"limit[gameCenterAchievements],omitempty"`
-	LimitGameCenterAppVersions             int      `url:"limit[gameCenterAppVersions],omitempty"`
-	LimitGameCenterLeaderboardSets         int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
-	LimitGameCenterLeaderboardSetsV2       int      `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
-	LimitGameCenterLeaderboards            int      `url:"limit[gameCenterLeaderboards],omitempty"`
-	LimitGameCenterLeaderboardsV2          int      `url:"limit[gameCenterLeaderboardsV2],omitempty"`
-	LimitLeaderboardReleases               int      `url:"limit[leaderboardReleases],omitempty"`
-	LimitLeaderboardSetReleases            int      `url:"limit[leaderboardSetReleases],omitempty"`
+	FieldsGameCenterAchievementReleases    []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements           []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterAppVersions            []string     `url:"fields[gameCenterAppVersions],omitempty"`
+	FieldsGameCenterDetails                []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                 []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardReleases    []string     `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string     `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets        []string     `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards           []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                                []string     `url:"include,omitempty"`
+	LimitAchievementReleases               int          `url:"limit[achievementReleases],omitempty"`
+	LimitGameCenterAchievements            int          `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterAppVersions             int          `url:"limit[gameCenterAppVersions],omitempty"`
+	LimitGameCenterLeaderboardSets         int          `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboardSetsV2       int          `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
+	LimitGameCenterLeaderboards            int          `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitGameCenterLeaderboardsV2          int          `url:"limit[gameCenterLeaderboardsV2],omitempty"`
+	LimitLeaderboardReleases               int          `url:"limit[leaderboardReleases],omitempty"`
+	LimitLeaderboardSetReleases            int          `url:"limit[leaderboardSetReleases],omitempty"`
+	Query                                  QueryEncoder `url:"-"`
 }
 
 // GetGameCenterDetailForAppQuery defines model for GetGameCenterDetailForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_game_center_detail_for_an_app
 type GetGameCenterDetailForAppQuery struct {
-	FieldsGameCenterAchievementReleases    []string `url:"fields[gameCenterAchievementReleases],omitempty"`
-	FieldsGameCenterAchievements           []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterAppVersions            []string `url:"fields[gameCenterAppVersions],omitempty"`
-	FieldsGameCenterDetails                []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups                 []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboardReleases    []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
-	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
-	FieldsGameCenterLeaderboardSets        []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
-	FieldsGameCenterLeaderboards           []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	Include                                []string `url:"include,omitempty"`
-	LimitAchievementReleases               int      `url:"limit[achievementReleases],omitempty"`
-	LimitGameCenterAchievements            int      `url:"limit[gameCenterAchievements],omitempty"`
-	LimitGameCenterAppVersions             int      `url:"limit[gameCenterAppVersions],omitempty"`
-	LimitGameCenterLeaderboardSets         int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
-	LimitGameCenterLeaderboardSetsV2       int      `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
-	LimitGameCenterLeaderboards            int      `url:"limit[gameCenterLeaderboards],omitempty"`
-	LimitGameCenterLeaderboardsV2          int      `url:"limit[gameCenterLeaderboardsV2],omitempty"`
-	LimitLeaderboardReleases               int      `url:"limit[leaderboardReleases],omitempty"`
-	LimitLeaderboardSetReleases            int      `url:"limit[leaderboardSetReleases],omitempty"`
+	FieldsGameCenterAchievementReleases    []string     `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterAchievements           []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterAppVersions            []string     `url:"fields[gameCenterAppVersions],omitempty"`
+	FieldsGameCenterDetails                []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups                 []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardReleases    []string     `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string     `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FieldsGameCenterLeaderboardSets        []string     `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards           []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                                []string     `url:"include,omitempty"`
+	LimitAchievementReleases               int          `url:"limit[achievementReleases],omitempty"`
+	LimitGameCenterAchievements            int          `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterAppVersions             int          `url:"limit[gameCenterAppVersions],omitempty"`
+	LimitGameCenterLeaderboardSets         int          `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboardSetsV2       int          `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
+	LimitGameCenterLeaderboards            int          `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitGameCenterLeaderboardsV2          int          `url:"limit[gameCenterLeaderboardsV2],omitempty"`
+	LimitLeaderboardReleases               int          `url:"limit[leaderboardReleases],omitempty"`
+	LimitLeaderboardSetReleases            int          `url:"limit[leaderboardSetReleases],omitempty"`
+	Query                                  QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterDetail creates a Game Center detail for an app.
@@ -41,6 +41,8 @@ type GameCenterGroup struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/attributes
 type GameCenterGroupAttributes struct {
 	ReferenceName *string `json:"referenceName,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterGroupRelationships defines model for GameCenterGroup.Relationships
@@ -53,6 +55,8 @@ type GameCenterGroupRelationships struct {
 	GameCenterLeaderboardSetsV2 *PagedRelationship `json:"gameCenterLeaderboardSetsV2,omitempty"`
 	GameCenterLeaderboards      *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
 	GameCenterLeaderboardsV2    *PagedRelationship `json:"gameCenterLeaderboardsV2,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterGroupCreateRequest defines model for GameCenterGroupCreateRequest.
@@ -84,6 +88,8 @@ type gameCenterGroupUpdateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupupdaterequest/data/attributes
 type GameCenterGroupUpdateRequestAttributes struct {
 	ReferenceName *string `json:"referenceName,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterGroupResponse defines model for GameCenterGroupResponse.
@@ -127,39 +133,41 @@ type GameCenterGroupsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_game_center_groups
 type ListGameCenterGroupsQuery struct {
-	FieldsGameCenterAchievements     []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails          []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups           []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboardSets  []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
-	FieldsGameCenterLeaderboards     []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	FilterGameCenterDetails          []string `url:"filter[gameCenterDetails],omitempty"`
-	Include                          []string `url:"include,omitempty"`
-	Limit                            int      `url:"limit,omitempty"`
-	LimitGameCenterAchievements      int      `url:"limit[gameCenterAchievements],omitempty"`
-	LimitGameCenterDetails           int      `url:"limit[gameCenterDetails],omitempty"`
-	LimitGameCenterLeaderboardSets   int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
-	LimitGameCenterLeaderboardSetsV2 int      `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
-	LimitGameCenterLeaderboards      int      `url:"limit[gameCenterLeaderboards],omitempty"`
-	LimitGameCenterLeaderboardsV2    int      `url:"limit[gameCenterLeaderboardsV2],omitempty"`
-	Cursor                           string   `url:"cursor,omitempty"`
+	FieldsGameCenterAchievements     []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails          []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups           []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSets  []string     `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards     []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterGameCenterDetails          []string     `url:"filter[gameCenterDetails],omitempty"`
+	Include                          []string     `url:"include,omitempty"`
+	Limit                            int          `url:"limit,omitempty"`
+	LimitGameCenterAchievements      int          `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterDetails           int          `url:"limit[gameCenterDetails],omitempty"`
+	LimitGameCenterLeaderboardSets   int          `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboardSetsV2 int          `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
+	LimitGameCenterLeaderboards      int          `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitGameCenterLeaderboardsV2    int          `url:"limit[gameCenterLeaderboardsV2],omitempty"`
+	Cursor                           string       `url:"cursor,omitempty"`
+	Query                            QueryEncoder `url:"-"`
 }
 
 // GetGameCenterGroupQuery defines model for GetGameCenterGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_group_information
 type GetGameCenterGroupQuery struct {
-	FieldsGameCenterAchievements     []string `url:"fields[gameCenterAchievements],omitempty"`
-	FieldsGameCenterDetails          []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups           []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboardSets  []string `url:"fields[gameCenterLeaderboardSets],omitempty"`
-	FieldsGameCenterLeaderboards     []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	Include                          []string `url:"include,omitempty"`
-	LimitGameCenterAchievements      int      `url:"limit[gameCenterAchievements],omitempty"`
-	LimitGameCenterDetails           int      `url:"limit[gameCenterDetails],omitempty"`
-	LimitGameCenterLeaderboardSets   int      `url:"limit[gameCenterLeaderboardSets],omitempty"`
-	LimitGameCenterLeaderboardSetsV2 int      `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
-	LimitGameCenterLeaderboards      int      `url:"limit[gameCenterLeaderboards],omitempty"`
-	LimitGameCenterLeaderboardsV2    int      `url:"limit[gameCenterLeaderboardsV2],omitempty"`
+	FieldsGameCenterAchievements     []string     `url:"fields[gameCenterAchievements],omitempty"`
+	FieldsGameCenterDetails          []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups           []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboardSets  []string     `url:"fields[gameCenterLeaderboardSets],omitempty"`
+	FieldsGameCenterLeaderboards     []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                          []string     `url:"include,omitempty"`
+	LimitGameCenterAchievements      int          `url:"limit[gameCenterAchievements],omitempty"`
+	LimitGameCenterDetails           int          `url:"limit[gameCenterDetails],omitempty"`
+	LimitGameCenterLeaderboardSets   int          `url:"limit[gameCenterLeaderboardSets],omitempty"`
+	LimitGameCenterLeaderboardSetsV2 int          `url:"limit[gameCenterLeaderboardSetsV2],omitempty"`
+	LimitGameCenterLeaderboards      int          `url:"limit[gameCenterLeaderboards],omitempty"`
+	LimitGameCenterLeaderboardsV2    int          `url:"limit[gameCenterLeaderboardsV2],omitempty"`
+	Query                            QueryEncoder `url:"-"`
 }
 
 // ListGameCenterGroups lists all Game Center groups.
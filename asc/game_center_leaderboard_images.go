@@ -45,6 +45,8 @@ type GameCenterLeaderboardImageAttributes struct {
 	FileSize           *int                `json:"fileSize,omitempty"`
 	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
 	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardImageRelationships defines model for GameCenterLeaderboardImage.Relationships
@@ -52,6 +54,8 @@ type GameCenterLeaderboardImageAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimagev2/relationships
 type GameCenterLeaderboardImageRelationships struct {
 	Localization *Relationship `json:"localization,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardImageCreateRequest defines model for GameCenterLeaderboardImageV2CreateRequest.
@@ -69,6 +73,8 @@ type gameCenterLeaderboardImageCreateRequest struct {
 type GameCenterLeaderboardImageCreateRequestAttributes struct {
 	FileName string `json:"fileName"`
 	FileSize int    `json:"fileSize"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardImageCreateRequestRelationships are relationships for GameCenterLeaderboardImageV2CreateRequest.
@@ -92,6 +98,8 @@ type gameCenterLeaderboardImageUpdateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardimagev2/attributes
 type GameCenterLeaderboardImageUpdateRequestAttributes struct {
 	Uploaded *bool `json:"uploaded,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardImageResponse defines model for GameCenterLeaderboardImageV2Response.
@@ -111,9 +119,10 @@ type GameCenterLeaderboardImageResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardimages-_id_
 type GetGameCenterLeaderboardImageQuery struct {
-	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
-	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
+	FieldsGameCenterLeaderboardImages        []string     `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string     `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterLeaderboardImage creates a new image for a leaderboard localization.
@@ -133,7 +142,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardImage(ctx context.Context
 		Type: "gameCenterLeaderboardImages",
 	}
 	res := new(GameCenterLeaderboardImageResponse)
-	resp, err := s.client.post(ctx, "../v2/gameCenterLeaderboardImages", newRequestBody(req), res)
+	resp, err := s.client.post(ctx, "v2/gameCenterLeaderboardImages", newRequestBody(req), res)
 
 	return res, resp, err
 }
@@ -142,7 +151,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardImage(ctx context.Context
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardimages-_id_
 func (s *GameCenterService) GetGameCenterLeaderboardImage(ctx context.Context, id string, params *GetGameCenterLeaderboardImageQuery) (*GameCenterLeaderboardImageResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardImages/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardImages/%s", id)
 	res := new(GameCenterLeaderboardImageResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
@@ -158,7 +167,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboardImage(ctx context.Context
 		ID:         id,
 		Type:       "gameCenterLeaderboardImages",
 	}
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardImages/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardImages/%s", id)
 	res := new(GameCenterLeaderboardImageResponse)
 	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
 
@@ -169,7 +178,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboardImage(ctx context.Context
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/delete-v2-gamecenterleaderboardimages-_id_
 func (s *GameCenterService) DeleteGameCenterLeaderboardImage(ctx context.Context, id string) (*Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardImages/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardImages/%s", id)
 
 	return s.client.delete(ctx, url, nil)
 }
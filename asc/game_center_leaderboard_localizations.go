@@ -46,6 +46,8 @@ type GameCenterLeaderboardLocalizationAttributes struct {
 	FormatterSuffixSingular *string `json:"formatterSuffixSingular,omitempty"`
 	Locale                  *string `json:"locale,omitempty"`
 	Name                    *string `json:"name,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardLocalizationRelationships defines model for GameCenterLeaderboardLocalization.Relationships
@@ -54,6 +56,8 @@ type GameCenterLeaderboardLocalizationAttributes struct {
 type GameCenterLeaderboardLocalizationRelationships struct {
 	Image   *Relationship `json:"image,omitempty"`
 	Version *Relationship `json:"version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardLocalizationCreateRequest defines model for GameCenterLeaderboardLocalizationV2CreateRequest.
@@ -75,6 +79,8 @@ type GameCenterLeaderboardLocalizationCreateRequestAttributes struct {
 	FormatterSuffixSingular *string `json:"formatterSuffixSingular,omitempty"`
 	Locale                  string  `json:"locale"`
 	Name                    string  `json:"name"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardLocalizationCreateRequestRelationships are relationships for GameCenterLeaderboardLocalizationV2CreateRequest.
@@ -102,6 +108,8 @@ type GameCenterLeaderboardLocalizationUpdateRequestAttributes struct {
 	FormatterSuffix         *string `json:"formatterSuffix,omitempty"`
 	FormatterSuffixSingular *string `json:"formatterSuffixSingular,omitempty"`
 	Name                    *string `json:"name,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardLocalizationResponse defines model for GameCenterLeaderboardLocalizationV2Response.
@@ -145,23 +153,25 @@ func (i *GameCenterLeaderboardLocalizationResponseIncluded) GameCenterLeaderboar
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/game-center-leaderboard-localizations
 type ListGameCenterLeaderboardLocalizationsQuery struct {
-	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
-	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
-	FieldsGameCenterLeaderboardVersions      []string `url:"fields[gameCenterLeaderboardVersions],omitempty"`
-	FilterLocale                             []string `url:"filter[locale],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	Limit                                    int      `url:"limit,omitempty"`
-	Cursor                                   string   `url:"cursor,omitempty"`
+	FieldsGameCenterLeaderboardImages        []string     `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string     `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardVersions      []string     `url:"fields[gameCenterLeaderboardVersions],omitempty"`
+	FilterLocale                             []string     `url:"filter[locale],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Limit                                    int          `url:"limit,omitempty"`
+	Cursor                                   string       `url:"cursor,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // GetGameCenterLeaderboardLocalizationQuery defines model for GetGameCenterLeaderboardLocalization.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardlocalizations-_id_
 type GetGameCenterLeaderboardLocalizationQuery struct {
-	FieldsGameCenterLeaderboardImages        []string `url:"fields[gameCenterLeaderboardImages],omitempty"`
-	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
-	FieldsGameCenterLeaderboardVersions      []string `url:"fields[gameCenterLeaderboardVersions],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
+	FieldsGameCenterLeaderboardImages        []string     `url:"fields[gameCenterLeaderboardImages],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string     `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardVersions      []string     `url:"fields[gameCenterLeaderboardVersions],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterLeaderboardLocalization creates a new localization for a leaderboard version.
@@ -181,7 +191,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardLocalization(ctx context.
 		Type: "gameCenterLeaderboardLocalizations",
 	}
 	res := new(GameCenterLeaderboardLocalizationResponse)
-	resp, err := s.client.post(ctx, "../v2/gameCenterLeaderboardLocalizations", newRequestBody(req), res)
+	resp, err := s.client.post(ctx, "v2/gameCenterLeaderboardLocalizations", newRequestBody(req), res)
 
 	return res, resp, err
 }
@@ -190,7 +200,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardLocalization(ctx context.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardlocalizations-_id_
 func (s *GameCenterService) GetGameCenterLeaderboardLocalization(ctx context.Context, id string, params *GetGameCenterLeaderboardLocalizationQuery) (*GameCenterLeaderboardLocalizationResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardLocalizations/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardLocalizations/%s", id)
 	res := new(GameCenterLeaderboardLocalizationResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
@@ -206,7 +216,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboardLocalization(ctx context.
 		ID:         id,
 		Type:       "gameCenterLeaderboardLocalizations",
 	}
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardLocalizations/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardLocalizations/%s", id)
 	res := new(GameCenterLeaderboardLocalizationResponse)
 	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
 
@@ -217,7 +227,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboardLocalization(ctx context.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/delete-v2-gamecenterleaderboardlocalizations-_id_
 func (s *GameCenterService) DeleteGameCenterLeaderboardLocalization(ctx context.Context, id string) (*Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardLocalizations/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardLocalizations/%s", id)
 
 	return s.client.delete(ctx, url, nil)
 }
@@ -226,7 +236,7 @@ func (s *GameCenterService) DeleteGameCenterLeaderboardLocalization(ctx context.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardversions-_id_-localizations
 func (s *GameCenterService) ListGameCenterLeaderboardLocalizationsForVersion(ctx context.Context, gameCenterLeaderboardVersionID string, params *ListGameCenterLeaderboardLocalizationsQuery) (*GameCenterLeaderboardLocalizationsResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardVersions/%s/localizations", gameCenterLeaderboardVersionID)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardVersions/%s/localizations", gameCenterLeaderboardVersionID)
 	res := new(GameCenterLeaderboardLocalizationsResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
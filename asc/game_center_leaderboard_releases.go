@@ -41,6 +41,8 @@ type GameCenterLeaderboardRelease struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease
 type GameCenterLeaderboardReleaseAttributes struct {
 	Live *bool `json:"live,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardReleaseRelationships defines model for GameCenterLeaderboardRelease.Relationships
@@ -49,6 +51,8 @@ type GameCenterLeaderboardReleaseAttributes struct {
 type GameCenterLeaderboardReleaseRelationships struct {
 	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
 	GameCenterLeaderboard *Relationship `json:"gameCenterLeaderboard,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardReleaseCreateRequest defines model for GameCenterLeaderboardReleaseCreateRequest.
@@ -94,24 +98,26 @@ type GameCenterLeaderboardReleaseResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/game-center-leaderboard-releases
 type ListGameCenterLeaderboardReleasesQuery struct {
-	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterLeaderboardReleases []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
-	FieldsGameCenterLeaderboards        []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	FilterGameCenterLeaderboard         []string `url:"filter[gameCenterLeaderboard],omitempty"`
-	FilterLive                          []string `url:"filter[live],omitempty"`
-	Include                             []string `url:"include,omitempty"`
-	Limit                               int      `url:"limit,omitempty"`
-	Cursor                              string   `url:"cursor,omitempty"`
+	FieldsGameCenterDetails             []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardReleases []string     `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboards        []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterGameCenterLeaderboard         []string     `url:"filter[gameCenterLeaderboard],omitempty"`
+	FilterLive                          []string     `url:"filter[live],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	Limit                               int          `url:"limit,omitempty"`
+	Cursor                              string       `url:"cursor,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // GetGameCenterLeaderboardReleaseQuery defines model for GetGameCenterLeaderboardRelease.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterleaderboardreleases-_id_
 type GetGameCenterLeaderboardReleaseQuery struct {
-	FieldsGameCenterDetails             []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterLeaderboardReleases []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
-	FieldsGameCenterLeaderboards        []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	Include                             []string `url:"include,omitempty"`
+	FieldsGameCenterDetails             []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterLeaderboardReleases []string     `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboards        []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                             []string     `url:"include,omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterLeaderboardRelease creates a new release for a leaderboard.
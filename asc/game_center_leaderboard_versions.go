@@ -42,6 +42,8 @@ type GameCenterLeaderboardVersion struct {
 type GameCenterLeaderboardVersionAttributes struct {
 	State   *string `json:"state,omitempty"`
 	Version *int64  `json:"version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardVersionRelationships defines model for GameCenterLeaderboardVersion.Relationships
@@ -50,6 +52,8 @@ type GameCenterLeaderboardVersionAttributes struct {
 type GameCenterLeaderboardVersionRelationships struct {
 	Leaderboard   *Relationship      `json:"leaderboard,omitempty"`
 	Localizations *PagedRelationship `json:"localizations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardVersionCreateRequest defines model for GameCenterLeaderboardVersionV2CreateRequest.
@@ -108,22 +112,24 @@ func (i *GameCenterLeaderboardVersionResponseIncluded) GameCenterLeaderboardLoca
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/game-center-leaderboard-versions
 type ListGameCenterLeaderboardVersionsQuery struct {
-	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
-	FieldsGameCenterLeaderboardVersions      []string `url:"fields[gameCenterLeaderboardVersions],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	Limit                                    int      `url:"limit,omitempty"`
-	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
-	Cursor                                   string   `url:"cursor,omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string     `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardVersions      []string     `url:"fields[gameCenterLeaderboardVersions],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	Limit                                    int          `url:"limit,omitempty"`
+	LimitLocalizations                       int          `url:"limit[localizations],omitempty"`
+	Cursor                                   string       `url:"cursor,omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // GetGameCenterLeaderboardVersionQuery defines model for GetGameCenterLeaderboardVersion.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardversions-_id_
 type GetGameCenterLeaderboardVersionQuery struct {
-	FieldsGameCenterLeaderboardLocalizations []string `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
-	FieldsGameCenterLeaderboardVersions      []string `url:"fields[gameCenterLeaderboardVersions],omitempty"`
-	Include                                  []string `url:"include,omitempty"`
-	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	FieldsGameCenterLeaderboardLocalizations []string     `url:"fields[gameCenterLeaderboardLocalizations],omitempty"`
+	FieldsGameCenterLeaderboardVersions      []string     `url:"fields[gameCenterLeaderboardVersions],omitempty"`
+	Include                                  []string     `url:"include,omitempty"`
+	LimitLocalizations                       int          `url:"limit[localizations],omitempty"`
+	Query                                    QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterLeaderboardVersion creates a new version for a leaderboard.
@@ -142,7 +148,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardVersion(ctx context.Conte
 		Type: "gameCenterLeaderboardVersions",
 	}
 	res := new(GameCenterLeaderboardVersionResponse)
-	resp, err := s.client.post(ctx, "../v2/gameCenterLeaderboardVersions", newRequestBody(req), res)
+	resp, err := s.client.post(ctx, "v2/gameCenterLeaderboardVersions", newRequestBody(req), res)
 
 	return res, resp, err
 }
@@ -151,7 +157,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardVersion(ctx context.Conte
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboardversions-_id_
 func (s *GameCenterService) GetGameCenterLeaderboardVersion(ctx context.Context, id string, params *GetGameCenterLeaderboardVersionQuery) (*GameCenterLeaderboardVersionResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboardVersions/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboardVersions/%s", id)
 	res := new(GameCenterLeaderboardVersionResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
@@ -162,7 +168,7 @@ func (s *GameCenterService) GetGameCenterLeaderboardVersion(ctx context.Context,
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboards-_id_-versions
 func (s *GameCenterService) ListGameCenterLeaderboardVersionsForLeaderboard(ctx context.Context, gameCenterLeaderboardID string, params *ListGameCenterLeaderboardVersionsQuery) (*GameCenterLeaderboardVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboards/%s/versions", gameCenterLeaderboardID)
+	url := fmt.Sprintf("v2/gameCenterLeaderboards/%s/versions", gameCenterLeaderboardID)
 	res := new(GameCenterLeaderboardVersionsResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
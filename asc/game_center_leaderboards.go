@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 )
 
@@ -61,9 +62,12 @@ type GameCenterLeaderboardAttributes struct {
 	SubmissionType      *string            `json:"submissionType,omitempty"`
 	VendorIdentifier    *string            `json:"vendorIdentifier,omitempty"`
 	Visibility          *string            `json:"visibility,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
-// UnmarshalJSON accepts the numeric strings returned by App Store Connect for score ranges.
+// UnmarshalJSON accepts the numeric strings returned by App Store Connect for score ranges, and
+// keeps unknown members in Unknown.
 func (a *GameCenterLeaderboardAttributes) UnmarshalJSON(data []byte) error {
 	type attributesAlias GameCenterLeaderboardAttributes
 	decoded := struct {
@@ -92,7 +96,9 @@ func (a *GameCenterLeaderboardAttributes) UnmarshalJSON(data []byte) error {
 		}
 	}
 
-	return nil
+	a.Unknown, err = decodeUnknownFields(data, reflect.TypeOf(*a))
+
+	return err
 }
 
 func decodeNullableInt64(data []byte) (*int64, error) {
@@ -129,6 +135,8 @@ type GameCenterLeaderboardRelationships struct {
 	GameCenterGroup           *Relationship      `json:"gameCenterGroup,omitempty"`
 	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
 	Versions                  *PagedRelationship `json:"versions,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardCreateRequest defines model for GameCenterLeaderboardV2CreateRequest.
@@ -156,6 +164,8 @@ type GameCenterLeaderboardCreateRequestAttributes struct {
 	SubmissionType      string             `json:"submissionType"`
 	VendorIdentifier    string             `json:"vendorIdentifier"`
 	Visibility          *string            `json:"visibility,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardCreateRequestRelationships are relationships for GameCenterLeaderboardV2CreateRequest.
@@ -181,6 +191,8 @@ type GameCenterLeaderboardVersionInlineCreate struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardversionv2inlinecreate/relationships
 type GameCenterLeaderboardVersionInlineCreateRelationships struct {
 	Leaderboard *relationshipDeclaration `json:"leaderboard,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // gameCenterLeaderboardUpdateRequest defines model for GameCenterLeaderboardV2UpdateRequest.
@@ -208,6 +220,8 @@ type GameCenterLeaderboardUpdateRequestAttributes struct {
 	ScoreSortType       *string            `json:"scoreSortType,omitempty"`
 	SubmissionType      *string            `json:"submissionType,omitempty"`
 	Visibility          *string            `json:"visibility,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // GameCenterLeaderboardResponse defines model for GameCenterLeaderboardV2Response.
@@ -251,29 +265,31 @@ func (i *GameCenterLeaderboardResponseIncluded) GameCenterLeaderboardVersion() *
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/game-center-leaderboards
 type ListGameCenterLeaderboardsQuery struct {
-	FieldsGameCenterDetails      []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups       []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboards []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	FilterArchived               []string `url:"filter[archived],omitempty"`
-	FilterID                     []string `url:"filter[id],omitempty"`
-	FilterReferenceName          []string `url:"filter[referenceName],omitempty"`
-	FilterVendorIdentifier       []string `url:"filter[vendorIdentifier],omitempty"`
-	Include                      []string `url:"include,omitempty"`
-	Limit                        int      `url:"limit,omitempty"`
-	LimitVersions                int      `url:"limit[versions],omitempty"`
-	Sort                         []string `url:"sort,omitempty"`
-	Cursor                       string   `url:"cursor,omitempty"`
+	FieldsGameCenterDetails      []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups       []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboards []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterArchived               []string     `url:"filter[archived],omitempty"`
+	FilterID                     []string     `url:"filter[id],omitempty"`
+	FilterReferenceName          []string     `url:"filter[referenceName],omitempty"`
+	FilterVendorIdentifier       []string     `url:"filter[vendorIdentifier],omitempty"`
+	Include                      []string     `url:"include,omitempty"`
+	Limit                        int          `url:"limit,omitempty"`
+	LimitVersions                int          `url:"limit[versions],omitempty"`
+	Sort                         []string     `url:"sort,omitempty"`
+	Cursor                       string       `url:"cursor,omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // GetGameCenterLeaderboardQuery defines model for GetGameCenterLeaderboard.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboards-_id_
 type GetGameCenterLeaderboardQuery struct {
-	FieldsGameCenterDetails      []string `url:"fields[gameCenterDetails],omitempty"`
-	FieldsGameCenterGroups       []string `url:"fields[gameCenterGroups],omitempty"`
-	FieldsGameCenterLeaderboards []string `url:"fields[gameCenterLeaderboards],omitempty"`
-	Include                      []string `url:"include,omitempty"`
-	LimitVersions                int      `url:"limit[versions],omitempty"`
+	FieldsGameCenterDetails      []string     `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups       []string     `url:"fields[gameCenterGroups],omitempty"`
+	FieldsGameCenterLeaderboards []string     `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                      []string     `url:"include,omitempty"`
+	LimitVersions                int          `url:"limit[versions],omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // CreateGameCenterLeaderboard creates a new leaderboard for a Game Center detail.
@@ -302,7 +318,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboard(ctx context.Context, att
 		},
 	}
 	res := new(GameCenterLeaderboardResponse)
-	resp, err := s.client.post(ctx, "../v2/gameCenterLeaderboards", newRequestBodyWithIncluded(req, included), res)
+	resp, err := s.client.post(ctx, "v2/gameCenterLeaderboards", newRequestBodyWithIncluded(req, included), res)
 
 	return res, resp, err
 }
@@ -334,7 +350,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardForGroup(ctx context.Cont
 		},
 	}
 	res := new(GameCenterLeaderboardResponse)
-	resp, err := s.client.post(ctx, "../v2/gameCenterLeaderboards", newRequestBodyWithIncluded(req, included), res)
+	resp, err := s.client.post(ctx, "v2/gameCenterLeaderboards", newRequestBodyWithIncluded(req, included), res)
 
 	return res, resp, err
 }
@@ -343,7 +359,7 @@ func (s *GameCenterService) CreateGameCenterLeaderboardForGroup(ctx context.Cont
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get-v2-gamecenterleaderboards-_id_
 func (s *GameCenterService) GetGameCenterLeaderboard(ctx context.Context, id string, params *GetGameCenterLeaderboardQuery) (*GameCenterLeaderboardResponse, *Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboards/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboards/%s", id)
 	res := new(GameCenterLeaderboardResponse)
 	resp, err := s.client.get(ctx, url, params, res)
 
@@ -359,7 +375,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboard(ctx context.Context, id
 		ID:         id,
 		Type:       "gameCenterLeaderboards",
 	}
-	url := fmt.Sprintf("../v2/gameCenterLeaderboards/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboards/%s", id)
 	res := new(GameCenterLeaderboardResponse)
 	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
 
@@ -370,7 +386,7 @@ func (s *GameCenterService) UpdateGameCenterLeaderboard(ctx context.Context, id
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/delete-v2-gamecenterleaderboards-_id_
 func (s *GameCenterService) DeleteGameCenterLeaderboard(ctx context.Context, id string) (*Response, error) {
-	url := fmt.Sprintf("../v2/gameCenterLeaderboards/%s", id)
+	url := fmt.Sprintf("v2/gameCenterLeaderboards/%s", id)
 
 	return s.client.delete(ctx, url, nil)
 }
@@ -0,0 +1,334 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Resource is a generic JSON:API resource with attributes of type A and relationships of type R.
+// Use it to decode resources the library does not model yet, or to resolve included resources
+// with an IncludedGraph.
+type Resource[A, R any] struct {
+	Attributes    *A            `json:"attributes,omitempty"`
+	ID            string        `json:"id"`
+	Links         ResourceLinks `json:"links"`
+	Relationships *R            `json:"relationships,omitempty"`
+	Type          string        `json:"type"`
+}
+
+// Linkage returns the type and ID of the resource.
+func (r Resource[A, R]) Linkage() RelationshipData {
+	return RelationshipData{ID: r.ID, Type: r.Type}
+}
+
+// Linkage is implemented by relationships, and returns the type and ID of each related resource.
+type Linkage interface {
+	Linkages() []RelationshipData
+}
+
+// Linkages returns the type and ID of the related resource, if there is one.
+func (r *Relationship) Linkages() []RelationshipData {
+	if r == nil || r.Data == nil {
+		return nil
+	}
+
+	return []RelationshipData{*r.Data}
+}
+
+// Linkages returns the type and ID of each related resource.
+func (r *PagedRelationship) Linkages() []RelationshipData {
+	if r == nil {
+		return nil
+	}
+
+	return r.Data
+}
+
+// Linkages returns the linkage itself.
+func (d RelationshipData) Linkages() []RelationshipData {
+	return []RelationshipData{d}
+}
+
+// IncludedGraph indexes the included resources of a response by type and ID, so that the
+// relationships of a resource can be resolved to the included resources they refer to:
+//
+//	graph, err := asc.NewIncludedGraph(builds)
+//	groups, err := asc.Resolve[asc.BetaGroup](graph, build.Relationships.BetaGroups)
+//
+// An IncludedGraph can also be decoded from the "included" member of a document directly.
+type IncludedGraph struct {
+	entries map[RelationshipData]*includedEntry
+	order   []RelationshipData
+}
+
+type includedEntry struct {
+	value interface{}
+	raw   json.RawMessage
+}
+
+// NewIncludedGraph indexes the Included resources of a response, such as an *AppsResponse or a
+// PagedDocument. Responses from several pages or requests can be indexed together.
+func NewIncludedGraph(responses ...interface{}) (*IncludedGraph, error) {
+	g := &IncludedGraph{}
+
+	for _, response := range responses {
+		v := reflect.Indirect(reflect.ValueOf(response))
+		if v.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%T is not a response", response)
+		}
+
+		included := v.FieldByName("Included")
+		if !included.IsValid() {
+			continue
+		}
+
+		if included.Kind() != reflect.Slice {
+			return nil, fmt.Errorf("included resources of %T are not a list", response)
+		}
+
+		for i := 0; i < included.Len(); i++ {
+			if err := g.add(included.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// UnmarshalJSON decodes the included resources of a document.
+func (g *IncludedGraph) UnmarshalJSON(b []byte) error {
+	var included []json.RawMessage
+	if err := json.Unmarshal(b, &included); err != nil {
+		return err
+	}
+
+	*g = IncludedGraph{}
+
+	for _, raw := range included {
+		if err := g.Add(raw); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the included resources in the order they were added.
+func (g IncludedGraph) MarshalJSON() ([]byte, error) {
+	included := make([]json.RawMessage, 0, len(g.order))
+
+	for _, linkage := range g.order {
+		raw, err := g.entries[linkage].json()
+		if err != nil {
+			return nil, err
+		}
+
+		included = append(included, raw)
+	}
+
+	return json.Marshal(included)
+}
+
+// Add indexes a resource, which can be a model of this package, a Resource, raw JSON or one of
+// the heterogenous included types of a response. A resource with the same type and ID as one
+// already in the graph replaces it.
+func (g *IncludedGraph) Add(resource interface{}) error {
+	return g.add(reflect.ValueOf(resource))
+}
+
+func (g *IncludedGraph) add(v reflect.Value) error {
+	linkage, value, ok := includedLinkage(v)
+	if !ok {
+		return fmt.Errorf("%s is not a resource with a type and ID", v.Type())
+	}
+
+	if g.entries == nil {
+		g.entries = map[RelationshipData]*includedEntry{}
+	}
+
+	if _, exists := g.entries[linkage]; !exists {
+		g.order = append(g.order, linkage)
+	}
+
+	entry := &includedEntry{value: value}
+	if raw, ok := value.(json.RawMessage); ok {
+		entry.raw = raw
+	}
+
+	g.entries[linkage] = entry
+
+	return nil
+}
+
+// Len returns the number of resources in the graph.
+func (g *IncludedGraph) Len() int {
+	return len(g.order)
+}
+
+// Has reports whether the graph holds the resource with the given type and ID.
+func (g *IncludedGraph) Has(linkage RelationshipData) bool {
+	_, ok := g.entries[linkage]
+
+	return ok
+}
+
+// Linkages returns the type and ID of every resource in the graph, in the order they were added.
+func (g *IncludedGraph) Linkages() []RelationshipData {
+	return append([]RelationshipData(nil), g.order...)
+}
+
+// Resolve returns the included resources that a relationship refers to, decoded as T. T is usually
+// a model of this package, such as BetaGroup, or a Resource. Related resources that were not
+// included in the response are skipped.
+func Resolve[T any](g *IncludedGraph, rel Linkage) ([]T, error) {
+	if rel == nil || reflect.ValueOf(rel).Kind() == reflect.Ptr && reflect.ValueOf(rel).IsNil() {
+		return nil, nil
+	}
+
+	var resolved []T
+
+	for _, linkage := range rel.Linkages() {
+		entry, ok := g.entries[linkage]
+		if !ok {
+			continue
+		}
+
+		v, err := decodeIncluded[T](entry)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s %s: %w", linkage.Type, linkage.ID, err)
+		}
+
+		resolved = append(resolved, v)
+	}
+
+	return resolved, nil
+}
+
+// ResolveOne returns the included resource that a to-one relationship refers to, decoded as T, or
+// nil if it was not included in the response.
+func ResolveOne[T any](g *IncludedGraph, rel Linkage) (*T, error) {
+	resolved, err := Resolve[T](g, rel)
+	if err != nil || len(resolved) == 0 {
+		return nil, err
+	}
+
+	return &resolved[0], nil
+}
+
+// IncludedOfType returns every resource of the given type in the graph, such as "betaGroups",
+// decoded as T.
+func IncludedOfType[T any](g *IncludedGraph, typ string) ([]T, error) {
+	var linkages []RelationshipData
+
+	for _, linkage := range g.order {
+		if linkage.Type == typ {
+			linkages = append(linkages, linkage)
+		}
+	}
+
+	return Resolve[T](g, &PagedRelationship{Data: linkages})
+}
+
+func decodeIncluded[T any](entry *includedEntry) (T, error) {
+	var v T
+
+	if typed, ok := entry.value.(T); ok {
+		return typed, nil
+	}
+
+	if typed, ok := entry.value.(*T); ok && typed != nil {
+		return *typed, nil
+	}
+
+	raw, err := entry.json()
+	if err != nil {
+		return v, err
+	}
+
+	err = json.Unmarshal(raw, &v)
+
+	return v, err
+}
+
+func (e *includedEntry) json() (json.RawMessage, error) {
+	if e.raw == nil {
+		raw, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+
+		e.raw = raw
+	}
+
+	return e.raw, nil
+}
+
+// includedLinkage identifies an included resource by its type and ID, and returns the resource
+// itself. Resources wrapped in the heterogenous included type are unwrapped first, and raw JSON
+// resources are decoded.
+func includedLinkage(v reflect.Value) (RelationshipData, interface{}, bool) {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr) {
+		if v.IsNil() {
+			return RelationshipData{}, nil, false
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return RelationshipData{}, nil, false
+	}
+
+	if raw, ok := v.Interface().(json.RawMessage); ok {
+		var linkage RelationshipData
+		if err := json.Unmarshal(raw, &linkage); err != nil || linkage.Type == "" {
+			return RelationshipData{}, nil, false
+		}
+
+		return linkage, raw, true
+	}
+
+	if v.Kind() == reflect.Struct && v.Type().ConvertibleTo(includedType) && v.CanInterface() {
+		wrapper, _ := v.Convert(includedType).Interface().(included)
+		v = reflect.ValueOf(wrapper.inner)
+	}
+
+	if !v.IsValid() || v.Kind() != reflect.Struct {
+		return RelationshipData{}, nil, false
+	}
+
+	typ, id := v.FieldByName("Type"), v.FieldByName("ID")
+	if !id.IsValid() {
+		id = v.FieldByName("Id")
+	}
+
+	if !typ.IsValid() || !id.IsValid() || typ.Kind() != reflect.String || id.Kind() != reflect.String {
+		return RelationshipData{}, nil, false
+	}
+
+	return RelationshipData{ID: id.String(), Type: typ.String()}, v.Interface(), true
+}
+
+var includedType = reflect.TypeOf(included{})
@@ -0,0 +1,136 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const marshaledBuildsWithIncluded = `{
+	"data": [{
+		"id": "b1",
+		"type": "builds",
+		"relationships": {
+			"app": {"data": {"id": "a1", "type": "apps"}},
+			"individualTesters": {"data": [{"id": "t1", "type": "betaTesters"}, {"id": "t2", "type": "betaTesters"}, {"id": "t3", "type": "betaTesters"}]}
+		}
+	}],
+	"included": [
+		{"id": "a1", "type": "apps", "attributes": {"name": "My App"}},
+		{"id": "t1", "type": "betaTesters", "attributes": {"firstName": "Ada"}},
+		{"id": "t2", "type": "betaTesters", "attributes": {"firstName": "Grace"}}
+	],
+	"links": {"self": "https://api.appstoreconnect.apple.com/v1/builds"}
+}`
+
+func TestIncludedGraphResolve(t *testing.T) {
+	t.Parallel()
+
+	var builds BuildsResponse
+	assert.NoError(t, json.Unmarshal([]byte(marshaledBuildsWithIncluded), &builds))
+
+	graph, err := NewIncludedGraph(&builds)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, graph.Len())
+	assert.True(t, graph.Has(RelationshipData{ID: "a1", Type: "apps"}))
+
+	build := builds.Data[0]
+
+	app, err := ResolveOne[App](graph, build.Relationships.App)
+	assert.NoError(t, err)
+	assert.Equal(t, "My App", *app.Attributes.Name)
+
+	testers, err := Resolve[BetaTester](graph, build.Relationships.IndividualTesters)
+	assert.NoError(t, err)
+	assert.Len(t, testers, 2)
+	assert.Equal(t, "Grace", *testers[1].Attributes.FirstName)
+
+	type testerAttributes struct {
+		FirstName string `json:"firstName"`
+	}
+
+	generic, err := Resolve[Resource[testerAttributes, struct{}]](graph, build.Relationships.IndividualTesters)
+	assert.NoError(t, err)
+	assert.Equal(t, "Ada", generic[0].Attributes.FirstName)
+	assert.Equal(t, RelationshipData{ID: "t1", Type: "betaTesters"}, generic[0].Linkage())
+
+	none, err := ResolveOne[App](graph, build.Relationships.AppStoreVersion)
+	assert.NoError(t, err)
+	assert.Nil(t, none)
+
+	_, err = Resolve[int](graph, build.Relationships.App)
+	assert.Error(t, err)
+}
+
+func TestIncludedGraphUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	type betaTesterResource = Resource[BetaTesterAttributes, BetaTesterRelationships]
+
+	var doc struct {
+		Data     []Resource[BuildAttributes, BuildRelationships] `json:"data"`
+		Included IncludedGraph                                   `json:"included"`
+	}
+
+	assert.NoError(t, json.Unmarshal([]byte(marshaledBuildsWithIncluded), &doc))
+
+	testers, err := IncludedOfType[betaTesterResource](&doc.Included, "betaTesters")
+	assert.NoError(t, err)
+	assert.Len(t, testers, 2)
+
+	apps, err := Resolve[App](&doc.Included, doc.Data[0].Relationships.App)
+	assert.NoError(t, err)
+	assert.Equal(t, "a1", apps[0].ID)
+
+	b, err := json.Marshal(doc.Included)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"id": "a1", "type": "apps", "attributes": {"name": "My App"}},
+		{"id": "t1", "type": "betaTesters", "attributes": {"firstName": "Ada"}},
+		{"id": "t2", "type": "betaTesters", "attributes": {"firstName": "Grace"}}
+	]`, string(b))
+}
+
+func TestIncludedGraphAdd(t *testing.T) {
+	t.Parallel()
+
+	graph, err := NewIncludedGraph(&CustomerReviewsResponse{
+		Included: []CustomerReviewResponseV1{{Id: "r1", Type: "customerReviewResponses"}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, graph.Add(BetaGroup{ID: "g1", Type: "betaGroups"}))
+	assert.NoError(t, graph.Add(&BetaGroup{ID: "g1", Type: "betaGroups"}))
+	assert.Error(t, graph.Add(42))
+	assert.Equal(t, []RelationshipData{
+		{ID: "r1", Type: "customerReviewResponses"},
+		{ID: "g1", Type: "betaGroups"},
+	}, graph.Linkages())
+
+	groups, err := Resolve[BetaGroup](graph, RelationshipData{ID: "g1", Type: "betaGroups"})
+	assert.NoError(t, err)
+	assert.Len(t, groups, 1)
+
+	_, err = NewIncludedGraph("not a response")
+	assert.Error(t, err)
+}
@@ -0,0 +1,357 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxLoggedBodySize = 4096
+	redacted                 = "[REDACTED]"
+)
+
+// DefaultRedactedFields are the JSON attribute names whose values are redacted from logged bodies
+// unless WithRedactedFields is used. They cover the personal information of users, beta testers
+// and devices.
+var DefaultRedactedFields = []string{
+	"email",
+	"firstName",
+	"lastName",
+	"username",
+	"udid",
+	"password",
+	"phone",
+	"contactEmail",
+	"contactFirstName",
+	"contactLastName",
+	"contactPhone",
+	"demoAccountName",
+	"demoAccountPassword",
+}
+
+// sensitiveHeaders are the headers whose values are never logged.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// LogOption customizes what the client logs.
+type LogOption func(*logConfig)
+
+type logConfig struct {
+	maxBodySize int
+	redacted    map[string]bool
+}
+
+// WithMaxBodySize truncates logged request and response bodies to n bytes. A value of 0 or less
+// omits bodies from the logs entirely.
+func WithMaxBodySize(n int) LogOption {
+	return func(c *logConfig) {
+		c.maxBodySize = n
+	}
+}
+
+// WithRedactedFields replaces DefaultRedactedFields with the given JSON attribute names.
+func WithRedactedFields(fields ...string) LogOption {
+	return func(c *logConfig) {
+		c.redacted = redactedFieldSet(fields)
+	}
+}
+
+// SetLogger sets the structured logger used to log every request made by this client. Each request
+// is logged at the Info level with its method, path, status, duration and remaining rate limit;
+// retries are logged at the Warn level and failures at the Error level, alongside the IDs of the
+// errors returned by Apple. Redacted headers and bodies are logged at the Debug level. Pass nil
+// to stop logging.
+func (c *Client) SetLogger(logger *slog.Logger, options ...LogOption) {
+	config := defaultLogConfig()
+
+	for _, option := range options {
+		option(&config)
+	}
+
+	c.logger = logger
+	c.logConfig = config
+}
+
+func defaultLogConfig() logConfig {
+	return logConfig{
+		maxBodySize: defaultMaxLoggedBodySize,
+		redacted:    redactedFieldSet(DefaultRedactedFields),
+	}
+}
+
+// log returns the logger to use for this client, if any. When HTTP debugging is enabled and no
+// logger has been set, requests are logged to stdout.
+func (c *Client) log() *slog.Logger {
+	if c.logger != nil {
+		return c.logger
+	}
+
+	if c.httpDebug {
+		return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+
+	return nil
+}
+
+func (c *Client) logRequest(ctx context.Context, logger *slog.Logger, req *http.Request, attempt int) {
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := append(requestAttrs(req, attempt), slog.Any("headers", redactHeaders(req.Header)))
+
+	if req.GetBody != nil && req.ContentLength > 0 {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(body)
+			attrs = append(attrs, slog.String("body", c.logConfig.formatBody(data, req.Header)))
+		}
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "asc request", attrs...)
+}
+
+func (c *Client) logResponse(ctx context.Context, logger *slog.Logger, req *http.Request, resp *http.Response, err error, attempt int, duration time.Duration) {
+	if logger == nil {
+		return
+	}
+
+	attrs := append(requestAttrs(req, attempt), slog.Duration("duration", duration))
+
+	if err != nil {
+		logger.LogAttrs(ctx, slog.LevelError, "asc request failed", append(attrs, slog.String("error", err.Error()))...)
+
+		return
+	}
+
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+
+	if rate := parseRate(resp); rate.Limit > 0 {
+		attrs = append(attrs, slog.Int("rate_limit", rate.Limit), slog.Int("rate_remaining", rate.Remaining))
+	}
+
+	level := slog.LevelInfo
+	if resp.StatusCode >= http.StatusBadRequest {
+		level = slog.LevelWarn
+	}
+
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		attrs = append(attrs, slog.Any("headers", redactHeaders(resp.Header)))
+
+		if body := c.peekResponseBody(resp); body != "" {
+			attrs = append(attrs, slog.String("body", body))
+		}
+	}
+
+	logger.LogAttrs(ctx, level, "asc response", attrs...)
+}
+
+func (c *Client) logRetry(ctx context.Context, logger *slog.Logger, req *http.Request, attempt int, delay time.Duration) {
+	if logger == nil {
+		return
+	}
+
+	attrs := append(requestAttrs(req, attempt), slog.Duration("delay", delay))
+	logger.LogAttrs(ctx, slog.LevelWarn, "asc request will be retried", attrs...)
+}
+
+func (c *Client) logErrorResponse(ctx context.Context, logger *slog.Logger, req *http.Request, err *ErrorResponse) {
+	if logger == nil {
+		return
+	}
+
+	var codes, ids []string
+
+	for _, e := range err.Flatten() {
+		codes = append(codes, e.Code)
+
+		if e.ID != nil {
+			ids = append(ids, *e.ID)
+		}
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("status", err.Response.StatusCode),
+		slog.Any("error_codes", codes),
+		slog.Any("error_ids", ids),
+	}
+
+	logger.LogAttrs(ctx, slog.LevelError, "asc error response", attrs...)
+}
+
+// peekResponseBody returns a loggable representation of the response body, leaving the body
+// readable for decoding. Bodies that are not JSON, such as gzipped reports, are not read.
+func (c *Client) peekResponseBody(resp *http.Response) string {
+	if c.logConfig.maxBodySize <= 0 || resp.Body == nil {
+		return ""
+	}
+
+	if !isJSON(resp.Header) {
+		return omittedBody(resp.ContentLength)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	if err != nil {
+		return ""
+	}
+
+	return c.logConfig.formatBody(data, resp.Header)
+}
+
+// formatBody redacts and truncates a body for logging.
+func (c logConfig) formatBody(data []byte, header http.Header) string {
+	if c.maxBodySize <= 0 || len(data) == 0 {
+		return ""
+	}
+
+	if !isJSON(header) {
+		return omittedBody(int64(len(data)))
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err == nil {
+		if redactedData, err := json.Marshal(c.redact(v)); err == nil {
+			data = redactedData
+		}
+	}
+
+	if len(data) > c.maxBodySize {
+		return fmt.Sprintf("%s... (%d bytes truncated)", data[:c.maxBodySize], len(data)-c.maxBodySize)
+	}
+
+	return string(data)
+}
+
+// redact replaces the values of redacted attributes anywhere in a decoded JSON document.
+func (c logConfig) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if c.redacted[strings.ToLower(key)] {
+				v[key] = redacted
+			} else {
+				v[key] = c.redact(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = c.redact(value)
+		}
+	}
+
+	return v
+}
+
+// logUpload logs the outcome of uploading one part of an asset. Upload URLs are signed, so their
+// query is redacted, and the uploaded bytes are never logged.
+func (c *Client) logUpload(ctx context.Context, req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	logger := c.log()
+	if logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL.String())),
+		slog.Int64("length", req.ContentLength),
+		slog.Duration("duration", duration),
+	}
+
+	switch {
+	case err != nil:
+		logger.LogAttrs(ctx, slog.LevelError, "asc upload failed", append(attrs, slog.String("error", err.Error()))...)
+	case resp.StatusCode >= http.StatusBadRequest:
+		logger.LogAttrs(ctx, slog.LevelError, "asc upload failed", append(attrs, slog.Int("status", resp.StatusCode))...)
+	default:
+		logger.LogAttrs(ctx, slog.LevelInfo, "asc upload", append(attrs, slog.Int("status", resp.StatusCode))...)
+	}
+}
+
+func requestAttrs(req *http.Request, attempt int) []slog.Attr {
+	return []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Int("attempt", attempt),
+	}
+}
+
+func redactHeaders(header http.Header) http.Header {
+	redactedHeader := header.Clone()
+
+	for _, name := range sensitiveHeaders {
+		if redactedHeader.Get(name) != "" {
+			redactedHeader.Set(name, redacted)
+		}
+	}
+
+	return redactedHeader
+}
+
+// redactURL removes the query of a URL, such as the signature of an upload operation URL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redacted
+	}
+
+	if u.RawQuery != "" {
+		u.RawQuery = redacted
+	}
+
+	return u.String()
+}
+
+func redactedFieldSet(fields []string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[strings.ToLower(field)] = true
+	}
+
+	return set
+}
+
+func isJSON(header http.Header) bool {
+	contentType := header.Get("Content-Type")
+
+	return strings.Contains(contentType, "json") && header.Get("Content-Encoding") == ""
+}
+
+func omittedBody(size int64) string {
+	if size < 0 {
+		return "[body omitted]"
+	}
+
+	return fmt.Sprintf("[%d bytes omitted]", size)
+}
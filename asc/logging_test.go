@@ -0,0 +1,173 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newJSONServer(raw string, status int) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Add("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:3000;")
+		w.WriteHeader(status)
+		fmt.Fprintln(w, raw)
+	}))
+
+	base, _ := url.Parse(server.URL)
+	client := NewClient(server.Client())
+	client.baseURL = base
+
+	return client, server
+}
+
+func newTestLogger(level slog.Level) (*slog.Logger, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+
+	return slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: level})), buf
+}
+
+func TestLoggerInfo(t *testing.T) {
+	t.Parallel()
+
+	client, server := newJSONServer(marshaledMockPayload, http.StatusOK)
+	defer server.Close()
+
+	logger, buf := newTestLogger(slog.LevelInfo)
+	client.SetLogger(logger)
+
+	var unmarshaled mockPayload
+	_, err := client.get(context.Background(), "test", nil, &unmarshaled)
+	assert.NoError(t, err)
+	assert.Equal(t, mockPayload{"TEST"}, unmarshaled)
+
+	out := buf.String()
+	assert.Contains(t, out, `msg="asc response"`)
+	assert.Contains(t, out, "method=GET")
+	assert.Contains(t, out, "path=/test")
+	assert.Contains(t, out, "status=200")
+	assert.Contains(t, out, "rate_remaining=3000")
+	assert.NotContains(t, out, "body=")
+}
+
+func TestLoggerDebugRedacts(t *testing.T) {
+	t.Parallel()
+
+	client, server := newJSONServer(`{"data":{"attributes":{"email":"hank@example.com","firstName":"Hank","state":"ACCEPTED"}}}`, http.StatusOK)
+	defer server.Close()
+
+	logger, buf := newTestLogger(slog.LevelDebug)
+	client.SetLogger(logger)
+
+	req, err := client.newRequest(context.Background(), "POST", "test", newRequestBody(map[string]string{"lastName": "Venture", "udid": "0000"}), withContentType("application/json"))
+	assert.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer TEST.TEST.TEST")
+
+	var unmarshaled struct {
+		Data struct {
+			Attributes map[string]string `json:"attributes"`
+		} `json:"data"`
+	}
+	_, err = client.do(context.Background(), req, &unmarshaled)
+	assert.NoError(t, err)
+	assert.Equal(t, "hank@example.com", unmarshaled.Data.Attributes["email"])
+
+	out := buf.String()
+	assert.NotContains(t, out, "TEST.TEST.TEST")
+	assert.NotContains(t, out, "hank@example.com")
+	assert.NotContains(t, out, "Hank")
+	assert.NotContains(t, out, "Venture")
+	assert.NotContains(t, out, "0000")
+	assert.NotContains(t, out, "session=secret")
+	assert.Contains(t, out, "ACCEPTED")
+	assert.Contains(t, out, redacted)
+}
+
+func TestLoggerErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, server := newJSONServer(`{"errors":[{"id":"abc-123","code":"NOT_FOUND","status":"404"}]}`, http.StatusNotFound)
+	defer server.Close()
+
+	logger, buf := newTestLogger(slog.LevelInfo)
+	client.SetLogger(logger)
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+	assert.Error(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "level=WARN")
+	assert.Contains(t, out, "level=ERROR")
+	assert.Contains(t, out, "abc-123")
+	assert.Contains(t, out, "NOT_FOUND")
+}
+
+func TestLogConfigFormatBody(t *testing.T) {
+	t.Parallel()
+
+	jsonHeader := http.Header{"Content-Type": []string{"application/json"}}
+	config := defaultLogConfig()
+
+	assert.Equal(t, `{"email":"[REDACTED]"}`, config.formatBody([]byte(`{"email":"a@b.c"}`), jsonHeader))
+	assert.Equal(t, "[3 bytes omitted]", config.formatBody([]byte{0x1f, 0x8b, 0x08}, http.Header{"Content-Type": []string{"application/a-gzip"}}))
+	assert.Empty(t, config.formatBody(nil, jsonHeader))
+
+	WithMaxBodySize(5)(&config)
+	assert.Equal(t, `{"a":... (6 bytes truncated)`, config.formatBody([]byte(`{"a":"bcd"}`), jsonHeader))
+
+	WithRedactedFields("a")(&config)
+	WithMaxBodySize(100)(&config)
+	assert.Equal(t, `[{"a":"[REDACTED]","email":"a@b.c"}]`, config.formatBody([]byte(`[{"a":"bcd","email":"a@b.c"}]`), jsonHeader))
+}
+
+func TestRedactURL(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://example.com/upload?"+redacted, redactURL("https://example.com/upload?signature=abc"))
+	assert.Equal(t, "https://example.com/upload", redactURL("https://example.com/upload"))
+	assert.Equal(t, redacted, redactURL(":"))
+}
+
+func TestHTTPDebugLogger(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	assert.Nil(t, client.log())
+
+	client.SetHTTPDebug(true)
+	assert.NotNil(t, client.log())
+	assert.True(t, client.log().Enabled(context.Background(), slog.LevelDebug))
+
+	logger, _ := newTestLogger(slog.LevelInfo)
+	client.SetLogger(logger)
+	assert.Same(t, logger, client.log())
+}
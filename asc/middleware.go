@@ -0,0 +1,169 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// RequestInfo describes a request made by a Client, as seen by Middleware.
+type RequestInfo struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the JSON:API resource, relative to the client's base URL. For example,
+	// "apps/1234/builds".
+	Path string
+	// Query holds the query parameters of the request.
+	Query url.Values
+	// Body is the JSON request body, or nil if the request has none.
+	Body []byte
+	// Target is the value the response will be decoded into, such as an *AppsResponse. It is nil
+	// for requests that expect no content.
+	Target interface{}
+	// Request is the underlying HTTP request. BeforeSend hooks may modify its headers.
+	Request *http.Request
+}
+
+// Middleware hooks into every request made by a Client. Any of its hooks may be nil.
+//
+// Hooks run once per call to a service method, regardless of how many times the request is
+// retried, in the order the middleware was added with Use.
+type Middleware struct {
+	// BeforeSend is called before the request is sent. Returning an error aborts the request,
+	// and the error is returned to the caller as-is. Use it to inject headers, break circuits,
+	// or inject faults.
+	BeforeSend func(ctx context.Context, req *RequestInfo) error
+	// AfterResponse is called after a successful response has been decoded into the target.
+	AfterResponse func(ctx context.Context, req *RequestInfo, resp *Response)
+	// OnError is called when the request fails. If the API rejected the request, err is an
+	// *ErrorResponse and resp holds the response. The returned error replaces err, so hooks
+	// can wrap or translate it; return err unchanged to leave it as is.
+	OnError func(ctx context.Context, req *RequestInfo, resp *Response, err error) error
+}
+
+// Use appends middleware to the chain that runs for every request made by this client. It must
+// be called before the client is used to make requests.
+func (c *Client) Use(middleware ...Middleware) {
+	c.middleware = append(c.middleware, middleware...)
+}
+
+// do sends the request through the middleware chain and decodes the response into v.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if len(c.middleware) == 0 {
+		return c.receive(ctx, req, v)
+	}
+
+	info := c.newRequestInfo(req, v)
+
+	for _, m := range c.middleware {
+		if m.BeforeSend == nil {
+			continue
+		}
+
+		if err := m.BeforeSend(ctx, info); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.receive(ctx, req, v)
+	if err != nil {
+		for _, m := range c.middleware {
+			if m.OnError != nil {
+				err = m.OnError(ctx, info, resp, err)
+			}
+		}
+
+		return resp, err
+	}
+
+	for _, m := range c.middleware {
+		if m.AfterResponse != nil {
+			m.AfterResponse(ctx, info, resp)
+		}
+	}
+
+	return resp, nil
+}
+
+// receive sends the request, decodes the response into v and checks it against the schema. In
+// dry-run mode, requests other than GET are captured in the plan instead, and otherwise they are
+// recorded in the audit sink, if any.
+func (c *Client) receive(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if req.Method != http.MethodGet {
+		switch {
+		case c.plan != nil:
+			return c.capture(req, v)
+		case c.audit != nil:
+			return c.audited(ctx, req, v)
+		}
+	}
+
+	resp, err := c.send(ctx, req, v)
+	if err != nil {
+		return resp, err
+	}
+
+	if err := c.checkSchema(ctx, req, v); err != nil {
+		return resp, err
+	}
+
+	c.rememberFetched(req, v)
+
+	return resp, nil
+}
+
+func (c *Client) newRequestInfo(req *http.Request, v interface{}) *RequestInfo {
+	info := &RequestInfo{
+		Method:  req.Method,
+		Path:    c.resourcePath(req.URL),
+		Query:   req.URL.Query(),
+		Target:  v,
+		Request: req,
+	}
+
+	if req.GetBody != nil && req.ContentLength > 0 {
+		if body, err := req.GetBody(); err == nil {
+			info.Body, _ = io.ReadAll(body)
+		}
+	}
+
+	return info
+}
+
+// resourcePath returns the path of u relative to the client's base URL, such as "apps/1234/builds".
+// Paths of other versions are relative to the root of the API, such as "v2/inAppPurchases/1", and
+// paths outside of it are returned whole, without their leading slash.
+func (c *Client) resourcePath(u *url.URL) string {
+	path := u.Path
+	if u.Host == c.baseURL.Host {
+		if rel, ok := strings.CutPrefix(path, c.baseURL.Path); ok {
+			path = rel
+		} else {
+			path = strings.TrimPrefix(path, c.rootURL().Path)
+		}
+	}
+
+	return strings.TrimPrefix(path, "/")
+}
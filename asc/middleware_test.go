@@ -0,0 +1,153 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddlewareSuccess(t *testing.T) {
+	t.Parallel()
+
+	var gotHeader string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header.Get("X-Audit")
+		fmt.Fprintln(w, marshaledMockPayload)
+	}))
+	defer server.Close()
+
+	base, _ := url.Parse(server.URL + "/v1/")
+	client := NewClient(server.Client())
+	client.baseURL = base
+
+	var calls []string
+
+	client.Use(Middleware{
+		BeforeSend: func(ctx context.Context, req *RequestInfo) error {
+			calls = append(calls, "before")
+
+			assert.Equal(t, "PATCH", req.Method)
+			assert.Equal(t, "apps/1", req.Path)
+			assert.Equal(t, "b", req.Query.Get("a"))
+			assert.JSONEq(t, `{"data":{"Field":"TEST"}}`, string(req.Body))
+			assert.IsType(t, &mockPayload{}, req.Target)
+
+			req.Request.Header.Set("X-Audit", "yes")
+
+			return nil
+		},
+		OnError: func(ctx context.Context, req *RequestInfo, resp *Response, err error) error {
+			calls = append(calls, "error")
+
+			return err
+		},
+	}, Middleware{
+		AfterResponse: func(ctx context.Context, req *RequestInfo, resp *Response) {
+			calls = append(calls, "after")
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, &mockPayload{"TEST"}, req.Target)
+		},
+	})
+
+	var unmarshaled mockPayload
+	_, err := client.patch(context.Background(), "apps/1?a=b", newRequestBody(mockBody{"TEST"}), &unmarshaled)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"before", "after"}, calls)
+	assert.Equal(t, "yes", gotHeader)
+}
+
+func TestMiddlewareOnError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"code":"NOT_FOUND","status":"404"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	errWrapped := errors.New("wrapped")
+
+	client.Use(Middleware{
+		OnError: func(ctx context.Context, req *RequestInfo, resp *Response, err error) error {
+			var apiErr *ErrorResponse
+
+			assert.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+			assert.Equal(t, "apps", req.Path)
+
+			return fmt.Errorf("%w: %w", errWrapped, err)
+		},
+	})
+
+	_, err := client.get(context.Background(), "apps", nil, nil)
+	assert.ErrorIs(t, err, errWrapped)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestMiddlewareBeforeSendAborts(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledMockPayload, http.StatusOK, false)
+	defer server.Close()
+
+	errOpen := errors.New("circuit open")
+	called := false
+
+	client.Use(Middleware{
+		BeforeSend: func(ctx context.Context, req *RequestInfo) error {
+			return errOpen
+		},
+	}, Middleware{
+		BeforeSend: func(ctx context.Context, req *RequestInfo) error {
+			called = true
+
+			return nil
+		},
+	})
+
+	resp, err := client.get(context.Background(), "apps", nil, nil)
+	assert.ErrorIs(t, err, errOpen)
+	assert.Nil(t, resp)
+	assert.False(t, called)
+}
+
+func TestResourcePath(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+
+	u, _ := url.Parse("https://api.appstoreconnect.apple.com/v1/apps/1/builds?limit=1")
+	assert.Equal(t, "apps/1/builds", client.resourcePath(u))
+
+	u, _ = url.Parse("https://api.appstoreconnect.apple.com/v2/inAppPurchases/1")
+	assert.Equal(t, "v2/inAppPurchases/1", client.resourcePath(u))
+
+	u, _ = url.Parse("https://example.com/v1/apps")
+	assert.Equal(t, "v1/apps", client.resourcePath(u))
+}
@@ -0,0 +1,290 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// ErrNotPaged happens when a response passed to one of the paging helpers does not have the
+// Data slice and PagedDocumentLinks of a paged document, or its Data does not hold the requested item type.
+type ErrNotPaged struct {
+	Type string
+}
+
+func (e ErrNotPaged) Error() string {
+	return fmt.Sprintf("type %s is not a paged document", e.Type)
+}
+
+// ListFunc requests the first page of a paged resource collection. It is usually a closure
+// around one of the List methods on a service, such as AppsService.ListApps.
+type ListFunc[R any] func(ctx context.Context) (*R, *Response, error)
+
+// PageOption customizes how the paging helpers traverse a resource collection.
+type PageOption func(*pageOptions)
+
+type pageOptions struct {
+	prefetch bool
+	maxItems int
+}
+
+// WithPrefetch requests the next page concurrently while the current page is being consumed.
+func WithPrefetch() PageOption {
+	return func(o *pageOptions) {
+		o.prefetch = true
+	}
+}
+
+// WithMaxItems stops paging once n items have been produced. The final page is truncated
+// so that no more than n items are returned in total. A value of 0 or less means no limit.
+func WithMaxItems(n int) PageOption {
+	return func(o *pageOptions) {
+		o.maxItems = n
+	}
+}
+
+type pageResult[R any] struct {
+	page *R
+	err  error
+}
+
+// Pages returns an iterator over every page of a resource collection. The first page is
+// requested with list, and each following page is requested by following the next link in
+// the previous page's PagedDocumentLinks until there are no pages left.
+//
+//	for page, err := range asc.Pages(ctx, client, func(ctx context.Context) (*asc.AppsResponse, *asc.Response, error) {
+//		return client.Apps.ListApps(ctx, nil)
+//	}) {
+//		...
+//	}
+func Pages[R any](ctx context.Context, c *Client, list ListFunc[R], opts ...PageOption) iter.Seq2[*R, error] {
+	return func(yield func(*R, error) bool) {
+		o := newPageOptions(opts)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		page, _, err := list(ctx)
+		produced := 0
+
+		for {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			data, links, fieldsErr := pageFields(page)
+			if fieldsErr != nil {
+				yield(nil, fieldsErr)
+
+				return
+			}
+
+			if o.maxItems > 0 && produced+data.Len() >= o.maxItems {
+				data.Set(data.Slice(0, o.maxItems-produced))
+				links.Next = nil
+			}
+
+			produced += data.Len()
+			next := links.Next
+
+			var pending <-chan pageResult[R]
+			if next != nil && o.prefetch {
+				pending = fetchPageAsync[R](ctx, c, next)
+			}
+
+			if !yield(page, nil) || next == nil {
+				return
+			}
+
+			if pending != nil {
+				result := <-pending
+				page, err = result.page, result.err
+			} else {
+				page, err = fetchPage[R](ctx, c, next)
+			}
+		}
+	}
+}
+
+// Items returns an iterator over every item in the Data of each page of a resource collection.
+// T must be the element type of R's Data field.
+//
+//	for app, err := range asc.Items[asc.App](ctx, client, func(ctx context.Context) (*asc.AppsResponse, *asc.Response, error) {
+//		return client.Apps.ListApps(ctx, nil)
+//	}) {
+//		...
+//	}
+func Items[T any, R any](ctx context.Context, c *Client, list ListFunc[R], opts ...PageOption) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for page, err := range Pages(ctx, c, list, opts...) {
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			items, ok := reflect.ValueOf(page).Elem().FieldByName("Data").Interface().([]T)
+			if !ok {
+				yield(zero, ErrNotPaged{Type: fmt.Sprintf("%T", page)})
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CollectAll requests every page of a resource collection and merges them into a single response.
+// The Data of each page is concatenated, and the Included resources are merged with duplicates
+// (by type and ID) removed. The Links of the returned response are those of the last page requested.
+//
+// If an error occurs, the pages collected so far are returned alongside the error.
+func CollectAll[R any](ctx context.Context, c *Client, list ListFunc[R], opts ...PageOption) (*R, error) {
+	var (
+		all  *R
+		seen map[string]bool
+	)
+
+	for page, err := range Pages(ctx, c, list, opts...) {
+		if err != nil {
+			return all, err
+		}
+
+		if all == nil {
+			all = page
+			seen = includedKeys(reflect.ValueOf(all).Elem().FieldByName("Included"))
+
+			continue
+		}
+
+		mergePages(reflect.ValueOf(all).Elem(), reflect.ValueOf(page).Elem(), seen)
+	}
+
+	return all, nil
+}
+
+func newPageOptions(opts []PageOption) pageOptions {
+	var o pageOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+func fetchPage[R any](ctx context.Context, c *Client, ref *Reference) (*R, error) {
+	page := new(R)
+	_, err := c.get(ctx, ref.String(), nil, page)
+
+	return page, err
+}
+
+func fetchPageAsync[R any](ctx context.Context, c *Client, ref *Reference) <-chan pageResult[R] {
+	results := make(chan pageResult[R], 1)
+
+	go func() {
+		page, err := fetchPage[R](ctx, c, ref)
+		results <- pageResult[R]{page: page, err: err}
+	}()
+
+	return results
+}
+
+// pageFields returns the Data slice and the PagedDocumentLinks of a paged response.
+func pageFields(page interface{}) (reflect.Value, *PagedDocumentLinks, error) {
+	v := reflect.ValueOf(page)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, nil, ErrNotPaged{Type: fmt.Sprintf("%T", page)}
+	}
+
+	data, links := v.Elem().FieldByName("Data"), v.Elem().FieldByName("Links")
+	if data.Kind() != reflect.Slice || !links.IsValid() || links.Type() != reflect.TypeOf(PagedDocumentLinks{}) {
+		return reflect.Value{}, nil, ErrNotPaged{Type: fmt.Sprintf("%T", page)}
+	}
+
+	return data, links.Addr().Interface().(*PagedDocumentLinks), nil
+}
+
+// mergePages appends the Data and unseen Included resources of src to dst, and replaces the
+// Links of dst with those of src.
+func mergePages(dst, src reflect.Value, seen map[string]bool) {
+	data := dst.FieldByName("Data")
+	data.Set(reflect.AppendSlice(data, src.FieldByName("Data")))
+
+	dst.FieldByName("Links").Set(src.FieldByName("Links"))
+
+	included := dst.FieldByName("Included")
+	if !included.IsValid() || included.Kind() != reflect.Slice {
+		return
+	}
+
+	srcIncluded := src.FieldByName("Included")
+	for i := 0; i < srcIncluded.Len(); i++ {
+		item := srcIncluded.Index(i)
+
+		key, ok := includedKey(item)
+		if ok && seen[key] {
+			continue
+		}
+
+		if ok {
+			seen[key] = true
+		}
+
+		included.Set(reflect.Append(included, item))
+	}
+}
+
+func includedKeys(included reflect.Value) map[string]bool {
+	seen := map[string]bool{}
+
+	if !included.IsValid() || included.Kind() != reflect.Slice {
+		return seen
+	}
+
+	for i := 0; i < included.Len(); i++ {
+		if key, ok := includedKey(included.Index(i)); ok {
+			seen[key] = true
+		}
+	}
+
+	return seen
+}
+
+// includedKey identifies an included resource by its type and ID.
+func includedKey(v reflect.Value) (string, bool) {
+	linkage, _, ok := includedLinkage(v)
+
+	return linkage.Type + "/" + linkage.ID, ok
+}
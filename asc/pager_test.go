@@ -0,0 +1,191 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPagedServer serves three pages of apps, each including the same beta group alongside
+// a beta group unique to the page.
+func newPagedServer() (*Client, *httptest.Server) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			_, _ = fmt.Sscanf(cursor, "%d", &page)
+		}
+
+		next := ""
+		if page < 2 {
+			next = fmt.Sprintf(`,"next":"%s/apps?cursor=%d"`, server.URL, page+1)
+		}
+
+		fmt.Fprintf(w, `{
+			"data":[{"id":"%[1]d-a","type":"apps"},{"id":"%[1]d-b","type":"apps"}],
+			"included":[{"id":"shared","type":"betaGroups"},{"id":"%[1]d","type":"betaGroups"}],
+			"links":{"self":"%[2]s/apps"%[3]s}
+		}`, page, server.URL, next)
+	}))
+
+	base, _ := url.Parse(server.URL)
+	client := NewClient(server.Client())
+	client.baseURL = base
+
+	return client, server
+}
+
+func listAppsFunc(client *Client) ListFunc[AppsResponse] {
+	return func(ctx context.Context) (*AppsResponse, *Response, error) {
+		return client.Apps.ListApps(ctx, nil)
+	}
+}
+
+func appIDs(apps []App) []string {
+	ids := make([]string, len(apps))
+	for i, app := range apps {
+		ids[i] = app.ID
+	}
+
+	return ids
+}
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	var pages int
+
+	for page, err := range Pages(context.Background(), client, listAppsFunc(client)) {
+		assert.NoError(t, err)
+		assert.Len(t, page.Data, 2)
+
+		pages++
+	}
+
+	assert.Equal(t, 3, pages)
+}
+
+func TestItems(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	var ids []string
+
+	for app, err := range Items[App](context.Background(), client, listAppsFunc(client), WithPrefetch()) {
+		assert.NoError(t, err)
+
+		ids = append(ids, app.ID)
+	}
+
+	assert.Equal(t, []string{"0-a", "0-b", "1-a", "1-b", "2-a", "2-b"}, ids)
+}
+
+func TestItemsStopEarly(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	var ids []string
+
+	for app, err := range Items[App](context.Background(), client, listAppsFunc(client), WithPrefetch()) {
+		assert.NoError(t, err)
+
+		ids = append(ids, app.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"0-a", "0-b", "1-a"}, ids)
+}
+
+func TestItemsWrongType(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	for _, err := range Items[Build](context.Background(), client, listAppsFunc(client)) {
+		assert.ErrorAs(t, err, new(ErrNotPaged))
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	all, err := CollectAll(context.Background(), client, listAppsFunc(client), WithPrefetch())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0-a", "0-b", "1-a", "1-b", "2-a", "2-b"}, appIDs(all.Data))
+	assert.Len(t, all.Included, 4)
+	assert.Nil(t, all.Links.Next)
+}
+
+func TestCollectAllMaxItems(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	all, err := CollectAll(context.Background(), client, listAppsFunc(client), WithMaxItems(3))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0-a", "0-b", "1-a"}, appIDs(all.Data))
+	assert.Nil(t, all.Links.Next)
+}
+
+func TestCollectAllError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"code":"NOT_FOUND","status":"404"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	all, err := CollectAll(context.Background(), client, listAppsFunc(client))
+	assert.Error(t, err)
+	assert.Nil(t, all)
+}
+
+func TestCollectAllNotPaged(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"1","type":"apps"}}`, http.StatusOK, false)
+	defer server.Close()
+
+	_, err := CollectAll(context.Background(), client, func(ctx context.Context) (*AppResponse, *Response, error) {
+		return client.Apps.GetApp(ctx, "1", nil)
+	})
+	assert.ErrorAs(t, err, new(ErrNotPaged))
+}
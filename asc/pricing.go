@@ -48,6 +48,8 @@ type AppPrice struct {
 type AppPriceRelationships struct {
 	App       *Relationship `json:"app,omitempty"`
 	PriceTier *Relationship `json:"priceTier,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPriceResponse defines model for AppPriceResponse.
@@ -71,20 +73,22 @@ type AppPricesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_an_app
 type ListPricesQuery struct {
-	FieldsAppPrices     []string `url:"fields[appPrices],omitempty"`
-	FieldsApps          []string `url:"fields[apps],omitempty"`
-	FieldsAppPriceTiers []string `url:"fields[appPriceTiers],omitempty"`
-	Include             []string `url:"include,omitempty"`
-	Limit               int      `url:"limit,omitempty"`
-	Cursor              string   `url:"cursor,omitempty"`
+	FieldsAppPrices     []string     `url:"fields[appPrices],omitempty"`
+	FieldsApps          []string     `url:"fields[apps],omitempty"`
+	FieldsAppPriceTiers []string     `url:"fields[appPriceTiers],omitempty"`
+	Include             []string     `url:"include,omitempty"`
+	Limit               int          `url:"limit,omitempty"`
+	Cursor              string       `url:"cursor,omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // GetPriceQuery are query options for GetPrice
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_information
 type GetPriceQuery struct {
-	FieldsAppPrices []string `url:"fields[appPrices],omitempty"`
-	Include         []string `url:"include,omitempty"`
+	FieldsAppPrices []string     `url:"fields[appPrices],omitempty"`
+	Include         []string     `url:"include,omitempty"`
+	Query           QueryEncoder `url:"-"`
 }
 
 // ListPricesForApp gets current price tier of an app and any future planned price changes.
@@ -40,6 +40,8 @@ type Territory struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/territory/attributes
 type TerritoryAttributes struct {
 	Currency *string `json:"currency,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // TerritoryResponse defines model for TerritoryResponse.
@@ -66,9 +68,10 @@ type TerritoriesResponse struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_territories_for_an_end_user_license_agreement
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_territory_information_of_an_app_price_point
 type ListTerritoriesQuery struct {
-	FieldsTerritories []string `url:"fields[territories],omitempty"`
-	Limit             int      `url:"limit,omitempty"`
-	Cursor            string   `url:"cursor,omitempty"`
+	FieldsTerritories []string     `url:"fields[territories],omitempty"`
+	Limit             int          `url:"limit,omitempty"`
+	Cursor            string       `url:"cursor,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // ListTerritories lists all territories where the App Store operates.
@@ -40,6 +40,8 @@ type AppPriceTier struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/apppricetier/relationships
 type AppPriceTierRelationships struct {
 	PricePoints *PagedRelationship `json:"pricePoints,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPriceTierResponse defines model for AppPriceTierResponse.
@@ -78,6 +80,8 @@ type AppPricePoint struct {
 type AppPricePointAttributes struct {
 	CustomerPrice *string `json:"customerPrice,omitempty"`
 	Proceeds      *string `json:"proceeds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPricePointRelationships defines model for AppPricePoint.Relationships
@@ -86,6 +90,8 @@ type AppPricePointAttributes struct {
 type AppPricePointRelationships struct {
 	PriceTier *Relationship `json:"priceTier,omitempty"`
 	Territory *Relationship `json:"territory,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPricePointResponse defines model for AppPricePointResponse.
@@ -111,63 +117,69 @@ type AppPricePointsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_tiers
 type ListAppPriceTiersQuery struct {
-	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
-	FieldsAppPriceTiers  []string `url:"fields[appPriceTiers],omitempty"`
-	FilterID             []string `url:"filter[id],omitempty"`
-	Include              []string `url:"include,omitempty"`
-	Limit                int      `url:"limit,omitempty"`
-	LimitPricePoints     int      `url:"limit[pricePoints],omitempty"`
-	Cursor               string   `url:"cursor,omitempty"`
+	FieldsAppPricePoints []string     `url:"fields[appPricePoints],omitempty"`
+	FieldsAppPriceTiers  []string     `url:"fields[appPriceTiers],omitempty"`
+	FilterID             []string     `url:"filter[id],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	Limit                int          `url:"limit,omitempty"`
+	LimitPricePoints     int          `url:"limit[pricePoints],omitempty"`
+	Cursor               string       `url:"cursor,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // GetAppPriceTierQuery are query options for GetAppPriceTier
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_tier_information
 type GetAppPriceTierQuery struct {
-	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
-	FieldsAppPriceTiers  []string `url:"fields[appPriceTiers],omitempty"`
-	Include              []string `url:"include,omitempty"`
-	LimitPricePoints     int      `url:"limit[pricePoints],omitempty"`
+	FieldsAppPricePoints []string     `url:"fields[appPricePoints],omitempty"`
+	FieldsAppPriceTiers  []string     `url:"fields[appPriceTiers],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	LimitPricePoints     int          `url:"limit[pricePoints],omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListPricePointsForAppPriceTierQuery are query options for ListPricePointsForAppPriceTier
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_an_app_price_tier
 type ListPricePointsForAppPriceTierQuery struct {
-	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
-	Limit                int      `url:"limit,omitempty"`
-	Cursor               string   `url:"cursor,omitempty"`
+	FieldsAppPricePoints []string     `url:"fields[appPricePoints],omitempty"`
+	Limit                int          `url:"limit,omitempty"`
+	Cursor               string       `url:"cursor,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListAppPricePointsQuery are query options for ListAppPricePoints
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_points
 type ListAppPricePointsQuery struct {
-	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
-	FieldsTerritories    []string `url:"fields[territories],omitempty"`
-	FilterPriceTier      []string `url:"filter[priceTier],omitempty"`
-	FilterTerritory      []string `url:"filter[territory],omitempty"`
-	Include              []string `url:"include,omitempty"`
-	Limit                int      `url:"limit,omitempty"`
-	Cursor               string   `url:"cursor,omitempty"`
+	FieldsAppPricePoints []string     `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string     `url:"fields[territories],omitempty"`
+	FilterPriceTier      []string     `url:"filter[priceTier],omitempty"`
+	FilterTerritory      []string     `url:"filter[territory],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	Limit                int          `url:"limit,omitempty"`
+	Cursor               string       `url:"cursor,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // GetTerritoryForAppPricePointQuery are query options for GetTerritoryForAppPricePoint
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_territory_information_of_an_app_price_point
 type GetTerritoryForAppPricePointQuery struct {
-	FieldsTerritories []string `url:"fields[territories],omitempty"`
+	FieldsTerritories []string     `url:"fields[territories],omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // GetAppPricePointQuery are query options for GetAppPricePoint
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_point_information
 type GetAppPricePointQuery struct {
-	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
-	FieldsTerritories    []string `url:"fields[territories],omitempty"`
-	FilterPriceTier      []string `url:"filter[priceTier],omitempty"`
-	FilterTerritory      []string `url:"filter[territory],omitempty"`
-	Include              []string `url:"include,omitempty"`
+	FieldsAppPricePoints []string     `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string     `url:"fields[territories],omitempty"`
+	FilterPriceTier      []string     `url:"filter[priceTier],omitempty"`
+	FilterTerritory      []string     `url:"filter[territory],omitempty"`
+	Include              []string     `url:"include,omitempty"`
+	Query                QueryEncoder `url:"-"`
 }
 
 // ListAppPriceTiers lists all app price tiers available in App Store Connect, including related price points.
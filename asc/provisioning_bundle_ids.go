@@ -56,6 +56,8 @@ type BundleIDAttributes struct {
 	Name       *string           `json:"name,omitempty"`
 	Platform   *BundleIDPlatform `json:"platform,omitempty"`
 	SeedID     *string           `json:"seedId,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BundleIDRelationships defines model for BundleId.Relationships
@@ -65,6 +67,8 @@ type BundleIDRelationships struct {
 	App                  *Relationship      `json:"app,omitempty"`
 	BundleIDCapabilities *PagedRelationship `json:"bundleIdCapabilities,omitempty"`
 	Profiles             *PagedRelationship `json:"profiles,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BundleIDCreateRequest defines model for BundleIdCreateRequest.
@@ -83,6 +87,8 @@ type BundleIDCreateRequestAttributes struct {
 	Name       string           `json:"name"`
 	Platform   BundleIDPlatform `json:"platform"`
 	SeedID     *string          `json:"seedId,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BundleIDUpdateRequest defines model for BundleIdUpdateRequest.
@@ -128,59 +134,64 @@ type BundleIDResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_bundle_ids
 type ListBundleIDsQuery struct {
-	FieldsBundleIds            []string `url:"fields[bundleIds],omitempty"`
-	FieldsProfiles             []string `url:"fields[profiles],omitempty"`
-	FieldsBundleIDCapabilities []string `url:"fields[bundleIdCapabilities],omitempty"`
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	Include                    []string `url:"include,omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	LimitProfiles              int      `url:"limit[profiles],omitempty"`
-	LimitBundleIDCapabilities  int      `url:"limit[bundleIdCapabilities],omitempty"`
-	Sort                       []string `url:"sort,omitempty"`
-	FilterID                   []string `url:"filter[id],omitempty"`
-	FilterIdentifier           []string `url:"filter[identifier],omitempty"`
-	FilterName                 []string `url:"filter[name],omitempty"`
-	FilterPlatform             []string `url:"filter[platform],omitempty"`
-	FilterSeedID               []string `url:"filter[seedId],omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsBundleIds            []string     `url:"fields[bundleIds],omitempty"`
+	FieldsProfiles             []string     `url:"fields[profiles],omitempty"`
+	FieldsBundleIDCapabilities []string     `url:"fields[bundleIdCapabilities],omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	LimitProfiles              int          `url:"limit[profiles],omitempty"`
+	LimitBundleIDCapabilities  int          `url:"limit[bundleIdCapabilities],omitempty"`
+	Sort                       []string     `url:"sort,omitempty"`
+	FilterID                   []string     `url:"filter[id],omitempty"`
+	FilterIdentifier           []string     `url:"filter[identifier],omitempty"`
+	FilterName                 []string     `url:"filter[name],omitempty"`
+	FilterPlatform             []string     `url:"filter[platform],omitempty"`
+	FilterSeedID               []string     `url:"filter[seedId],omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetBundleIDQuery are query options for GetBundleID
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_bundle_id_information
 type GetBundleIDQuery struct {
-	FieldsBundleIds            []string `url:"fields[bundleIds],omitempty"`
-	FieldsProfiles             []string `url:"fields[profiles],omitempty"`
-	FieldsBundleIDCapabilities []string `url:"fields[bundleIdCapabilities],omitempty"`
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	LimitProfiles              int      `url:"limit[profiles],omitempty"`
-	LimitBundleIDCapabilities  int      `url:"limit[bundleIdCapabilities],omitempty"`
-	Include                    []string `url:"include,omitempty"`
+	FieldsBundleIds            []string     `url:"fields[bundleIds],omitempty"`
+	FieldsProfiles             []string     `url:"fields[profiles],omitempty"`
+	FieldsBundleIDCapabilities []string     `url:"fields[bundleIdCapabilities],omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	LimitProfiles              int          `url:"limit[profiles],omitempty"`
+	LimitBundleIDCapabilities  int          `url:"limit[bundleIdCapabilities],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetAppForBundleIDQuery are query options for GetAppForBundleID
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_bundle_id
 type GetAppForBundleIDQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListProfilesForBundleIDQuery are query options for ListProfilesForBundleID
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_profiles_for_a_bundle_id
 type ListProfilesForBundleIDQuery struct {
-	FieldsProfiles []string `url:"fields[profiles],omitempty"`
-	Limit          int      `url:"limit,omitempty"`
-	Cursor         string   `url:"cursor,omitempty"`
+	FieldsProfiles []string     `url:"fields[profiles],omitempty"`
+	Limit          int          `url:"limit,omitempty"`
+	Cursor         string       `url:"cursor,omitempty"`
+	Query          QueryEncoder `url:"-"`
 }
 
 // ListCapabilitiesForBundleIDQuery are query options for ListCapabilitiesForBundleID
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_capabilities_for_a_bundle_id
 type ListCapabilitiesForBundleIDQuery struct {
-	FieldsBundleIDCapabilities []string `url:"fields[bundleIdCapabilities],omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsBundleIDCapabilities []string     `url:"fields[bundleIdCapabilities],omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // CreateBundleID registers a new bundle ID for app development.
@@ -105,6 +105,8 @@ type BundleIDCapability struct {
 type BundleIDCapabilityAttributes struct {
 	CapabilityType *CapabilityType     `json:"capabilityType,omitempty"`
 	Settings       []CapabilitySetting `json:"settings,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // bundleIDCapabilityCreateRequest defines model for BundleIdCapabilityCreateRequest.
@@ -78,6 +78,8 @@ type CertificateAttributes struct {
 	Name               *string           `json:"name,omitempty"`
 	Platform           *BundleIDPlatform `json:"platform,omitempty"`
 	SerialNumber       *string           `json:"serialNumber,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // certificateCreateRequest defines model for certificateCreateRequest.
@@ -117,22 +119,24 @@ type CertificatesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_and_download_certificates
 type ListCertificatesQuery struct {
-	FieldsCertificates    []string `url:"fields[certificates],omitempty"`
-	Limit                 int      `url:"limit,omitempty"`
-	Include               []string `url:"include,omitempty"`
-	Sort                  []string `url:"sort,omitempty"`
-	FilterID              []string `url:"filter[id],omitempty"`
-	FilterSerialNumber    []string `url:"filter[serialNumber],omitempty"`
-	FilterCertificateType []string `url:"filter[certificateType],omitempty"`
-	FilterDisplayName     []string `url:"filter[displayName],omitempty"`
-	Cursor                string   `url:"cursor,omitempty"`
+	FieldsCertificates    []string     `url:"fields[certificates],omitempty"`
+	Limit                 int          `url:"limit,omitempty"`
+	Include               []string     `url:"include,omitempty"`
+	Sort                  []string     `url:"sort,omitempty"`
+	FilterID              []string     `url:"filter[id],omitempty"`
+	FilterSerialNumber    []string     `url:"filter[serialNumber],omitempty"`
+	FilterCertificateType []string     `url:"filter[certificateType],omitempty"`
+	FilterDisplayName     []string     `url:"filter[displayName],omitempty"`
+	Cursor                string       `url:"cursor,omitempty"`
+	Query                 QueryEncoder `url:"-"`
 }
 
 // GetCertificateQuery are query options for GetCertificate
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_and_download_certificate_information
 type GetCertificateQuery struct {
-	FieldsCertificates []string `url:"fields[certificates],omitempty"`
+	FieldsCertificates []string     `url:"fields[certificates],omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // CreateCertificate creates a new certificate using a certificate signing request.
@@ -46,6 +46,8 @@ type DeviceAttributes struct {
 	Platform    *BundleIDPlatform `json:"platform,omitempty"`
 	Status      *string           `json:"status,omitempty"`
 	UDID        *string           `json:"udid,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // DeviceCreateRequest defines model for DeviceCreateRequest.
@@ -103,22 +105,24 @@ type DevicesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_devices
 type ListDevicesQuery struct {
-	FieldsDevices  []string `url:"fields[devices],omitempty"`
-	FilterID       []string `url:"filter[id],omitempty"`
-	FilterName     []string `url:"filter[name],omitempty"`
-	FilterPlatform []string `url:"filter[platform],omitempty"`
-	FilterStatus   []string `url:"filter[status],omitempty"`
-	FilterUDID     []string `url:"filter[udid],omitempty"`
-	Limit          int      `url:"limit,omitempty"`
-	Sort           []string `url:"sort,omitempty"`
-	Cursor         string   `url:"cursor,omitempty"`
+	FieldsDevices  []string     `url:"fields[devices],omitempty"`
+	FilterID       []string     `url:"filter[id],omitempty"`
+	FilterName     []string     `url:"filter[name],omitempty"`
+	FilterPlatform []string     `url:"filter[platform],omitempty"`
+	FilterStatus   []string     `url:"filter[status],omitempty"`
+	FilterUDID     []string     `url:"filter[udid],omitempty"`
+	Limit          int          `url:"limit,omitempty"`
+	Sort           []string     `url:"sort,omitempty"`
+	Cursor         string       `url:"cursor,omitempty"`
+	Query          QueryEncoder `url:"-"`
 }
 
 // GetDeviceQuery are query options for GetDevice
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_device_information
 type GetDeviceQuery struct {
-	FieldsDevices []string `url:"fields[devices],omitempty"`
+	FieldsDevices []string     `url:"fields[devices],omitempty"`
+	Query         QueryEncoder `url:"-"`
 }
 
 // CreateDevice registers a new device for app development.
@@ -48,6 +48,8 @@ type ProfileAttributes struct {
 	ProfileState   *string           `json:"profileState,omitempty"`
 	ProfileType    *string           `json:"profileType,omitempty"`
 	UUID           *string           `json:"uuid,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // ProfileRelationships defines model for Profile.Relationships
@@ -57,6 +59,8 @@ type ProfileRelationships struct {
 	BundleID     *Relationship      `json:"bundleId,omitempty"`
 	Certificates *PagedRelationship `json:"certificates,omitempty"`
 	Devices      *PagedRelationship `json:"devices,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // ProfileCreateRequest defines model for ProfileCreateRequest.
@@ -112,58 +116,63 @@ type ProfileResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_and_download_profiles
 type ListProfilesQuery struct {
-	FieldsCertificates []string `url:"fields[certificates],omitempty"`
-	FieldsDevices      []string `url:"fields[devices],omitempty"`
-	FieldsProfiles     []string `url:"fields[profiles],omitempty"`
-	FieldsID           []string `url:"fields[id],omitempty"`
-	FieldsName         []string `url:"fields[name],omitempty"`
-	FieldsBundleIDs    []string `url:"fields[bundleIds],omitempty"`
-	Include            []string `url:"include,omitempty"`
-	Limit              int      `url:"limit,omitempty"`
-	LimitCertificates  int      `url:"limit[certificates],omitempty"`
-	LimitDevices       int      `url:"limit[devices],omitempty"`
-	Sort               []string `url:"sort,omitempty"`
-	FilterProfileState []string `url:"filter[profileState],omitempty"`
-	FilterProfileType  []string `url:"filter[profileType],omitempty"`
-	Cursor             string   `url:"cursor,omitempty"`
+	FieldsCertificates []string     `url:"fields[certificates],omitempty"`
+	FieldsDevices      []string     `url:"fields[devices],omitempty"`
+	FieldsProfiles     []string     `url:"fields[profiles],omitempty"`
+	FieldsID           []string     `url:"fields[id],omitempty"`
+	FieldsName         []string     `url:"fields[name],omitempty"`
+	FieldsBundleIDs    []string     `url:"fields[bundleIds],omitempty"`
+	Include            []string     `url:"include,omitempty"`
+	Limit              int          `url:"limit,omitempty"`
+	LimitCertificates  int          `url:"limit[certificates],omitempty"`
+	LimitDevices       int          `url:"limit[devices],omitempty"`
+	Sort               []string     `url:"sort,omitempty"`
+	FilterProfileState []string     `url:"filter[profileState],omitempty"`
+	FilterProfileType  []string     `url:"filter[profileType],omitempty"`
+	Cursor             string       `url:"cursor,omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // GetProfileQuery are query options for GetProfile
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_and_download_profile_information
 type GetProfileQuery struct {
-	FieldsCertificates []string `url:"fields[certificates],omitempty"`
-	FieldsDevices      []string `url:"fields[devices],omitempty"`
-	FieldsProfiles     []string `url:"fields[profiles],omitempty"`
-	FieldsBundleIds    []string `url:"fields[bundleIds],omitempty"`
-	LimitCertificates  int      `url:"limit[certificates],omitempty"`
-	LimitDevices       int      `url:"limit[devices],omitempty"`
-	Include            []string `url:"include,omitempty"`
+	FieldsCertificates []string     `url:"fields[certificates],omitempty"`
+	FieldsDevices      []string     `url:"fields[devices],omitempty"`
+	FieldsProfiles     []string     `url:"fields[profiles],omitempty"`
+	FieldsBundleIds    []string     `url:"fields[bundleIds],omitempty"`
+	LimitCertificates  int          `url:"limit[certificates],omitempty"`
+	LimitDevices       int          `url:"limit[devices],omitempty"`
+	Include            []string     `url:"include,omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // GetBundleIDForProfileQuery are query options for GetBundleIDForProfile
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_bundle_id_in_a_profile
 type GetBundleIDForProfileQuery struct {
-	FieldsCertificates []string `url:"fields[certificates],omitempty"`
+	FieldsCertificates []string     `url:"fields[certificates],omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // ListCertificatesForProfileQuery are query options for ListCertificatesForProfile
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_certificates_in_a_profile
 type ListCertificatesForProfileQuery struct {
-	FieldsCertificates []string `url:"fields[certificates],omitempty"`
-	Limit              int      `url:"limit,omitempty"`
-	Cursor             string   `url:"cursor,omitempty"`
+	FieldsCertificates []string     `url:"fields[certificates],omitempty"`
+	Limit              int          `url:"limit,omitempty"`
+	Cursor             string       `url:"cursor,omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // ListDevicesInProfileQuery are query options for ListDevicesInProfile
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_devices_in_a_profile
 type ListDevicesInProfileQuery struct {
-	FieldsDevices []string `url:"fields[devices],omitempty"`
-	Limit         int      `url:"limit,omitempty"`
-	Cursor        string   `url:"cursor,omitempty"`
+	FieldsDevices []string     `url:"fields[devices],omitempty"`
+	Limit         int          `url:"limit,omitempty"`
+	Cursor        string       `url:"cursor,omitempty"`
+	Query         QueryEncoder `url:"-"`
 }
 
 // CreateProfile creates a new provisioning profile.
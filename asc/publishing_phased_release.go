@@ -59,6 +59,8 @@ type AppStoreVersionPhasedReleaseAttributes struct {
 	PhasedReleaseState *PhasedReleaseState `json:"phasedReleaseState,omitempty"`
 	StartDate          *DateTime           `json:"startDate,omitempty"`
 	TotalPauseDuration *int                `json:"totalPauseDuration,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appStoreVersionPhasedReleaseCreateRequest defines model for appStoreVersionPhasedReleaseCreateRequest.
@@ -112,7 +114,8 @@ type appStoreVersionPhasedReleaseUpdateRequestAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_phased_release_information_of_an_app_store_version
 type GetAppStoreVersionPhasedReleaseForAppStoreVersionQuery struct {
-	FieldsAppStoreVersionPhasedReleases []string `url:"fields[appStoreVersionPhasedReleases],omitempty"`
+	FieldsAppStoreVersionPhasedReleases []string     `url:"fields[appStoreVersionPhasedReleases],omitempty"`
+	Query                               QueryEncoder `url:"-"`
 }
 
 // CreatePhasedRelease enables phased release for an App Store version.
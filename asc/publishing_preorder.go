@@ -42,6 +42,8 @@ type AppPreOrder struct {
 type AppPreOrderAttributes struct {
 	AppReleaseDate        *Date `json:"appReleaseDate,omitempty"`
 	PreOrderAvailableDate *Date `json:"preOrderAvailableDate,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPreOrderRelationships defines model for AppPreOrder.Relationships
@@ -49,6 +51,8 @@ type AppPreOrderAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreorder/relationships
 type AppPreOrderRelationships struct {
 	App *Relationship `json:"app,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppPreOrderCreateRequest defines model for AppPreOrderCreateRequest.
@@ -100,15 +104,17 @@ type AppPreOrderResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_pre-order_information
 type GetPreOrderQuery struct {
-	FieldsAppPreOrders []string `url:"fields[appPreOrders],omitempty"`
-	Include            []string `url:"include,omitempty"`
+	FieldsAppPreOrders []string     `url:"fields[appPreOrders],omitempty"`
+	Include            []string     `url:"include,omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // GetPreOrderForAppQuery are query options for GetPreOrderForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_pre-order_information_of_an_app
 type GetPreOrderForAppQuery struct {
-	FieldsAppPreOrders []string `url:"fields[appPreOrders],omitempty"`
+	FieldsAppPreOrders []string     `url:"fields[appPreOrders],omitempty"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // GetPreOrder gets information about your app's pre-order configuration.
@@ -0,0 +1,462 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxLimit         = 200
+	maxIncludedLimit = 50
+)
+
+// ErrInvalidQuery happens when a Query is built with a combination of parameters that the API
+// would reject. The query is validated before the request is sent.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Field is a field of a resource type that can be requested with the fields[type] parameter,
+// such as the version of a build.
+type Field struct {
+	resource string
+	name     string
+}
+
+// String returns the field in the form "type.name".
+func (f Field) String() string {
+	return f.resource + "." + f.name
+}
+
+// Include is a relationship of resources of type R that can be included in the response with the
+// include parameter, such as the app of a build.
+type Include[R any] struct {
+	name     string
+	target   string
+	maxLimit int
+}
+
+// String returns the name of the relationship.
+func (i Include[R]) String() string {
+	return i.name
+}
+
+// Sort is a key that resources of type R can be sorted by. Keys sort in ascending order, unless
+// Desc is used.
+type Sort[R any] struct {
+	name       string
+	descending bool
+}
+
+// Desc returns the key sorting in descending order.
+func (s Sort[R]) Desc() Sort[R] {
+	s.descending = true
+
+	return s
+}
+
+// String returns the key as it is encoded in the sort parameter, with a leading '-' when descending.
+func (s Sort[R]) String() string {
+	if s.descending {
+		return "-" + s.name
+	}
+
+	return s.name
+}
+
+// Filter is an attribute or relationship of resources of type R that can be filtered on with the
+// filter[name] parameter.
+type Filter[R any] struct {
+	name string
+}
+
+// Exists is a relationship of resources of type R that can be filtered on by whether it has any
+// resources, with the exists[name] parameter.
+type Exists[R any] struct {
+	name string
+}
+
+// Query is a type-safe builder for the query parameters of a request for resources of type R.
+// Its methods can be chained, and the query is validated when it is encoded:
+//
+//	q := asc.NewBuildsQuery().
+//		Fields(asc.BuildFieldVersion, asc.BuildFieldUploadedDate, asc.AppFieldName).
+//		Include(asc.BuildIncludeApp).
+//		Filter(asc.BuildFilterProcessingState, "VALID").
+//		Sort(asc.BuildSortUploadedDate.Desc()).
+//		Limit(50)
+//
+// A Query can be passed as the query of Client.Do, Get and List, or as the Query field of the
+// parameters of a service method, where it replaces the parameters of the other fields that it
+// also sets:
+//
+//	builds, _, err := client.Builds.ListBuilds(ctx, &asc.ListBuildsQuery{Query: q})
+//
+// It only applies to that request: the next pages of a collection are requested with the next link
+// of the previous page.
+type Query[R any] struct {
+	resource string
+	fields   map[string][]string
+	include  []Include[R]
+	filters  map[string][]string
+	exists   map[string]bool
+	sort     []Sort[R]
+	limit    int
+	limits   map[string]int
+	params   url.Values
+}
+
+func newQuery[R any](resource string) *Query[R] {
+	return &Query[R]{
+		resource: resource,
+		fields:   map[string][]string{},
+		filters:  map[string][]string{},
+		exists:   map[string]bool{},
+		limits:   map[string]int{},
+		params:   url.Values{},
+	}
+}
+
+// Fields restricts the fields returned for each resource type. Fields of included resources can be
+// requested alongside fields of R.
+func (q *Query[R]) Fields(fields ...Field) *Query[R] {
+	for _, f := range fields {
+		q.fields[f.resource] = appendUnique(q.fields[f.resource], f.name)
+	}
+
+	return q
+}
+
+// Include includes related resources in the response.
+func (q *Query[R]) Include(relationships ...Include[R]) *Query[R] {
+	for _, rel := range relationships {
+		if !q.includes(rel.name) {
+			q.include = append(q.include, rel)
+		}
+	}
+
+	return q
+}
+
+// Filter returns only resources whose attribute or relationship matches any of the values.
+func (q *Query[R]) Filter(filter Filter[R], values ...string) *Query[R] {
+	q.filters[filter.name] = append(q.filters[filter.name], values...)
+
+	return q
+}
+
+// Exists returns only resources that have, or don't have, any related resources.
+func (q *Query[R]) Exists(exists Exists[R], value bool) *Query[R] {
+	q.exists[exists.name] = value
+
+	return q
+}
+
+// Sort sorts the resources by each key in turn.
+func (q *Query[R]) Sort(keys ...Sort[R]) *Query[R] {
+	q.sort = append(q.sort, keys...)
+
+	return q
+}
+
+// Limit sets the number of resources returned per page, up to 200.
+func (q *Query[R]) Limit(n int) *Query[R] {
+	q.limit = n
+
+	return q
+}
+
+// LimitIncluded sets the number of related resources included for the relationship, which must be
+// included as well.
+func (q *Query[R]) LimitIncluded(rel Include[R], n int) *Query[R] {
+	q.limits[rel.name] = n
+
+	return q
+}
+
+// Cursor sets the cursor of the page to request, as returned by Reference.Cursor.
+func (q *Query[R]) Cursor(cursor string) *Query[R] {
+	return q.Param("cursor", cursor)
+}
+
+// Param sets a raw query parameter, for parameters this package does not model yet. It replaces
+// any value set for the same key by the other methods.
+func (q *Query[R]) Param(key string, values ...string) *Query[R] {
+	q.params[key] = values
+
+	return q
+}
+
+// Validate reports any combination of parameters the API would reject. The returned error matches
+// ErrInvalidQuery.
+func (q *Query[R]) Validate() error {
+	var errs []error
+
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidQuery, fmt.Sprintf(format, args...)))
+	}
+
+	for _, resource := range sortedKeys(q.fields) {
+		if resource == "" {
+			invalid("fields must be created by this package")
+		} else if resource != q.resource && !q.includesType(resource) {
+			invalid("fields[%s] requires including a relationship to %s", resource, resource)
+		}
+	}
+
+	for _, rel := range q.include {
+		if rel.name == "" {
+			invalid("include must be created by this package")
+		}
+	}
+
+	if q.limit < 0 || q.limit > maxLimit {
+		invalid("limit must be between 1 and %d, got %d", maxLimit, q.limit)
+	}
+
+	for _, name := range sortedKeys(q.limits) {
+		n := q.limits[name]
+
+		rel, ok := q.includedRelationship(name)
+		if !ok {
+			invalid("limit[%s] requires including %s", name, name)
+
+			continue
+		}
+
+		if rel.maxLimit == 0 {
+			invalid("limit[%s] is not supported by a to-one relationship", name)
+		} else if n < 1 || n > rel.maxLimit {
+			invalid("limit[%s] must be between 1 and %d, got %d", name, rel.maxLimit, n)
+		}
+	}
+
+	seen := map[string]bool{}
+
+	for _, key := range q.sort {
+		if key.name == "" {
+			invalid("sort keys must be created by this package")
+		} else if seen[key.name] {
+			invalid("sort key %s is used more than once", key.name)
+		}
+
+		seen[key.name] = true
+	}
+
+	for _, name := range sortedKeys(q.filters) {
+		if name == "" {
+			invalid("filters must be created by this package")
+		} else if len(q.filters[name]) == 0 {
+			invalid("filter[%s] requires at least one value", name)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Values validates the query and encodes it, joining multiple values of a parameter with commas
+// as the API expects.
+func (q *Query[R]) Values() (url.Values, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+
+	for resource, names := range q.fields {
+		values.Set("fields["+resource+"]", strings.Join(names, ","))
+	}
+
+	if len(q.include) > 0 {
+		names := make([]string, len(q.include))
+		for i, rel := range q.include {
+			names[i] = rel.name
+		}
+
+		values.Set("include", strings.Join(names, ","))
+	}
+
+	for name, v := range q.filters {
+		values.Set("filter["+name+"]", strings.Join(v, ","))
+	}
+
+	for name, v := range q.exists {
+		values.Set("exists["+name+"]", strconv.FormatBool(v))
+	}
+
+	if len(q.sort) > 0 {
+		keys := make([]string, len(q.sort))
+		for i, key := range q.sort {
+			keys[i] = key.String()
+		}
+
+		values.Set("sort", strings.Join(keys, ","))
+	}
+
+	if q.limit > 0 {
+		values.Set("limit", strconv.Itoa(q.limit))
+	}
+
+	for name, n := range q.limits {
+		values.Set("limit["+name+"]", strconv.Itoa(n))
+	}
+
+	for key, v := range q.params {
+		values[key] = v
+	}
+
+	return values, nil
+}
+
+func (q *Query[R]) resourceType() string {
+	return q.resource
+}
+
+func (q *Query[R]) includes(name string) bool {
+	_, ok := q.includedRelationship(name)
+
+	return ok
+}
+
+func (q *Query[R]) includedRelationship(name string) (Include[R], bool) {
+	for _, rel := range q.include {
+		if rel.name == name {
+			return rel, true
+		}
+	}
+
+	return Include[R]{}, false
+}
+
+func (q *Query[R]) includesType(resource string) bool {
+	for _, rel := range q.include {
+		if rel.target == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueryEncoder encodes query parameters. It is implemented by every Query, and can be passed as the
+// query of Client.Do, Get and List, or as the Query field of the parameters of a service method.
+type QueryEncoder interface {
+	Values() (url.Values, error)
+}
+
+// appendingQuery adds the parameters of query to path, as appendingQueryOptions does. The Query
+// field of the parameters of a service method is encoded over the other fields, replacing the
+// parameters they both set. A Query for another resource type than the top-level collection or
+// resource at path is rejected.
+func (c *Client) appendingQuery(path string, query interface{}) (string, error) {
+	encoder := queryField(query)
+	if encoder != nil {
+		var err error
+
+		path, err = appendingQueryOptions(path, query)
+		if err != nil {
+			return path, err
+		}
+
+		query = encoder
+	}
+
+	if q, ok := query.(interface{ resourceType() string }); ok {
+		u, err := url.Parse(path)
+		if err != nil {
+			return path, err
+		}
+
+		types := resourceTypes(c.resourcePath(c.resolve(u)))
+		if len(types) == 1 && types[0] != q.resourceType() {
+			return path, fmt.Errorf("%w: a query for %s cannot be used with %s", ErrInvalidQuery, q.resourceType(), types[0])
+		}
+	}
+
+	if encoder == nil {
+		return appendingQueryOptions(path, query)
+	}
+
+	return mergingQuery(path, encoder)
+}
+
+// queryField returns the Query field of the parameters of a service method, or nil if it is unset.
+func queryField(params interface{}) QueryEncoder {
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	field := v.Elem().FieldByName("Query")
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*QueryEncoder)(nil)).Elem() || field.IsNil() {
+		return nil
+	}
+
+	return field.Interface().(QueryEncoder)
+}
+
+// mergingQuery sets the parameters of encoder on path, replacing those path already has.
+func mergingQuery(path string, encoder QueryEncoder) (string, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+
+	values, err := encoder.Values()
+	if err != nil {
+		return path, err
+	}
+
+	qs := u.Query()
+	for key, value := range values {
+		qs[key] = value
+	}
+
+	u.RawQuery = qs.Encode()
+
+	return u.String(), nil
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
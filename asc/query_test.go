@@ -0,0 +1,193 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryValues(t *testing.T) {
+	t.Parallel()
+
+	values, err := NewBuildsQuery().
+		Fields(BuildFieldVersion, BuildFieldUploadedDate, BuildFieldVersion, AppFieldName).
+		Include(BuildIncludeApp, BuildIncludeIndividualTesters).
+		Filter(BuildFilterProcessingState, "VALID", "PROCESSING").
+		Sort(BuildSortUploadedDate.Desc(), BuildSortVersion).
+		Limit(50).
+		LimitIncluded(BuildIncludeIndividualTesters, 10).
+		Param("filter[buildAudienceType]", "APP_STORE_ELIGIBLE").
+		Values()
+
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"fields[builds]":            {"version,uploadedDate"},
+		"fields[apps]":              {"name"},
+		"include":                   {"app,individualTesters"},
+		"filter[processingState]":   {"VALID,PROCESSING"},
+		"filter[buildAudienceType]": {"APP_STORE_ELIGIBLE"},
+		"sort":                      {"-uploadedDate,version"},
+		"limit":                     {"50"},
+		"limit[individualTesters]":  {"10"},
+	}, values)
+
+	values, err = NewAppsQuery().Exists(AppExistsGameCenterEnabledVersions, false).Values()
+	assert.NoError(t, err)
+	assert.Equal(t, "false", values.Get("exists[gameCenterEnabledVersions]"))
+}
+
+func TestQueryValidate(t *testing.T) {
+	t.Parallel()
+
+	err := NewBuildsQuery().
+		Fields(AppFieldName, BetaTesterFieldEmail).
+		Include(BuildIncludeApp).
+		Sort(BuildSortVersion, BuildSortVersion.Desc()).
+		Limit(500).
+		LimitIncluded(BuildIncludeApp, 10).
+		LimitIncluded(BuildIncludeIcons, 10).
+		Filter(BuildFilterVersion).
+		Validate()
+
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	assert.EqualError(t, err, `invalid query: fields[betaTesters] requires including a relationship to betaTesters
+invalid query: limit must be between 1 and 200, got 500
+invalid query: limit[app] is not supported by a to-one relationship
+invalid query: limit[icons] requires including icons
+invalid query: sort key version is used more than once
+invalid query: filter[version] requires at least one value`)
+
+	assert.ErrorIs(t, NewBetaGroupsQuery().Fields(Field{}).Validate(), ErrInvalidQuery)
+	assert.ErrorIs(t, NewBetaGroupsQuery().Include(BetaGroupIncludeBuilds).LimitIncluded(BetaGroupIncludeBuilds, 51).Validate(), ErrInvalidQuery)
+	assert.NoError(t, NewBetaTestersQuery().Validate())
+}
+
+func TestQueryRequest(t *testing.T) {
+	t.Parallel()
+
+	var got url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		fmt.Fprintln(w, "{}")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+
+	ctx := context.Background()
+
+	_, _, err := Get[BuildsResponse](ctx, client, "builds", NewBuildsQuery().Include(BuildIncludeApp).Limit(5))
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"include": {"app"}, "limit": {"5"}}, got)
+
+	_, _, err = Get[BuildsResponse](ctx, client, "apps/1/builds", NewBuildsQuery().Sort(BuildSortVersion))
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"sort": {"version"}}, got)
+
+	got = nil
+	_, _, err = Get[AppsResponse](ctx, client, "apps", NewBuildsQuery())
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	_, _, err = Get[BuildsResponse](ctx, client, "builds", NewBuildsQuery().Limit(-1))
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	assert.Nil(t, got)
+}
+
+func TestQueryServiceMethods(t *testing.T) {
+	t.Parallel()
+
+	var got url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query()
+		fmt.Fprintln(w, "{}")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+
+	ctx := context.Background()
+
+	_, _, err := client.Builds.ListBuilds(ctx, &ListBuildsQuery{
+		FilterVersion: []string{"1"},
+		Limit:         10,
+		Query:         NewBuildsQuery().Include(BuildIncludeApp).Limit(5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"filter[version]": {"1"}, "include": {"app"}, "limit": {"5"}}, got)
+
+	_, _, err = client.Apps.GetApp(ctx, "1", &GetAppQuery{Query: NewAppsQuery().Fields(AppFieldName)})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"fields[apps]": {"name"}}, got)
+
+	_, _, err = client.Builds.ListBuildsForApp(ctx, "1", &ListBuildsForAppQuery{Query: NewBuildsQuery().Limit(2)})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"limit": {"2"}}, got)
+
+	got = nil
+	_, _, err = client.Apps.ListApps(ctx, &ListAppsQuery{Query: NewBuildsQuery()})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	_, _, err = client.Apps.GetApp(ctx, "1", &GetAppQuery{Query: NewAppsQuery().Limit(-1)})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	assert.Nil(t, got)
+}
+
+func TestQueryNotAppliedToNextPages(t *testing.T) {
+	t.Parallel()
+
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		if r.URL.Query().Get("cursor") == "Mg" {
+			fmt.Fprintf(w, `{"data":[{"id":"2","type":"builds"}],"links":{"self":"%s"}}`, r.URL)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"data":[{"id":"1","type":"builds"}],"links":{"self":"%s","next":"%s/builds?cursor=Mg&limit=1"}}`, r.URL, "http://"+r.Host)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+
+	var ids []string
+
+	for build, err := range List[Build](context.Background(), client, "builds", NewBuildsQuery().Cursor("MQ").Limit(1)) {
+		assert.NoError(t, err)
+
+		ids = append(ids, build.ID)
+	}
+
+	assert.Equal(t, []string{"1", "2"}, ids)
+	assert.Equal(t, []string{"cursor=MQ&limit=1", "cursor=Mg&limit=1"}, queries)
+}
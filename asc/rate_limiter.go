@@ -0,0 +1,354 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultHourlyRateLimit = 3600
+	// defaultMaxRate stays below the undocumented limit of roughly 300 requests per minute.
+	defaultMaxRate = 4.5
+	defaultBurst   = 10
+	// slowdownThreshold is the fraction of the hourly limit below which the limiter starts
+	// slowing down towards the sustainable pace.
+	slowdownThreshold = 0.25
+	// defaultExceededDelay is how long requests wait after one was rejected for exceeding the rate
+	// limit, when the API does not say how long with the Retry-After header.
+	defaultExceededDelay = 30 * time.Second
+)
+
+// RateLimiterOptions configures a RateLimiter.
+type RateLimiterOptions struct {
+	// Reserve is the number of requests in the hourly budget that the limiter leaves unused,
+	// for example to keep some headroom for other tools sharing the same key.
+	Reserve int
+	// MaxRate caps the number of requests per second, however large the remaining budget is.
+	// Defaults to 4.5, below the undocumented per-minute limit of App Store Connect.
+	MaxRate float64
+	// Burst is the number of requests that may be sent at once. Defaults to 10.
+	Burst int
+}
+
+// RateLimiter paces requests made by a Client so that they stay within the hourly rate limit of
+// App Store Connect. The budget is adapted from the rate limit reported by every response: requests
+// are sent at up to MaxRate while plenty of budget remains, and slow down to the sustainable pace
+// of the hourly limit as the budget runs out. Once a response reports that the budget is exhausted,
+// requests wait until the hourly window of that response has passed. After a request is rejected
+// for exceeding the rate limit, requests wait for the delay the API asks for with the Retry-After
+// header, or the delay of the retry policy.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/identifying_rate_limits
+type RateLimiter struct {
+	mu      sync.Mutex
+	limiter *rate.Limiter
+	options RateLimiterOptions
+	rate    Rate
+	updated time.Time
+	retryAt time.Time
+	now     func() time.Time
+}
+
+// RateBudget is a snapshot of the request budget tracked by a RateLimiter.
+type RateBudget struct {
+	// Rate is the rate limit reported by the most recent response.
+	Rate Rate
+	// Reserve is the number of requests the limiter leaves unused.
+	Reserve int
+	// Available is the number of requests that can be sent before reaching the reserve.
+	Available int
+	// Pace is the current number of requests per second the limiter allows.
+	Pace float64
+	// UpdatedAt is when the budget was last updated from a response. It is zero if no response
+	// reporting a rate limit has been received yet.
+	UpdatedAt time.Time
+	// ResetAt is when an exhausted budget is expected to be replenished, an hour after UpdatedAt.
+	// It is zero while requests are available.
+	ResetAt time.Time
+	// RetryAt is when requests can be sent again after the API rejected one for exceeding the rate
+	// limit. It is zero once that time has passed.
+	RetryAt time.Time
+
+	// windowPace is the pace of a replenished budget.
+	windowPace float64
+}
+
+// NewRateLimiter creates a new RateLimiter. Set it on a Client with SetRateLimiter.
+func NewRateLimiter(options RateLimiterOptions) *RateLimiter {
+	if options.MaxRate <= 0 {
+		options.MaxRate = defaultMaxRate
+	}
+
+	if options.Burst <= 0 {
+		options.Burst = defaultBurst
+	}
+
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(options.MaxRate), options.Burst),
+		options: options,
+		now:     time.Now,
+	}
+}
+
+// SetRateLimiter sets the RateLimiter shared by every service of this client. Pass nil to
+// stop limiting requests. It is not used when requests are sent through a CredentialPool, which
+// paces each of its keys instead.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.rateLimiter = limiter
+}
+
+// RateBudget returns the request budget tracked by the client's RateLimiter. It returns a zero
+// RateBudget if no RateLimiter is used. The budget of each key of a CredentialPool is reported by
+// its Status.
+func (c *Client) RateBudget() RateBudget {
+	limiter := c.limiter()
+	if limiter == nil {
+		return RateBudget{}
+	}
+
+	return limiter.Budget()
+}
+
+// limiter returns the RateLimiter pacing the requests of the client, if any.
+func (c *Client) limiter() *RateLimiter {
+	if c.credentialPool() != nil {
+		return nil
+	}
+
+	return c.rateLimiter
+}
+
+// Wait blocks until the limiter permits a request to be sent, or until ctx is done. When the
+// budget is exhausted, it blocks until the hourly window of the last response has passed, and after
+// a request was rejected for exceeding the rate limit, until it can be retried. It fails right away
+// with ErrRateLimited if that is after the deadline of ctx.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		resetAt := l.resetAt()
+
+		if !resetAt.IsZero() && !l.now().Before(resetAt) {
+			// The window has passed, so assume the budget is whole until a response says otherwise.
+			l.rate.Remaining = l.rate.Limit
+			l.limiter.SetLimit(rate.Limit(l.pace()))
+			resetAt = time.Time{}
+		}
+
+		if l.retryAt.After(l.now()) && l.retryAt.After(resetAt) {
+			resetAt = l.retryAt
+		}
+
+		delay := resetAt.Sub(l.now())
+		l.mu.Unlock()
+
+		if resetAt.IsZero() {
+			return l.limiter.Wait(ctx)
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(resetAt) {
+			return fmt.Errorf("%w: requests can be sent again at %s, after the deadline", ErrRateLimited, resetAt.Format(time.RFC3339))
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Update adapts the limiter's pace to the rate limit reported by a response. Rates that
+// report no limit are ignored.
+func (l *RateLimiter) Update(r Rate) {
+	if r.Limit <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = r
+	l.updated = l.now()
+	l.limiter.SetLimit(rate.Limit(l.pace()))
+}
+
+// exceed records that the API rejected a request for exceeding the rate limit, and that no request
+// should be sent for delay, or a default delay if it is not positive. The budget is left to the rate
+// limit reported by the responses.
+func (l *RateLimiter) exceed(delay time.Duration) {
+	if delay <= 0 {
+		delay = defaultExceededDelay
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if retryAt := l.now().Add(delay); retryAt.After(l.retryAt) {
+		l.retryAt = retryAt
+	}
+}
+
+// Budget returns a snapshot of the current request budget.
+func (l *RateLimiter) Budget() RateBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return RateBudget{
+		Rate:      l.rate,
+		Reserve:   l.options.Reserve,
+		Available: l.available(),
+		Pace:      float64(l.limiter.Limit()),
+		UpdatedAt: l.updated,
+		ResetAt:   l.resetAt(),
+		RetryAt:   l.pendingRetryAt(),
+
+		windowPace: l.paceFor(l.rate.Limit - l.options.Reserve),
+	}
+}
+
+// blockedUntil returns when requests can be sent again, the later of ResetAt and RetryAt. It is zero
+// if requests can be sent.
+func (b RateBudget) blockedUntil() time.Time {
+	if b.RetryAt.After(b.ResetAt) {
+		return b.RetryAt
+	}
+
+	return b.ResetAt
+}
+
+// pendingRetryAt returns when requests can be sent again after a rejection, or zero if they can
+// already. l.mu must be held.
+func (l *RateLimiter) pendingRetryAt() time.Time {
+	if !l.retryAt.After(l.now()) {
+		return time.Time{}
+	}
+
+	return l.retryAt
+}
+
+// resetAt returns when the exhausted budget is replenished, or zero if requests are available or
+// no response reported a rate limit yet. l.mu must be held.
+func (l *RateLimiter) resetAt() time.Time {
+	if l.updated.IsZero() || l.available() > 0 {
+		return time.Time{}
+	}
+
+	return l.updated.Add(time.Hour)
+}
+
+// available returns the number of requests left before reaching the reserve. l.mu must be held.
+func (l *RateLimiter) available() int {
+	available := l.rate.Remaining - l.options.Reserve
+	if available < 0 {
+		return 0
+	}
+
+	return available
+}
+
+// pace returns the number of requests per second to allow given the current budget. Once the
+// budget falls below a quarter of the hourly limit, the pace decreases linearly down to the
+// sustainable pace of the hourly limit. Once the budget is exhausted, Wait blocks regardless of
+// the pace. l.mu must be held.
+func (l *RateLimiter) pace() float64 {
+	return l.paceFor(l.available())
+}
+
+// paceFor returns the pace for a budget of available requests. l.mu must be held.
+func (l *RateLimiter) paceFor(available int) float64 {
+	limit := l.rate.Limit
+	if limit == 0 {
+		limit = defaultHourlyRateLimit
+	}
+
+	sustainable := float64(limit) / time.Hour.Seconds()
+	if sustainable >= l.options.MaxRate {
+		return l.options.MaxRate
+	}
+
+	headroom := math.Max(0, math.Min(1, float64(available)/(slowdownThreshold*float64(limit))))
+
+	return sustainable + (l.options.MaxRate-sustainable)*headroom
+}
+
+// Forecast estimates how long it will take to send n requests at the current pace. If n is more than
+// the requests available, it includes the wait until the budget is replenished at ResetAt, or an
+// hour after UpdatedAt, and the time to send the rest at the pace of the replenished budget, waiting
+// for as many hourly windows as they need.
+func (b RateBudget) Forecast(n int) time.Duration {
+	if b.Pace <= 0 || n <= 0 {
+		return 0
+	}
+
+	if b.Rate.Limit <= 0 || n <= b.Available {
+		return seconds(float64(n) / b.Pace)
+	}
+
+	forecast := seconds(float64(b.Available) / b.Pace)
+
+	resetAt := b.ResetAt
+	if resetAt.IsZero() {
+		resetAt = b.UpdatedAt.Add(time.Hour)
+	}
+
+	if wait := time.Until(resetAt); wait > forecast {
+		forecast = wait
+	}
+
+	perWindow := b.Rate.Limit - b.Reserve
+	if perWindow <= 0 {
+		return forecast
+	}
+
+	pace := b.windowPace
+	if pace <= 0 {
+		pace = b.Pace
+	}
+
+	remaining := n - b.Available
+
+	// Every window but the last is used up, and then waited out.
+	windows := (remaining - 1) / perWindow
+	window := seconds(float64(perWindow) / pace)
+
+	if window < time.Hour {
+		window = time.Hour
+	}
+
+	forecast += time.Duration(windows) * window
+	remaining -= windows * perWindow
+
+	return forecast + seconds(float64(remaining)/pace)
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
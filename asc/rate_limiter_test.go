@@ -0,0 +1,250 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterPace(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterOptions{Reserve: 100})
+	assert.Equal(t, defaultMaxRate, limiter.Budget().Pace)
+
+	limiter.Update(Rate{Limit: 3600, Remaining: 3000})
+	budget := limiter.Budget()
+	assert.Equal(t, 2900, budget.Available)
+	assert.Equal(t, defaultMaxRate, budget.Pace)
+	assert.False(t, budget.UpdatedAt.IsZero())
+
+	limiter.Update(Rate{Limit: 3600, Remaining: 550})
+	budget = limiter.Budget()
+	assert.Equal(t, 450, budget.Available)
+	assert.InDelta(t, 1+(defaultMaxRate-1)*0.5, budget.Pace, 0.001)
+
+	limiter.Update(Rate{Limit: 3600, Remaining: 50})
+	budget = limiter.Budget()
+	assert.Equal(t, 0, budget.Available)
+	assert.InDelta(t, 1, budget.Pace, 0.001)
+
+	limiter.Update(Rate{})
+	assert.Equal(t, budget.Rate, limiter.Budget().Rate)
+}
+
+func TestRateLimiterExceeded(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := NewRateLimiter(RateLimiterOptions{})
+	limiter.now = func() time.Time { return now }
+	limiter.Update(Rate{Limit: 3600, Remaining: 3000})
+	limiter.exceed(2 * time.Second)
+
+	budget := limiter.Budget()
+	assert.Equal(t, 3000, budget.Available, "a rejection leaves the budget to the responses")
+	assert.Zero(t, budget.ResetAt)
+	assert.Equal(t, now.Add(2*time.Second), budget.RetryAt)
+
+	limiter.exceed(time.Second)
+	assert.Equal(t, now.Add(2*time.Second), limiter.Budget().RetryAt, "a shorter delay does not shorten the wait")
+
+	limiter.exceed(0)
+	assert.Equal(t, now.Add(defaultExceededDelay), limiter.Budget().RetryAt)
+
+	now = now.Add(defaultExceededDelay)
+	assert.Zero(t, limiter.Budget().RetryAt)
+	assert.NoError(t, limiter.Wait(context.Background()))
+}
+
+func TestRateLimiterWaitExceeded(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterOptions{})
+	limiter.exceed(30 * time.Millisecond)
+
+	start := time.Now()
+	assert.NoError(t, limiter.Wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRateLimiterWaitExhausted(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterOptions{})
+	limiter.Update(Rate{Limit: 3600, Remaining: 0})
+
+	budget := limiter.Budget()
+	assert.Equal(t, budget.UpdatedAt.Add(time.Hour), budget.ResetAt)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	assert.ErrorIs(t, err, ErrRateLimited, "waiting past the deadline fails right away")
+	assert.Less(t, time.Since(start), time.Second)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- limiter.Wait(ctx)
+	}()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return after the context was canceled")
+	}
+}
+
+func TestRateLimiterWaitReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := NewRateLimiter(RateLimiterOptions{Reserve: 100})
+	limiter.now = func() time.Time { return now }
+	limiter.Update(Rate{Limit: 3600, Remaining: 50})
+
+	now = now.Add(time.Hour)
+
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	budget := limiter.Budget()
+	assert.Equal(t, 3500, budget.Available)
+	assert.Zero(t, budget.ResetAt)
+	assert.Equal(t, defaultMaxRate, budget.Pace)
+}
+
+func TestRateBudgetForecast(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 10*time.Second, RateBudget{Pace: 2}.Forecast(20))
+	assert.Zero(t, RateBudget{}.Forecast(20))
+	assert.Zero(t, RateBudget{Pace: 2}.Forecast(0))
+}
+
+func TestRateBudgetForecastExhausted(t *testing.T) {
+	t.Parallel()
+
+	budget := RateBudget{
+		Rate:       Rate{Limit: 3600, Remaining: 100},
+		Available:  100,
+		Pace:       1,
+		ResetAt:    time.Now().Add(30 * time.Minute),
+		windowPace: 4.5,
+	}
+
+	assert.Equal(t, 100*time.Second, budget.Forecast(100))
+	assert.InDelta(t, 30*time.Minute+100*time.Second, budget.Forecast(550), float64(time.Second))
+	assert.InDelta(t, 90*time.Minute+2*time.Second, budget.Forecast(100+3600+9), float64(time.Second))
+
+	limiter := NewRateLimiter(RateLimiterOptions{})
+	limiter.Update(Rate{Limit: 3600, Remaining: 0})
+
+	budget = limiter.Budget()
+	assert.InDelta(t, time.Hour+10*time.Second, budget.Forecast(45), float64(time.Second))
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledMockPayload, http.StatusOK, true)
+	defer server.Close()
+
+	assert.Equal(t, RateBudget{}, client.RateBudget())
+
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{}))
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+	assert.NoError(t, err)
+
+	budget := client.RateBudget()
+	assert.Equal(t, Rate{Limit: 2500, Remaining: 10}, budget.Rate)
+	assert.Equal(t, 10, budget.Available)
+}
+
+func TestClientRateLimiterContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledMockPayload, http.StatusOK, true)
+	defer server.Close()
+
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{MaxRate: 0.001, Burst: 1}))
+
+	_, err := client.get(context.Background(), "test", nil, nil)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = client.get(ctx, "test", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestClientRateLimiterTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, http.StatusTooManyRequests)
+	defer server.Close()
+
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{}))
+
+	resp, err := client.get(context.Background(), "test", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Attempts)
+
+	assert.Zero(t, client.RateBudget().ResetAt, "a rejection without an exhausted budget does not wait for the hourly window")
+
+	start := time.Now()
+	_, err = client.get(context.Background(), "test", nil, nil)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.EqualValues(t, 3, atomic.LoadInt32(calls))
+}
+
+func TestClientRateLimiterMaxElapsedTime(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t)
+	defer server.Close()
+
+	limiter := NewRateLimiter(RateLimiterOptions{})
+	limiter.Update(Rate{Limit: 3600, Remaining: 0})
+	client.SetRateLimiter(limiter)
+
+	start := time.Now()
+	_, err := client.get(context.Background(), "test", nil, nil)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Zero(t, atomic.LoadInt32(calls))
+}
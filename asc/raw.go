@@ -0,0 +1,104 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+)
+
+// Document is a generic JSON:API document containing a single resource of type T. Use it with Get
+// to read resources the library does not model yet.
+type Document[T any] struct {
+	Data     T                 `json:"data"`
+	Included []json.RawMessage `json:"included,omitempty"`
+	Links    DocumentLinks     `json:"links"`
+}
+
+// PagedDocument is a generic JSON:API document containing a page of resources of type T. Use it
+// with Get, Pages or CollectAll to read collections the library does not model yet.
+type PagedDocument[T any] struct {
+	Data     []T                `json:"data"`
+	Included []json.RawMessage  `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// Do sends a request to any App Store Connect endpoint with the client's authentication, retry
+// policy, middleware and error handling, for endpoints that have no service method yet.
+//
+// The path is resolved against the client's base URL, unless it is absolute. The query may be nil,
+// a struct whose fields have "url" tags such as the List*Query types, url.Values, or a Query for the
+// resource type at path, and is encoded as for a GET request of a service method. The body, if not
+// nil, is sent as the "data" member of the request document. The response is decoded into out
+// unless it is nil; if out is an io.Writer, the raw response body is copied to it instead.
+//
+// If the API rejects the request, the returned error is an *ErrorResponse.
+func (c *Client) Do(ctx context.Context, method string, path string, query interface{}, body interface{}, out interface{}) (*Response, error) {
+	var err error
+	if query != nil {
+		path, err = c.appendingQuery(path, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		reqBody *requestBody
+		options []requestOption
+	)
+
+	if body != nil {
+		reqBody = newRequestBody(body)
+		options = append(options, withContentType("application/json"))
+	}
+
+	req, err := c.newRequest(ctx, method, path, reqBody, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(ctx, req, out)
+}
+
+// Get sends a GET request to path and decodes the response into a new T. T is usually a response
+// type of this package, or a Document or PagedDocument of a resource type.
+//
+//	res, _, err := asc.Get[asc.Document[MyResource]](ctx, client, "myResources/1", nil)
+func Get[T any](ctx context.Context, c *Client, path string, query interface{}) (*T, *Response, error) {
+	res := new(T)
+	resp, err := c.get(ctx, path, query, res)
+
+	return res, resp, err
+}
+
+// List returns an iterator over every resource of a paged collection at path, following the next
+// link of each page. The query may be nil, a struct whose fields have "url" tags, or url.Values.
+//
+//	for item, err := range asc.List[MyResource](ctx, client, "apps/1/myResources", nil) {
+//		...
+//	}
+func List[T any](ctx context.Context, c *Client, path string, query interface{}, opts ...PageOption) iter.Seq2[T, error] {
+	return Items[T](ctx, c, func(ctx context.Context) (*PagedDocument[T], *Response, error) {
+		return Get[PagedDocument[T]](ctx, c, path, query)
+	}, opts...)
+}
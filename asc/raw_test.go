@@ -0,0 +1,162 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type rawResource struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Name string `json:"name"`
+	} `json:"attributes"`
+}
+
+func TestClientDo(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/widgets", r.URL.Path)
+		assert.Equal(t, "app", r.URL.Query().Get("include"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"data":{"type":"widgets","attributes":{"name":"Widget"}}}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data":{"id":"1","type":"widgets","attributes":{"name":"Widget"}}}`)
+	}))
+	defer server.Close()
+
+	base, _ := url.Parse(server.URL + "/v1/")
+	client := NewClient(server.Client())
+	client.baseURL = base
+
+	body := map[string]interface{}{
+		"type":       "widgets",
+		"attributes": map[string]string{"name": "Widget"},
+	}
+
+	var res Document[rawResource]
+	resp, err := client.Do(context.Background(), "POST", "widgets", url.Values{"include": {"app"}}, body, &res)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "1", res.Data.ID)
+	assert.Equal(t, "Widget", res.Data.Attributes.Name)
+}
+
+func TestClientDoError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"code":"NOT_FOUND","status":"404"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	_, err := client.Do(context.Background(), "DELETE", "widgets/1", nil, nil, nil)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClientDoQueryMatchesGet(t *testing.T) {
+	t.Parallel()
+
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		fmt.Fprintln(w, "{}")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL))
+
+	ctx := context.Background()
+	query := NewBuildsQuery().Include(BuildIncludeApp).Limit(5)
+
+	_, err := client.get(ctx, "builds", query, nil)
+	assert.NoError(t, err)
+	_, err = client.Do(ctx, "GET", "builds", query, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"include=app&limit=5", "include=app&limit=5"}, queries)
+
+	_, err = client.get(ctx, "apps", query, nil)
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	_, err = client.Do(ctx, "GET", "apps", query, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	assert.Len(t, queries, 2)
+}
+
+func TestGetGeneric(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"1","type":"widgets","attributes":{"name":"Widget"}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	res, _, err := Get[Document[rawResource]](context.Background(), client, "widgets/1", &struct {
+		Fields []string `url:"fields[widgets],omitempty,comma"`
+	}{Fields: []string{"name"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Widget", res.Data.Attributes.Name)
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	var ids []string
+
+	for item, err := range List[rawResource](context.Background(), client, "apps", nil) {
+		assert.NoError(t, err)
+
+		ids = append(ids, item.ID)
+	}
+
+	assert.Equal(t, []string{"0-a", "0-b", "1-a", "1-b", "2-a", "2-b"}, ids)
+}
+
+func TestCollectAllPagedDocument(t *testing.T) {
+	t.Parallel()
+
+	client, server := newPagedServer()
+	defer server.Close()
+
+	all, err := CollectAll(context.Background(), client, func(ctx context.Context) (*PagedDocument[rawResource], *Response, error) {
+		return Get[PagedDocument[rawResource]](ctx, client, "apps", nil)
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, all.Data, 6)
+	assert.Len(t, all.Included, 4)
+}
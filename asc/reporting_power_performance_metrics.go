@@ -60,6 +60,8 @@ type DiagnosticSignatureAttributes struct {
 	DiagnosticType *string  `json:"diagnosticType,omitempty"`
 	Signature      *string  `json:"signature,omitempty"`
 	Weight         *float32 `json:"weight,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // DiagnosticSignaturesResponse defines model for DiagnosticSignaturesResponse.
@@ -89,6 +91,8 @@ type PerfPowerMetricAttributes struct {
 	DeviceType *string `json:"deviceType,omitempty"`
 	MetricType *string `json:"metricType,omitempty"`
 	Platform   *string `json:"platform,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // PerfPowerMetricsResponse defines model for PerfPowerMetricsResponse.
@@ -104,28 +108,31 @@ type PerfPowerMetricsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_power_and_performance_metrics_for_a_build
 type GetPerfPowerMetricsQuery struct {
-	FilterDeviceType []string `url:"filter[deviceType],omitempty"`
-	FilterMetricType []string `url:"filter[metricType],omitempty"`
-	FilterPlatform   []string `url:"filter[platform],omitempty"`
-	Cursor           string   `url:"cursor,omitempty"`
+	FilterDeviceType []string     `url:"filter[deviceType],omitempty"`
+	FilterMetricType []string     `url:"filter[metricType],omitempty"`
+	FilterPlatform   []string     `url:"filter[platform],omitempty"`
+	Cursor           string       `url:"cursor,omitempty"`
+	Query            QueryEncoder `url:"-"`
 }
 
 // ListDiagnosticsSignaturesQuery are query options for ListDiagnosticsSignatures
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_diagnostic_signatures_for_a_build
 type ListDiagnosticsSignaturesQuery struct {
-	FieldsDiagnosticSignatures []string `url:"fields[diagnosticSignatures],omitempty"`
-	FilterDiagnosticType       []string `url:"filter[diagnosticType],omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsDiagnosticSignatures []string     `url:"fields[diagnosticSignatures],omitempty"`
+	FilterDiagnosticType       []string     `url:"filter[diagnosticType],omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetLogsForDiagnosticSignatureQuery are query options for GetLogsForDiagnosticSignature
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/download_logs_for_a_diagnostic_signature
 type GetLogsForDiagnosticSignatureQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // GetPerfPowerMetricsForApp gets the performance and power metrics data for the most recent versions of an app.
@@ -30,22 +30,24 @@ import (
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/download_finance_reports
 type DownloadFinanceReportsQuery struct {
-	FilterRegionCode   []string `url:"filter[regionCode]"`
-	FilterReportDate   []string `url:"filter[reportDate]"`
-	FilterReportType   []string `url:"filter[reportType]"`
-	FilterVendorNumber []string `url:"filter[vendorNumber]"`
+	FilterRegionCode   []string     `url:"filter[regionCode]"`
+	FilterReportDate   []string     `url:"filter[reportDate]"`
+	FilterReportType   []string     `url:"filter[reportType]"`
+	FilterVendorNumber []string     `url:"filter[vendorNumber]"`
+	Query              QueryEncoder `url:"-"`
 }
 
 // DownloadSalesAndTrendsReportsQuery are query options for DownloadSalesAndTrendsReports
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
 type DownloadSalesAndTrendsReportsQuery struct {
-	FilterFrequency     []string `url:"filter[frequency]"`
-	FilterReportDate    []string `url:"filter[reportDate],omitempty"`
-	FilterReportSubType []string `url:"filter[reportSubType]"`
-	FilterReportType    []string `url:"filter[reportType]"`
-	FilterVendorNumber  []string `url:"filter[vendorNumber]"`
-	FilterVersion       []string `url:"filter[version],omitempty"`
+	FilterFrequency     []string     `url:"filter[frequency]"`
+	FilterReportDate    []string     `url:"filter[reportDate],omitempty"`
+	FilterReportSubType []string     `url:"filter[reportSubType]"`
+	FilterReportType    []string     `url:"filter[reportType]"`
+	FilterVendorNumber  []string     `url:"filter[vendorNumber]"`
+	FilterVersion       []string     `url:"filter[version],omitempty"`
+	Query               QueryEncoder `url:"-"`
 }
 
 // DownloadFinanceReports downloads finance reports filtered by your specified criteria.
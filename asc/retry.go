@@ -0,0 +1,225 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const headerRetryAfter = "Retry-After"
+
+// RetryPolicy configures how the Client retries requests that fail with a rate limit error,
+// a server error or a transient network error.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first attempt.
+	// A value of 1 or less disables retries.
+	MaxAttempts int
+	// MaxElapsedTime caps the total time spent on a request and its retries. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// InitialInterval is the delay before the first retry. Each following delay grows
+	// exponentially, up to MaxInterval.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between two attempts, unless the API asks for a longer
+	// delay through the Retry-After header.
+	MaxInterval time.Duration
+	// Jitter is the randomization factor applied to each delay, between 0 and 1.
+	Jitter float64
+	// IdempotentMethods lists the HTTP methods that are safe to retry after a server error or
+	// a network error. Requests rejected with 429 Too Many Requests are retried regardless of
+	// their method, since the API did not process them.
+	IdempotentMethods []string
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by clients created with NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:     4,
+		MaxElapsedTime:  2 * time.Minute,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     30 * time.Second,
+		Jitter:          0.5,
+		IdempotentMethods: []string{
+			http.MethodGet,
+			http.MethodHead,
+			http.MethodOptions,
+			http.MethodPut,
+			http.MethodDelete,
+		},
+	}
+}
+
+// NoRetryPolicy returns a RetryPolicy that sends every request exactly once.
+func NoRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 1}
+}
+
+// SetRetryPolicy replaces the RetryPolicy used for all requests made by this client.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+func (p RetryPolicy) backOff() *backoff.ExponentialBackOff {
+	b := backoff.NewExponentialBackOff()
+	b.RandomizationFactor = p.Jitter
+
+	if p.InitialInterval > 0 {
+		b.InitialInterval = p.InitialInterval
+	}
+
+	if p.MaxInterval > 0 {
+		b.MaxInterval = p.MaxInterval
+	}
+
+	// The elapsed time is tracked by the retry loop itself, so that delays requested through
+	// the Retry-After header count towards it as well.
+	b.MaxElapsedTime = 0
+	b.Reset()
+
+	return b
+}
+
+func (p RetryPolicy) isIdempotent(method string) bool {
+	for _, m := range p.IdempotentMethods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shouldRetry reports whether the outcome of an attempt can be retried, and the minimum delay
+// the API asked for before the next attempt.
+func (p RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) (bool, time.Duration) {
+	if err != nil {
+		return p.isIdempotent(req.Method) && isTransientNetworkError(err), 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, parseRetryAfter(resp)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return p.isIdempotent(req.Method), parseRetryAfter(resp)
+	default:
+		return false, 0
+	}
+}
+
+// retryDelay returns how long to wait before the next attempt, or false if the request should not
+// be retried again.
+func (p RetryPolicy) retryDelay(b backoff.BackOff, attempts int, start time.Time, minimum time.Duration) (time.Duration, bool) {
+	if attempts >= p.MaxAttempts {
+		return 0, false
+	}
+
+	delay := b.NextBackOff()
+	if delay == backoff.Stop {
+		return 0, false
+	}
+
+	if minimum > delay {
+		delay = minimum
+	}
+
+	if p.MaxElapsedTime > 0 && time.Since(start)+delay > p.MaxElapsedTime {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// parseRetryAfter parses the Retry-After header, which holds either a number of seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response) time.Duration {
+	header := resp.Header.Get(headerRetryAfter)
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+
+	return 0
+}
+
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// rewindBody resets the body of a request so it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+
+	req.Body = body
+
+	return nil
+}
+
+// discardBody drains and closes the body of a response that is about to be retried, so the
+// underlying connection can be reused.
+func discardBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,222 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fastRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	policy.MaxInterval = time.Millisecond
+
+	return policy
+}
+
+// newFlakyServer responds with the given statuses in order, then with 200 OK and the mock payload.
+func newFlakyServer(t *testing.T, statuses ...int) (*Client, *httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+
+		if r.Method != http.MethodGet {
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"data":{"Field":"TEST"}}`, string(body))
+		}
+
+		if int(n) <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			fmt.Fprintln(w, `{"errors":[]}`)
+
+			return
+		}
+
+		fmt.Fprintln(w, marshaledMockPayload)
+	}))
+
+	base, _ := url.Parse(server.URL)
+	client := NewClient(server.Client())
+	client.baseURL = base
+	client.SetRetryPolicy(fastRetryPolicy())
+
+	return client, server, &calls
+}
+
+func TestRetryServerError(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, http.StatusServiceUnavailable, http.StatusBadGateway)
+	defer server.Close()
+
+	var unmarshaled mockPayload
+	resp, err := client.get(context.Background(), "test", nil, &unmarshaled)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resp.Attempts)
+	assert.EqualValues(t, 3, atomic.LoadInt32(calls))
+	assert.Equal(t, mockPayload{"TEST"}, unmarshaled)
+}
+
+func TestRetryServerErrorNotIdempotent(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	resp, err := client.post(context.Background(), "test", newRequestBody(mockBody{"TEST"}), nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, resp.Attempts)
+	assert.EqualValues(t, 1, atomic.LoadInt32(calls))
+}
+
+func TestRetryTooManyRequestsRewindsBody(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	defer server.Close()
+
+	var unmarshaled mockPayload
+	resp, err := client.post(context.Background(), "test", newRequestBody(mockBody{"TEST"}), &unmarshaled)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, resp.Attempts)
+	assert.EqualValues(t, 3, atomic.LoadInt32(calls))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, 500, 500, 500, 500, 500)
+	defer server.Close()
+
+	resp, err := client.get(context.Background(), "test", nil, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, 4, resp.Attempts)
+	assert.EqualValues(t, 4, atomic.LoadInt32(calls))
+}
+
+func TestNoRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newFlakyServer(t, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	client.SetRetryPolicy(NoRetryPolicy())
+
+	resp, err := client.get(context.Background(), "test", nil, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, resp.Attempts)
+	assert.EqualValues(t, 1, atomic.LoadInt32(calls))
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			_ = conn.Close()
+
+			return
+		}
+
+		fmt.Fprintln(w, marshaledMockPayload)
+	}))
+	defer server.Close()
+
+	base, _ := url.Parse(server.URL)
+	client := NewClient(server.Client())
+	client.baseURL = base
+	client.SetRetryPolicy(fastRetryPolicy())
+
+	resp, err := client.get(context.Background(), "test", nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.Attempts)
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newFlakyServer(t, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialInterval = time.Hour
+	policy.MaxInterval = time.Hour
+	policy.MaxElapsedTime = 0
+	client.SetRetryPolicy(policy)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.get(ctx, "test", nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	resp := &http.Response{Header: http.Header{}}
+	assert.Zero(t, parseRetryAfter(resp))
+
+	resp.Header.Set(headerRetryAfter, "3")
+	assert.Equal(t, 3*time.Second, parseRetryAfter(resp))
+
+	resp.Header.Set(headerRetryAfter, time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.InDelta(t, time.Minute, parseRetryAfter(resp), float64(2*time.Second))
+
+	resp.Header.Set(headerRetryAfter, "soon")
+	assert.Zero(t, parseRetryAfter(resp))
+}
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	policy := fastRetryPolicy()
+	delay, ok := policy.retryDelay(policy.backOff(), 1, time.Now(), 5*time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay)
+
+	policy.MaxElapsedTime = time.Second
+	_, ok = policy.retryDelay(policy.backOff(), 1, time.Now(), 5*time.Second)
+	assert.False(t, ok)
+}
@@ -40,6 +40,8 @@ type AppStoreVersionSubmission struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionsubmission/relationships
 type AppStoreVersionSubmissionRelationships struct {
 	AppStoreVersion *Relationship `json:"appStoreVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appStoreVersionSubmissionCreateRequest defines model for appStoreVersionSubmissionCreateRequest.
@@ -69,9 +71,10 @@ type AppStoreVersionSubmissionResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_submission_information_of_an_app_store_version
 type GetAppStoreVersionSubmissionForAppStoreVersionQuery struct {
-	FieldsAppStoreVersions           []string `url:"fields[appStoreVersions],omitempty"`
-	FieldsAppStoreVersionSubmissions []string `url:"fields[appStoreVersionSubmissions],omitempty"`
-	Include                          []string `url:"include,omitempty"`
+	FieldsAppStoreVersions           []string     `url:"fields[appStoreVersions],omitempty"`
+	FieldsAppStoreVersionSubmissions []string     `url:"fields[appStoreVersionSubmissions],omitempty"`
+	Include                          []string     `url:"include,omitempty"`
+	Query                            QueryEncoder `url:"-"`
 }
 
 // CreateSubmission submits an App Store version to App Review.
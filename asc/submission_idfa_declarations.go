@@ -44,6 +44,8 @@ type IDFADeclarationAttributes struct {
 	AttributesAppInstallationToPreviousAd *bool `json:"attributesAppInstallationToPreviousAd,omitempty"`
 	HonorsLimitedAdTracking               *bool `json:"honorsLimitedAdTracking,omitempty"`
 	ServesAds                             *bool `json:"servesAds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // IDFADeclarationRelationships defines model for IDFADeclaration.Relationships
@@ -51,6 +53,8 @@ type IDFADeclarationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/idfadeclaration/relationships
 type IDFADeclarationRelationships struct {
 	AppStoreVersion *Relationship `json:"appStoreVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // idfaDeclarationCreateRequest defines model for idfaDeclarationCreateRequest.
@@ -70,6 +74,8 @@ type IDFADeclarationCreateRequestAttributes struct {
 	AttributesAppInstallationToPreviousAd bool `json:"attributesAppInstallationToPreviousAd"`
 	HonorsLimitedAdTracking               bool `json:"honorsLimitedAdTracking"`
 	ServesAds                             bool `json:"servesAds"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // idfaDeclarationCreateRequestRelationships are relationships for IDFADeclarationCreateRequest
@@ -96,6 +102,8 @@ type IDFADeclarationUpdateRequestAttributes struct {
 	AttributesAppInstallationToPreviousAd *bool `json:"attributesAppInstallationToPreviousAd,omitempty"`
 	HonorsLimitedAdTracking               *bool `json:"honorsLimitedAdTracking,omitempty"`
 	ServesAds                             *bool `json:"servesAds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // IDFADeclarationResponse defines model for IDFADeclarationResponse.
@@ -110,7 +118,8 @@ type IDFADeclarationResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_idfa_declaration_information_of_an_app_store_version
 type GetIDFADeclarationForAppStoreVersionQuery struct {
-	FieldsIDFADeclarations []string `url:"fields[idfaDeclarations],omitempty"`
+	FieldsIDFADeclarations []string     `url:"fields[idfaDeclarations],omitempty"`
+	Query                  QueryEncoder `url:"-"`
 }
 
 // CreateIDFADeclaration declares the IDFA usage for an App Store version.
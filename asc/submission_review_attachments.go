@@ -45,6 +45,8 @@ type AppStoreReviewAttachmentAttributes struct {
 	FileSize           *int64              `json:"fileSize,omitempty"`
 	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
 	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreReviewAttachmentRelationships defines model for AppStoreReviewAttachment.Relationships
@@ -52,6 +54,8 @@ type AppStoreReviewAttachmentAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/appstorereviewattachment/relationships
 type AppStoreReviewAttachmentRelationships struct {
 	AppStoreReviewDetail *Relationship `json:"appStoreReviewDetail,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // appStoreReviewAttachmentCreateRequest defines model for appStoreReviewAttachmentCreateRequest.
@@ -116,19 +120,21 @@ type AppStoreReviewAttachmentsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_review_attachment_information
 type GetAttachmentQuery struct {
-	FieldsAppStoreReviewAttachments []string `url:"fields[appStoreReviewAttachments],omitempty"`
-	Include                         []string `url:"include,omitempty"`
+	FieldsAppStoreReviewAttachments []string     `url:"fields[appStoreReviewAttachments],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // ListAttachmentQuery are query options for ListAttachmentsForReviewDetail
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_review_attachments_for_an_app_store_review_detail
 type ListAttachmentQuery struct {
-	FieldsAppStoreReviewAttachments []string `url:"fields[appStoreReviewAttachments],omitempty"`
-	FieldsAppStoreReviewDetails     []string `url:"fields[appStoreReviewDetails],omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	Limit                           int      `url:"limit,omitempty"`
-	Cursor                          string   `url:"cursor,omitempty"`
+	FieldsAppStoreReviewAttachments []string     `url:"fields[appStoreReviewAttachments],omitempty"`
+	FieldsAppStoreReviewDetails     []string     `url:"fields[appStoreReviewDetails],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Limit                           int          `url:"limit,omitempty"`
+	Cursor                          string       `url:"cursor,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // GetAttachment gets information about an App Store review attachment and its upload and processing status.
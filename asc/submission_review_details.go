@@ -48,6 +48,8 @@ type AppStoreReviewDetailAttributes struct {
 	DemoAccountPassword *string `json:"demoAccountPassword,omitempty"`
 	DemoAccountRequired *bool   `json:"demoAccountRequired,omitempty"`
 	Notes               *string `json:"notes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreReviewDetailRelationships defines model for AppStoreReviewDetail.Relationships
@@ -56,6 +58,8 @@ type AppStoreReviewDetailAttributes struct {
 type AppStoreReviewDetailRelationships struct {
 	AppStoreReviewAttachments *PagedRelationship `json:"appStoreReviewAttachments,omitempty"`
 	AppStoreVersion           *Relationship      `json:"appStoreVersion,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreReviewDetailCreateRequest defines model for AppStoreReviewDetailCreateRequest.
@@ -79,6 +83,8 @@ type AppStoreReviewDetailCreateRequestAttributes struct {
 	DemoAccountPassword *string `json:"demoAccountPassword,omitempty"`
 	DemoAccountRequired *bool   `json:"demoAccountRequired,omitempty"`
 	Notes               *string `json:"notes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreReviewDetailCreateRequestRelationships are relationships for AppStoreReviewDetailCreateRequest
@@ -109,6 +115,8 @@ type AppStoreReviewDetailUpdateRequestAttributes struct {
 	DemoAccountPassword *string `json:"demoAccountPassword,omitempty"`
 	DemoAccountRequired *bool   `json:"demoAccountRequired,omitempty"`
 	Notes               *string `json:"notes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // AppStoreReviewDetailResponse defines model for AppStoreReviewDetailResponse.
@@ -124,20 +132,22 @@ type AppStoreReviewDetailResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_review_detail_information
 type GetReviewDetailQuery struct {
-	FieldsAppStoreReviewDetails     []string `url:"fields[appStoreReviewDetails],omitempty"`
-	FieldsAppStoreReviewAttachments []string `url:"fields[appStoreReviewAttachments],omitempty"`
-	Include                         []string `url:"include,omitempty"`
-	LimitAppStoreReviewAttachments  int      `url:"limit[appStoreReviewAttachments],omitempty"`
+	FieldsAppStoreReviewDetails     []string     `url:"fields[appStoreReviewDetails],omitempty"`
+	FieldsAppStoreReviewAttachments []string     `url:"fields[appStoreReviewAttachments],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	LimitAppStoreReviewAttachments  int          `url:"limit[appStoreReviewAttachments],omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // GetAppStoreReviewDetailsForAppStoreVersionQuery are query options for GetAppStoreReviewDetailsForAppStoreVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_review_details_resource_information_of_an_app_store_version
 type GetAppStoreReviewDetailsForAppStoreVersionQuery struct {
-	FieldsAppStoreReviewAttachments []string `url:"fields[appStoreReviewAttachments],omitempty"`
-	FieldsAppStoreReviewDetails     []string `url:"fields[appStoreReviewDetails],omitempty"`
-	FieldsAppStoreVersions          []string `url:"fields[appStoreVersions],omitempty"`
-	Include                         []string `url:"include,omitempty"`
+	FieldsAppStoreReviewAttachments []string     `url:"fields[appStoreReviewAttachments],omitempty"`
+	FieldsAppStoreReviewDetails     []string     `url:"fields[appStoreReviewDetails],omitempty"`
+	FieldsAppStoreVersions          []string     `url:"fields[appStoreVersions],omitempty"`
+	Include                         []string     `url:"include,omitempty"`
+	Query                           QueryEncoder `url:"-"`
 }
 
 // CreateReviewDetail adds App Store review details to an App Store version, including contact and demo account information.
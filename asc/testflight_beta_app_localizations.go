@@ -46,6 +46,8 @@ type BetaAppLocalizationAttributes struct {
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	TVOSPrivacyPolicy *string `json:"tvOsPrivacyPolicy,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppLocalizationRelationships defines model for BetaAppLocalization.Relationships
@@ -53,6 +55,8 @@ type BetaAppLocalizationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaapplocalization/relationships
 type BetaAppLocalizationRelationships struct {
 	App *Relationship `json:"app,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // betaAppLocalizationCreateRequest defines model for betaAppLocalizationCreateRequest.
@@ -74,6 +78,8 @@ type BetaAppLocalizationCreateRequestAttributes struct {
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	TVOSPrivacyPolicy *string `json:"tvOsPrivacyPolicy,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // betaAppLocalizationCreateRequestRelationships are relationships for BetaAppLocalizationCreateRequest
@@ -110,6 +116,8 @@ type BetaAppLocalizationUpdateRequestAttributes struct {
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	TVOSPrivacyPolicy *string `json:"tvOsPrivacyPolicy,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppLocalizationsResponse defines model for BetaAppLocalizationsResponse.
@@ -126,38 +134,42 @@ type BetaAppLocalizationsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_localizations
 type ListBetaAppLocalizationsQuery struct {
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	FieldsBetaAppLocalizations []string `url:"fields[betaAppLocalizations],omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Include                    []string `url:"include,omitempty"`
-	FilterApp                  []string `url:"filter[app],omitempty"`
-	FilterLocale               []string `url:"filter[locale],omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	FieldsBetaAppLocalizations []string     `url:"fields[betaAppLocalizations],omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	FilterApp                  []string     `url:"filter[app],omitempty"`
+	FilterLocale               []string     `url:"filter[locale],omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetBetaAppLocalizationQuery defines model for GetBetaAppLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_localization_information
 type GetBetaAppLocalizationQuery struct {
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	FieldsBetaAppLocalizations []string `url:"fields[betaAppLocalizations],omitempty"`
-	Include                    []string `url:"include,omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	FieldsBetaAppLocalizations []string     `url:"fields[betaAppLocalizations],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetAppForBetaAppLocalizationQuery defines model for GetAppForBetaAppLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_app_localization
 type GetAppForBetaAppLocalizationQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListBetaAppLocalizationsForAppQuery defines model for ListBetaAppLocalizationsForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_app_localizations_of_an_app
 type ListBetaAppLocalizationsForAppQuery struct {
-	FieldsBetaAppLocalizations []string `url:"fields[betaAppLocalizations],omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsBetaAppLocalizations []string     `url:"fields[betaAppLocalizations],omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // ListBetaAppLocalizations finds and lists beta app localizations for all apps and locales.
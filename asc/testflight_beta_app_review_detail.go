@@ -48,6 +48,8 @@ type BetaAppReviewDetailAttributes struct {
 	DemoAccountPassword *string `json:"demoAccountPassword,omitempty"`
 	DemoAccountRequired *bool   `json:"demoAccountRequired,omitempty"`
 	Notes               *string `json:"notes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppReviewDetailRelationships defines model for BetaAppReviewDetail.Relationships
@@ -55,6 +57,8 @@ type BetaAppReviewDetailAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewdetail/relationships
 type BetaAppReviewDetailRelationships struct {
 	App *Relationship `json:"app,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppReviewDetailUpdateRequest defines model for BetaAppReviewDetailUpdateRequest.
@@ -78,6 +82,8 @@ type BetaAppReviewDetailUpdateRequestAttributes struct {
 	DemoAccountPassword *string `json:"demoAccountPassword,omitempty"`
 	DemoAccountRequired *bool   `json:"demoAccountRequired,omitempty"`
 	Notes               *string `json:"notes,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppReviewDetailResponse defines model for BetaAppReviewDetailResponse.
@@ -103,35 +109,39 @@ type BetaAppReviewDetailsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_review_details
 type ListBetaAppReviewDetailsQuery struct {
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	FieldsBetaAppReviewDetails []string `url:"fields[betaAppReviewDetails],omitempty"`
-	FilterApp                  []string `url:"filter[app],omitempty"`
-	Include                    []string `url:"include,omitempty"`
-	Limit                      int      `url:"limit,omitempty"`
-	Cursor                     string   `url:"cursor,omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	FieldsBetaAppReviewDetails []string     `url:"fields[betaAppReviewDetails],omitempty"`
+	FilterApp                  []string     `url:"filter[app],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Limit                      int          `url:"limit,omitempty"`
+	Cursor                     string       `url:"cursor,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetBetaAppReviewDetailQuery defines model for GetBetaAppReviewDetail
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_review_detail_information
 type GetBetaAppReviewDetailQuery struct {
-	FieldsApps                 []string `url:"fields[apps],omitempty"`
-	FieldsBetaAppReviewDetails []string `url:"fields[betaAppReviewDetails],omitempty"`
-	Include                    []string `url:"include,omitempty"`
+	FieldsApps                 []string     `url:"fields[apps],omitempty"`
+	FieldsBetaAppReviewDetails []string     `url:"fields[betaAppReviewDetails],omitempty"`
+	Include                    []string     `url:"include,omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // GetAppForBetaAppReviewDetailQuery defines model for GetAppForBetaAppReviewDetail
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_app_review_detail
 type GetAppForBetaAppReviewDetailQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // GetBetaAppReviewDetailsForAppQuery defines model for GetBetaAppReviewDetailsForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_app_review_details_resource_of_an_app
 type GetBetaAppReviewDetailsForAppQuery struct {
-	FieldsBetaAppReviewDetails []string `url:"fields[betaAppReviewDetails],omitempty"`
+	FieldsBetaAppReviewDetails []string     `url:"fields[betaAppReviewDetails],omitempty"`
+	Query                      QueryEncoder `url:"-"`
 }
 
 // ListBetaAppReviewDetails finds and lists beta app review details for all apps.
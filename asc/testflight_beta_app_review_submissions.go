@@ -57,6 +57,8 @@ type BetaAppReviewSubmission struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewsubmission/attributes
 type BetaAppReviewSubmissionAttributes struct {
 	BetaReviewState *BetaReviewState `json:"betaReviewState,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaAppReviewSubmissionRelationships defines model for BetaAppReviewSubmission.Relationships
@@ -64,6 +66,8 @@ type BetaAppReviewSubmissionAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewsubmission/relationships
 type BetaAppReviewSubmissionRelationships struct {
 	Build *Relationship `json:"build,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // betaAppReviewSubmissionCreateRequest defines model for betaAppReviewSubmissionCreateRequest.
@@ -104,36 +108,40 @@ type BetaAppReviewSubmissionsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_review_submissions
 type ListBetaAppReviewSubmissionsQuery struct {
-	FieldsBuilds                   []string `url:"fields[builds],omitempty"`
-	FieldsBetaAppReviewSubmissions []string `url:"fields[betaAppReviewSubmissions],omitempty"`
-	FilterBuild                    []string `url:"filter[build],omitempty"`
-	FilterBetaReviewState          []string `url:"filter[betaReviewState],omitempty"`
-	Include                        []string `url:"include,omitempty"`
-	Limit                          int      `url:"limit,omitempty"`
-	Cursor                         string   `url:"cursor,omitempty"`
+	FieldsBuilds                   []string     `url:"fields[builds],omitempty"`
+	FieldsBetaAppReviewSubmissions []string     `url:"fields[betaAppReviewSubmissions],omitempty"`
+	FilterBuild                    []string     `url:"filter[build],omitempty"`
+	FilterBetaReviewState          []string     `url:"filter[betaReviewState],omitempty"`
+	Include                        []string     `url:"include,omitempty"`
+	Limit                          int          `url:"limit,omitempty"`
+	Cursor                         string       `url:"cursor,omitempty"`
+	Query                          QueryEncoder `url:"-"`
 }
 
 // GetBetaAppReviewSubmissionQuery defines model for GetBetaAppReviewSubmission
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_review_submission_information
 type GetBetaAppReviewSubmissionQuery struct {
-	FieldsBuilds                   []string `url:"fields[builds],omitempty"`
-	FieldsBetaAppReviewSubmissions []string `url:"fields[betaAppReviewSubmissions],omitempty"`
-	Include                        []string `url:"include,omitempty"`
+	FieldsBuilds                   []string     `url:"fields[builds],omitempty"`
+	FieldsBetaAppReviewSubmissions []string     `url:"fields[betaAppReviewSubmissions],omitempty"`
+	Include                        []string     `url:"include,omitempty"`
+	Query                          QueryEncoder `url:"-"`
 }
 
 // GetBuildForBetaAppReviewSubmissionQuery defines model for GetBuildForBetaAppReviewSubmission
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_beta_app_review_submission
 type GetBuildForBetaAppReviewSubmissionQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // GetBetaAppReviewSubmissionForBuildQuery defines model for GetBetaAppReviewSubmissionForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_app_review_submission_of_a_build
 type GetBetaAppReviewSubmissionForBuildQuery struct {
-	FieldsBetaAppReviewSubmissions []string `url:"fields[betaAppReviewSubmissions],omitempty"`
+	FieldsBetaAppReviewSubmissions []string     `url:"fields[betaAppReviewSubmissions],omitempty"`
+	Query                          QueryEncoder `url:"-"`
 }
 
 // CreateBetaAppReviewSubmission submits an app for beta app review to allow external testing.
@@ -42,6 +42,8 @@ type BetaBuildLocalization struct {
 type BetaBuildLocalizationAttributes struct {
 	Locale   *string `json:"locale,omitempty"`
 	WhatsNew *string `json:"whatsNew,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaBuildLocalizationRelationships defines model for BetaBuildLocalization.Relationships
@@ -49,6 +51,8 @@ type BetaBuildLocalizationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betabuildlocalization/relationships
 type BetaBuildLocalizationRelationships struct {
 	Build *Relationship `json:"build,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaBuildLocalizationResponse defines model for BetaBuildLocalizationResponse.
@@ -114,36 +118,40 @@ type BetaBuildLocalizationsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_build_localizations
 type ListBetaBuildLocalizationsQuery struct {
-	FieldsBuilds                 []string `url:"fields[builds],omitempty"`
-	FieldsBetaBuildLocalizations []string `url:"fields[betaBuildLocalizations],omitempty"`
-	Limit                        int      `url:"limit,omitempty"`
-	Include                      []string `url:"include,omitempty"`
-	FilterBuild                  []string `url:"filter[build],omitempty"`
-	FilterLocale                 []string `url:"filter[locale],omitempty"`
+	FieldsBuilds                 []string     `url:"fields[builds],omitempty"`
+	FieldsBetaBuildLocalizations []string     `url:"fields[betaBuildLocalizations],omitempty"`
+	Limit                        int          `url:"limit,omitempty"`
+	Include                      []string     `url:"include,omitempty"`
+	FilterBuild                  []string     `url:"filter[build],omitempty"`
+	FilterLocale                 []string     `url:"filter[locale],omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // GetBetaBuildLocalizationQuery defines model for GetBetaBuildLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_build_localization_information
 type GetBetaBuildLocalizationQuery struct {
-	FieldsBuilds                 []string `url:"fields[builds],omitempty"`
-	FieldsBetaBuildLocalizations []string `url:"fields[betaBuildLocalizations],omitempty"`
-	Include                      []string `url:"include,omitempty"`
+	FieldsBuilds                 []string     `url:"fields[builds],omitempty"`
+	FieldsBetaBuildLocalizations []string     `url:"fields[betaBuildLocalizations],omitempty"`
+	Include                      []string     `url:"include,omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // GetBuildForBetaBuildLocalizationQuery defines model for GetBuildForBetaBuildLocalization
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_beta_build_localization
 type GetBuildForBetaBuildLocalizationQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // ListBetaBuildLocalizationsForBuildQuery defines model for ListBetaBuildLocalizationsForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_build_localizations_of_a_build
 type ListBetaBuildLocalizationsForBuildQuery struct {
-	FieldsBetaBuildLocalizations []string `url:"fields[betaBuildLocalizations],omitempty"`
-	Limit                        int      `url:"limit,omitempty"`
+	FieldsBetaBuildLocalizations []string     `url:"fields[betaBuildLocalizations],omitempty"`
+	Limit                        int          `url:"limit,omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // ListBetaBuildLocalizations finds and lists beta build localizations for all builds and locales.
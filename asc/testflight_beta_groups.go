@@ -49,6 +49,8 @@ type BetaGroupAttributes struct {
 	PublicLinkID           *string   `json:"publicLinkId,omitempty"`
 	PublicLinkLimit        *int      `json:"publicLinkLimit,omitempty"`
 	PublicLinkLimitEnabled *bool     `json:"publicLinkLimitEnabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaGroupRelationships defines model for BetaGroup.Relationships
@@ -58,6 +60,8 @@ type BetaGroupRelationships struct {
 	App         *Relationship      `json:"app,omitempty"`
 	BetaTesters *PagedRelationship `json:"betaTesters,omitempty"`
 	Builds      *PagedRelationship `json:"builds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaGroupResponse defines model for BetaGroupResponse.
@@ -91,6 +95,8 @@ type BetaGroupCreateRequestAttributes struct {
 	PublicLinkEnabled      *bool  `json:"publicLinkEnabled,omitempty"`
 	PublicLinkLimit        *int   `json:"publicLinkLimit,omitempty"`
 	PublicLinkLimitEnabled *bool  `json:"publicLinkLimitEnabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaGroupCreateRequestRelationships are relationships for BetaGroupCreateRequest
@@ -120,6 +126,8 @@ type BetaGroupUpdateRequestAttributes struct {
 	PublicLinkEnabled      *bool   `json:"publicLinkEnabled,omitempty"`
 	PublicLinkLimit        *int    `json:"publicLinkLimit,omitempty"`
 	PublicLinkLimitEnabled *bool   `json:"publicLinkLimitEnabled,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaGroupBetaTestersLinkagesResponse defines model for BetaGroupBetaTestersLinkagesResponse.
@@ -154,87 +162,95 @@ type BetaGroupsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_groups
 type ListBetaGroupsQuery struct {
-	FieldsApps                   []string `url:"fields[apps],omitempty"`
-	FieldsBetaGroups             []string `url:"fields[betaGroups],omitempty"`
-	FieldsBetaTesters            []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds                 []string `url:"fields[builds],omitempty"`
-	FilterApp                    []string `url:"filter[app],omitempty"`
-	FilterBuilds                 []string `url:"filter[builds],omitempty"`
-	FilterID                     []string `url:"filter[id],omitempty"`
-	FilterIsInternalGroup        []string `url:"filter[isInternalGroup],omitempty"`
-	FilterName                   []string `url:"filter[name],omitempty"`
-	FilterPublicLinkEnabled      []string `url:"filter[publicLinkEnabled],omitempty"`
-	FilterPublicLinkLimitEnabled []string `url:"filter[publicLinkLimitEnabled],omitempty"`
-	FilterPublicLink             []string `url:"filter[publicLink],omitempty"`
-	Include                      []string `url:"include,omitempty"`
-	Sort                         []string `url:"sort,omitempty"`
-	Limit                        int      `url:"limit,omitempty"`
-	LimitBuilds                  int      `url:"limit[builds],omitempty"`
-	LimitBetaTesters             int      `url:"limit[betaTesters],omitempty"`
-	Cursor                       string   `url:"cursor,omitempty"`
+	FieldsApps                   []string     `url:"fields[apps],omitempty"`
+	FieldsBetaGroups             []string     `url:"fields[betaGroups],omitempty"`
+	FieldsBetaTesters            []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds                 []string     `url:"fields[builds],omitempty"`
+	FilterApp                    []string     `url:"filter[app],omitempty"`
+	FilterBuilds                 []string     `url:"filter[builds],omitempty"`
+	FilterID                     []string     `url:"filter[id],omitempty"`
+	FilterIsInternalGroup        []string     `url:"filter[isInternalGroup],omitempty"`
+	FilterName                   []string     `url:"filter[name],omitempty"`
+	FilterPublicLinkEnabled      []string     `url:"filter[publicLinkEnabled],omitempty"`
+	FilterPublicLinkLimitEnabled []string     `url:"filter[publicLinkLimitEnabled],omitempty"`
+	FilterPublicLink             []string     `url:"filter[publicLink],omitempty"`
+	Include                      []string     `url:"include,omitempty"`
+	Sort                         []string     `url:"sort,omitempty"`
+	Limit                        int          `url:"limit,omitempty"`
+	LimitBuilds                  int          `url:"limit[builds],omitempty"`
+	LimitBetaTesters             int          `url:"limit[betaTesters],omitempty"`
+	Cursor                       string       `url:"cursor,omitempty"`
+	Query                        QueryEncoder `url:"-"`
 }
 
 // GetBetaGroupQuery defines model for GetBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_group_information
 type GetBetaGroupQuery struct {
-	FieldsApps        []string `url:"fields[apps],omitempty"`
-	FieldsBetaGroups  []string `url:"fields[betaGroups],omitempty"`
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds      []string `url:"fields[builds],omitempty"`
-	Include           []string `url:"include,omitempty"`
-	LimitBuilds       int      `url:"limit[builds],omitempty"`
-	LimitBetaTesters  int      `url:"limit[betaTesters],omitempty"`
+	FieldsApps        []string     `url:"fields[apps],omitempty"`
+	FieldsBetaGroups  []string     `url:"fields[betaGroups],omitempty"`
+	FieldsBetaTesters []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds      []string     `url:"fields[builds],omitempty"`
+	Include           []string     `url:"include,omitempty"`
+	LimitBuilds       int          `url:"limit[builds],omitempty"`
+	LimitBetaTesters  int          `url:"limit[betaTesters],omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // GetAppForBetaGroupQuery defines model for GetAppForBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_group
 type GetAppForBetaGroupQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListBetaGroupsForAppQuery defines model for ListBetaGroupsForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_groups_for_an_app
 type ListBetaGroupsForAppQuery struct {
-	FieldsBetaGroups []string `url:"fields[betaGroups],omitempty"`
-	Limit            int      `url:"limit,omitempty"`
-	Cursor           string   `url:"cursor,omitempty"`
+	FieldsBetaGroups []string     `url:"fields[betaGroups],omitempty"`
+	Limit            int          `url:"limit,omitempty"`
+	Cursor           string       `url:"cursor,omitempty"`
+	Query            QueryEncoder `url:"-"`
 }
 
 // ListBuildsForBetaGroupQuery defines model for ListBuildsForBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_for_a_betagroup
 type ListBuildsForBetaGroupQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
-	Limit        int      `url:"limit,omitempty"`
-	Cursor       string   `url:"cursor,omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Limit        int          `url:"limit,omitempty"`
+	Cursor       string       `url:"cursor,omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // ListBuildIDsForBetaGroupQuery defines model for ListBuildIDsForBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_build_ids_in_a_beta_group
 type ListBuildIDsForBetaGroupQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // ListBetaTestersForBetaGroupQuery defines model for ListBetaTestersForBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_testers_in_a_betagroup
 type ListBetaTestersForBetaGroupQuery struct {
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	Limit             int      `url:"limit,omitempty"`
-	Cursor            string   `url:"cursor,omitempty"`
+	FieldsBetaTesters []string     `url:"fields[betaTesters],omitempty"`
+	Limit             int          `url:"limit,omitempty"`
+	Cursor            string       `url:"cursor,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // ListBetaTesterIDsForBetaGroupQuery defines model for ListBetaTesterIDsForBetaGroup
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_beta_tester_ids_in_a_beta_group
 type ListBetaTesterIDsForBetaGroupQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // CreateBetaGroup creates a beta group associated with an app, optionally enabling TestFlight public links.
@@ -41,6 +41,8 @@ type BetaLicenseAgreement struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betalicenseagreement/attributes
 type BetaLicenseAgreementAttributes struct {
 	AgreementText *string `json:"agreementText,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaLicenseAgreementRelationships defines model for BetaLicenseAgreement.Relationships
@@ -48,6 +50,8 @@ type BetaLicenseAgreementAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betalicenseagreement/relationships
 type BetaLicenseAgreementRelationships struct {
 	App *Relationship `json:"app,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaLicenseAgreementUpdateRequest defines model for BetaLicenseAgreementUpdateRequest.
@@ -89,35 +93,39 @@ type BetaLicenseAgreementResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_license_agreements
 type ListBetaLicenseAgreementsQuery struct {
-	FieldsApps                  []string `url:"fields[apps],omitempty"`
-	FieldsBetaLicenseAgreements []string `url:"fields[betaLicenseAgreements],omitempty"`
-	FilterApp                   []string `url:"filter[app],omitempty"`
-	Include                     []string `url:"include,omitempty"`
-	Limit                       int      `url:"limit,omitempty"`
-	Cursor                      string   `url:"cursor,omitempty"`
+	FieldsApps                  []string     `url:"fields[apps],omitempty"`
+	FieldsBetaLicenseAgreements []string     `url:"fields[betaLicenseAgreements],omitempty"`
+	FilterApp                   []string     `url:"filter[app],omitempty"`
+	Include                     []string     `url:"include,omitempty"`
+	Limit                       int          `url:"limit,omitempty"`
+	Cursor                      string       `url:"cursor,omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // GetBetaLicenseAgreementQuery defines model for GetBetaLicenseAgreement
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_license_agreement_information
 type GetBetaLicenseAgreementQuery struct {
-	FieldsApps                  []string `url:"fields[apps],omitempty"`
-	FieldsBetaLicenseAgreements []string `url:"fields[betaLicenseAgreements],omitempty"`
-	Include                     []string `url:"include,omitempty"`
+	FieldsApps                  []string     `url:"fields[apps],omitempty"`
+	FieldsBetaLicenseAgreements []string     `url:"fields[betaLicenseAgreements],omitempty"`
+	Include                     []string     `url:"include,omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // GetAppForBetaLicenseAgreementQuery defines model for GetAppForBetaLicenseAgreement
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_license_agreement
 type GetAppForBetaLicenseAgreementQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // GetBetaLicenseAgreementForAppQuery defines model for GetBetaLicenseAgreementForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_license_agreement_of_an_app
 type GetBetaLicenseAgreementForAppQuery struct {
-	FieldsBetaLicenseAgreements []string `url:"fields[betaLicenseAgreements],omitempty"`
+	FieldsBetaLicenseAgreements []string     `url:"fields[betaLicenseAgreements],omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // ListBetaLicenseAgreements finds and lists beta license agreements for all apps.
@@ -56,6 +56,8 @@ type BetaTesterAttributes struct {
 	FirstName  *string         `json:"firstName,omitempty"`
 	InviteType *BetaInviteType `json:"inviteType,omitempty"`
 	LastName   *string         `json:"lastName,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaTesterRelationships defines model for BetaTester.Relationships
@@ -65,6 +67,8 @@ type BetaTesterRelationships struct {
 	Apps       *PagedRelationship `json:"apps,omitempty"`
 	BetaGroups *PagedRelationship `json:"betaGroups,omitempty"`
 	Builds     *PagedRelationship `json:"builds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BetaTesterAppsLinkagesResponse defines model for BetaTesterAppsLinkagesResponse.
@@ -110,6 +114,8 @@ type BetaTesterCreateRequestAttributes struct {
 	Email     Email   `json:"email"`
 	FirstName *string `json:"firstName,omitempty"`
 	LastName  *string `json:"lastName,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // betaTesterCreateRequestRelationships are relationships for BetaTesterCreateRequest
@@ -147,98 +153,107 @@ type BetaTesterResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_testers
 type ListBetaTestersQuery struct {
-	FieldsApps        []string `url:"fields[apps],omitempty"`
-	FieldsBetaGroups  []string `url:"fields[betaGroups],omitempty"`
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds      []string `url:"fields[builds],omitempty"`
-	FilterApps        []string `url:"filter[apps],omitempty"`
-	FilterBetaGroups  []string `url:"filter[betaGroups],omitempty"`
-	FilterBuilds      []string `url:"filter[builds],omitempty"`
-	FilterEmail       []string `url:"filter[email],omitempty"`
-	FilterFirstName   []string `url:"filter[firstName],omitempty"`
-	FilterInviteType  []string `url:"filter[inviteType],omitempty"`
-	FilterLastName    []string `url:"filter[lastName],omitempty"`
-	Include           []string `url:"include,omitempty"`
-	Sort              []string `url:"sort,omitempty"`
-	Limit             int      `url:"limit,omitempty"`
-	LimitApps         []string `url:"limit[apps],omitempty"`
-	LimitBetaGroups   []string `url:"limit[betaGroups],omitempty"`
-	LimitBuilds       []string `url:"limit[builds],omitempty"`
-	Cursor            string   `url:"cursor,omitempty"`
+	FieldsApps        []string     `url:"fields[apps],omitempty"`
+	FieldsBetaGroups  []string     `url:"fields[betaGroups],omitempty"`
+	FieldsBetaTesters []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds      []string     `url:"fields[builds],omitempty"`
+	FilterApps        []string     `url:"filter[apps],omitempty"`
+	FilterBetaGroups  []string     `url:"filter[betaGroups],omitempty"`
+	FilterBuilds      []string     `url:"filter[builds],omitempty"`
+	FilterEmail       []string     `url:"filter[email],omitempty"`
+	FilterFirstName   []string     `url:"filter[firstName],omitempty"`
+	FilterInviteType  []string     `url:"filter[inviteType],omitempty"`
+	FilterLastName    []string     `url:"filter[lastName],omitempty"`
+	Include           []string     `url:"include,omitempty"`
+	Sort              []string     `url:"sort,omitempty"`
+	Limit             int          `url:"limit,omitempty"`
+	LimitApps         []string     `url:"limit[apps],omitempty"`
+	LimitBetaGroups   []string     `url:"limit[betaGroups],omitempty"`
+	LimitBuilds       []string     `url:"limit[builds],omitempty"`
+	Cursor            string       `url:"cursor,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // GetBetaTesterQuery defines model for GetBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_tester_information
 type GetBetaTesterQuery struct {
-	FieldsApps        []string `url:"fields[apps],omitempty"`
-	FieldsBetaGroups  []string `url:"fields[betaGroups],omitempty"`
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	FieldsBuilds      []string `url:"fields[builds],omitempty"`
-	Include           []string `url:"include,omitempty"`
-	LimitApps         []string `url:"limit[apps],omitempty"`
-	LimitBetaGroups   []string `url:"limit[betaGroups],omitempty"`
-	LimitBuilds       []string `url:"limit[builds],omitempty"`
+	FieldsApps        []string     `url:"fields[apps],omitempty"`
+	FieldsBetaGroups  []string     `url:"fields[betaGroups],omitempty"`
+	FieldsBetaTesters []string     `url:"fields[betaTesters],omitempty"`
+	FieldsBuilds      []string     `url:"fields[builds],omitempty"`
+	Include           []string     `url:"include,omitempty"`
+	LimitApps         []string     `url:"limit[apps],omitempty"`
+	LimitBetaGroups   []string     `url:"limit[betaGroups],omitempty"`
+	LimitBuilds       []string     `url:"limit[builds],omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // ListAppsForBetaTesterQuery defines model for ListAppsForBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_apps_for_a_beta_tester
 type ListAppsForBetaTesterQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
-	Limit      int      `url:"limit,omitempty"`
-	Cursor     string   `url:"cursor,omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Limit      int          `url:"limit,omitempty"`
+	Cursor     string       `url:"cursor,omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListAppIDsForBetaTesterQuery defines model for ListAppIDsForBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_resource_ids_for_a_beta_tester
 type ListAppIDsForBetaTesterQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // ListBuildsIndividuallyAssignedToBetaTesterQuery defines model for ListBuildsIndividuallyAssignedToBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_individually_assigned_to_a_beta_tester
 type ListBuildsIndividuallyAssignedToBetaTesterQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
-	Limit        int      `url:"limit,omitempty"`
-	Cursor       string   `url:"cursor,omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Limit        int          `url:"limit,omitempty"`
+	Cursor       string       `url:"cursor,omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // ListBuildIDsIndividuallyAssignedToBetaTesterQuery defines model for ListBuildIDsIndividuallyAssignedToBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_ids_of_builds_individually_assigned_to_a_beta_tester
 type ListBuildIDsIndividuallyAssignedToBetaTesterQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // ListIndividualTestersForBuildQuery defines model for ListIndividualTestersForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_individual_testers_for_a_build
 type ListIndividualTestersForBuildQuery struct {
-	FieldsBetaTesters []string `url:"fields[betaTesters],omitempty"`
-	Limit             int      `url:"limit,omitempty"`
-	Cursor            string   `url:"cursor,omitempty"`
+	FieldsBetaTesters []string     `url:"fields[betaTesters],omitempty"`
+	Limit             int          `url:"limit,omitempty"`
+	Cursor            string       `url:"cursor,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // ListBetaGroupsForBetaTesterQuery defines model for ListBetaGroupsForBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_groups_to_which_a_beta_tester_belongs
 type ListBetaGroupsForBetaTesterQuery struct {
-	FieldsBetaGroups []string `url:"fields[betaGroups],omitempty"`
-	Limit            int      `url:"limit,omitempty"`
-	Cursor           string   `url:"cursor,omitempty"`
+	FieldsBetaGroups []string     `url:"fields[betaGroups],omitempty"`
+	Limit            int          `url:"limit,omitempty"`
+	Cursor           string       `url:"cursor,omitempty"`
+	Query            QueryEncoder `url:"-"`
 }
 
 // ListBetaGroupIDsForBetaTesterQuery defines model for ListBetaGroupIDsForBetaTester
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_beta_group_ids_of_a_beta_tester_s_groups
 type ListBetaGroupIDsForBetaTesterQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // CreateBetaTester creates a beta tester assigned to a group, a build, or an app.
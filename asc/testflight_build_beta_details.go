@@ -97,6 +97,8 @@ type BuildBetaDetailAttributes struct {
 	AutoNotifyEnabled  *bool              `json:"autoNotifyEnabled,omitempty"`
 	ExternalBuildState *ExternalBetaState `json:"externalBuildState,omitempty"`
 	InternalBuildState *InternalBetaState `json:"internalBuildState,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BuildBetaDetailRelationships defines model for BuildBetaDetail.Relationships
@@ -104,6 +106,8 @@ type BuildBetaDetailAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/buildbetadetail/relationships
 type BuildBetaDetailRelationships struct {
 	Build *Relationship `json:"build,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // BuildBetaDetailUpdateRequest defines model for BuildBetaDetailUpdateRequest.
@@ -145,36 +149,40 @@ type BuildBetaDetailsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_build_beta_details
 type ListBuildBetaDetailsQuery struct {
-	FieldsBuilds           []string `url:"fields[builds],omitempty"`
-	FieldsBuildBetaDetails []string `url:"fields[buildBetaDetails],omitempty"`
-	FilterID               []string `url:"filter[id],omitempty"`
-	FilterBuild            []string `url:"filter[build],omitempty"`
-	Include                []string `url:"include,omitempty"`
-	Limit                  int      `url:"limit,omitempty"`
-	Cursor                 string   `url:"cursor,omitempty"`
+	FieldsBuilds           []string     `url:"fields[builds],omitempty"`
+	FieldsBuildBetaDetails []string     `url:"fields[buildBetaDetails],omitempty"`
+	FilterID               []string     `url:"filter[id],omitempty"`
+	FilterBuild            []string     `url:"filter[build],omitempty"`
+	Include                []string     `url:"include,omitempty"`
+	Limit                  int          `url:"limit,omitempty"`
+	Cursor                 string       `url:"cursor,omitempty"`
+	Query                  QueryEncoder `url:"-"`
 }
 
 // GetBuildBetaDetailsQuery defines model for GetBuildBetaDetails
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_build_beta_detail_information
 type GetBuildBetaDetailsQuery struct {
-	FieldsBuilds           []string `url:"fields[builds],omitempty"`
-	FieldsBuildBetaDetails []string `url:"fields[buildBetaDetails],omitempty"`
-	Include                []string `url:"include,omitempty"`
+	FieldsBuilds           []string     `url:"fields[builds],omitempty"`
+	FieldsBuildBetaDetails []string     `url:"fields[buildBetaDetails],omitempty"`
+	Include                []string     `url:"include,omitempty"`
+	Query                  QueryEncoder `url:"-"`
 }
 
 // GetBuildForBuildBetaDetailQuery defines model for GetBuildForBuildBetaDetail
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_build_beta_detail
 type GetBuildForBuildBetaDetailQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // GetBuildBetaDetailForBuildQuery defines model for GetBuildBetaDetailForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_beta_details_information_of_a_build
 type GetBuildBetaDetailForBuildQuery struct {
-	FieldsBuildBetaDetails []string `url:"fields[buildBetaDetails],omitempty"`
+	FieldsBuildBetaDetails []string     `url:"fields[buildBetaDetails],omitempty"`
+	Query                  QueryEncoder `url:"-"`
 }
 
 // ListBuildBetaDetails finds and lists build beta details for all builds.
@@ -42,6 +42,8 @@ type PrereleaseVersion struct {
 type PrereleaseVersionAttributes struct {
 	Platform *Platform `json:"platform,omitempty"`
 	Version  *string   `json:"version,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // PrereleaseVersionRelationships defines model for PrereleaseVersion.Relationships
@@ -50,6 +52,8 @@ type PrereleaseVersionAttributes struct {
 type PrereleaseVersionRelationships struct {
 	App    *Relationship      `json:"app,omitempty"`
 	Builds *PagedRelationship `json:"builds,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // PrereleaseVersionResponse defines model for PrereleaseVersionResponse.
@@ -79,63 +83,69 @@ type PrereleaseVersionResponseIncluded included
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_prerelease_versions
 type ListPrereleaseVersionsQuery struct {
-	FieldsApps                  []string `url:"fields[apps],omitempty"`
-	FieldsBuilds                []string `url:"fields[builds],omitempty"`
-	FieldsPreReleaseVersions    []string `url:"fields[preReleaseVersions],omitempty"`
-	FilterApp                   []string `url:"filter[app],omitempty"`
-	FilterBuilds                []string `url:"filter[builds],omitempty"`
-	FilterBuildsExpired         []string `url:"filter[builds.expired],omitempty"`
-	FilterBuildsProcessingState []string `url:"filter[builds.processingState],omitempty"`
-	FilterPlatform              []string `url:"filter[platform],omitempty"`
-	FilterVersion               []string `url:"filter[version],omitempty"`
-	Include                     []string `url:"include,omitempty"`
-	Sort                        []string `url:"sort,omitempty"`
-	Limit                       int      `url:"limit,omitempty"`
-	LimitBuilds                 int      `url:"limit[builds],omitempty"`
-	Cursor                      string   `url:"cursor,omitempty"`
+	FieldsApps                  []string     `url:"fields[apps],omitempty"`
+	FieldsBuilds                []string     `url:"fields[builds],omitempty"`
+	FieldsPreReleaseVersions    []string     `url:"fields[preReleaseVersions],omitempty"`
+	FilterApp                   []string     `url:"filter[app],omitempty"`
+	FilterBuilds                []string     `url:"filter[builds],omitempty"`
+	FilterBuildsExpired         []string     `url:"filter[builds.expired],omitempty"`
+	FilterBuildsProcessingState []string     `url:"filter[builds.processingState],omitempty"`
+	FilterPlatform              []string     `url:"filter[platform],omitempty"`
+	FilterVersion               []string     `url:"filter[version],omitempty"`
+	Include                     []string     `url:"include,omitempty"`
+	Sort                        []string     `url:"sort,omitempty"`
+	Limit                       int          `url:"limit,omitempty"`
+	LimitBuilds                 int          `url:"limit[builds],omitempty"`
+	Cursor                      string       `url:"cursor,omitempty"`
+	Query                       QueryEncoder `url:"-"`
 }
 
 // GetPrereleaseVersionQuery defines model for GetPrereleaseVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_prerelease_version_information
 type GetPrereleaseVersionQuery struct {
-	FieldsApps               []string `url:"fields[apps],omitempty"`
-	FieldsBuilds             []string `url:"fields[builds],omitempty"`
-	FieldsPreReleaseVersions []string `url:"fields[preReleaseVersions],omitempty"`
-	Include                  []string `url:"include,omitempty"`
-	LimitBuilds              int      `url:"limit[builds],omitempty"`
+	FieldsApps               []string     `url:"fields[apps],omitempty"`
+	FieldsBuilds             []string     `url:"fields[builds],omitempty"`
+	FieldsPreReleaseVersions []string     `url:"fields[preReleaseVersions],omitempty"`
+	Include                  []string     `url:"include,omitempty"`
+	LimitBuilds              int          `url:"limit[builds],omitempty"`
+	Query                    QueryEncoder `url:"-"`
 }
 
 // GetAppForPrereleaseVersionQuery defines model for GetAppForPrereleaseVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_prerelease_version
 type GetAppForPrereleaseVersionQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListPrereleaseVersionsForAppQuery defines model for ListPrereleaseVersionsForApp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_prerelease_versions_for_an_app
 type ListPrereleaseVersionsForAppQuery struct {
-	FieldsPreReleaseVersions []string `url:"fields[preReleaseVersions],omitempty"`
-	Limit                    int      `url:"limit,omitempty"`
-	Cursor                   string   `url:"cursor,omitempty"`
+	FieldsPreReleaseVersions []string     `url:"fields[preReleaseVersions],omitempty"`
+	Limit                    int          `url:"limit,omitempty"`
+	Cursor                   string       `url:"cursor,omitempty"`
+	Query                    QueryEncoder `url:"-"`
 }
 
 // ListBuildsForPrereleaseVersionQuery defines model for ListBuildsForPrereleaseVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_of_a_prerelease_version
 type ListBuildsForPrereleaseVersionQuery struct {
-	FieldsBuilds []string `url:"fields[builds],omitempty"`
-	Limit        int      `url:"limit,omitempty"`
-	Cursor       string   `url:"cursor,omitempty"`
+	FieldsBuilds []string     `url:"fields[builds],omitempty"`
+	Limit        int          `url:"limit,omitempty"`
+	Cursor       string       `url:"cursor,omitempty"`
+	Query        QueryEncoder `url:"-"`
 }
 
 // GetPrereleaseVersionForBuildQuery defines model for GetPrereleaseVersionForBuild
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_prerelease_version_of_a_build
 type GetPrereleaseVersionForBuildQuery struct {
-	FieldsPreReleaseVersions []string `url:"fields[preReleaseVersions],omitempty"`
+	FieldsPreReleaseVersions []string     `url:"fields[preReleaseVersions],omitempty"`
+	Query                    QueryEncoder `url:"-"`
 }
 
 // ListPrereleaseVersions gets a list of prerelease versions for all apps.
@@ -0,0 +1,362 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+//go:generate go run ../internal/gen/cmd/ascgen -unknown .
+//go:generate go run ../internal/gen/cmd/ascgen -services . -mocks ascmock
+//go:generate go run ../internal/gen/cmd/ascgen -queries .
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// UnknownFields holds the members of a JSON object that its model has no field for, such as
+// attributes added to the API after this version of the package, keyed by name. Every attributes
+// and relationships model has an Unknown field of this type. Unknown members are encoded again
+// along with the known fields of the model, so a resource read from the API can be modified and
+// sent back without losing them:
+//
+//	attrs := asc.AppStoreVersionUpdateRequestAttributes{
+//		Copyright: &copyright,
+//		Unknown:   version.Attributes.Unknown,
+//	}
+type UnknownFields map[string]json.RawMessage
+
+// Keys returns the names of the unknown members, in alphabetical order.
+func (u UnknownFields) Keys() []string {
+	return sortedKeys(u)
+}
+
+var (
+	unknownFieldsType = reflect.TypeOf(UnknownFields(nil))
+	packagePath       = reflect.TypeOf(Client{}).PkgPath()
+
+	// knownFieldsCache caches the result of knownFields by type.
+	knownFieldsCache sync.Map
+)
+
+// unmarshalKeepingUnknown decodes b into v, a pointer to a struct, and stores the members of b
+// that v has no field for in unknown.
+func unmarshalKeepingUnknown(b []byte, v interface{}, unknown *UnknownFields) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+
+	var err error
+
+	*unknown, err = decodeUnknownFields(b, reflect.TypeOf(v).Elem())
+
+	return err
+}
+
+// decodeUnknownFields returns the members of the JSON object b that struct type t has no field
+// for, or nil if there are none.
+func decodeUnknownFields(b []byte, t reflect.Type) (UnknownFields, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(t)
+
+	var unknown UnknownFields
+
+	for name, raw := range members {
+		// encoding/json matches member names to fields case-insensitively.
+		if known[strings.ToLower(name)] {
+			continue
+		}
+
+		if unknown == nil {
+			unknown = UnknownFields{}
+		}
+
+		unknown[name] = raw
+	}
+
+	return unknown, nil
+}
+
+// marshalWithUnknown encodes v, a struct, adding the members of unknown that v has no field for.
+func marshalWithUnknown(v interface{}, unknown UnknownFields) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return b, err
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(b, &members); err != nil {
+		return nil, err
+	}
+
+	known := knownFields(reflect.TypeOf(v))
+
+	for name, raw := range unknown {
+		if !known[strings.ToLower(name)] {
+			members[name] = raw
+		}
+	}
+
+	return json.Marshal(members)
+}
+
+// knownFields returns the lowercased JSON names of the fields of struct type t.
+func knownFields(t reflect.Type) map[string]bool {
+	if known, ok := knownFieldsCache.Load(t); ok {
+		return known.(map[string]bool)
+	}
+
+	known := map[string]bool{}
+
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := jsonFieldName(t.Field(i)); ok && name != "" {
+			known[strings.ToLower(name)] = true
+		}
+	}
+
+	knownFieldsCache.Store(t, known)
+
+	return known
+}
+
+// jsonFieldName returns the JSON name of a struct field, or false if the field is not encoded.
+// Embedded structs without a name in their tag are returned with an empty name.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name != "" {
+		return name, true
+	}
+
+	if field.Anonymous {
+		return "", true
+	}
+
+	return field.Name, field.IsExported()
+}
+
+// StrictMode determines how a Client reacts to responses that do not match the models of this
+// package, which usually means that Apple has changed the API.
+type StrictMode int
+
+const (
+	// StrictModeOff ignores unknown fields and enum values. They are still kept in the Unknown
+	// fields of the models and in the enum fields. This is the default.
+	StrictModeOff StrictMode = iota
+	// StrictModeLog logs a warning for every response with unknown fields or enum values, with the
+	// logger set by SetLogger or the default logger of package slog.
+	StrictModeLog
+	// StrictModeFail returns a *SchemaDriftError for every response with unknown fields or enum
+	// values, along with the decoded response.
+	StrictModeFail
+)
+
+// ErrSchemaDrift is matched by every *SchemaDriftError with errors.Is.
+var ErrSchemaDrift = errors.New("response does not match the schema")
+
+// SchemaDrift is a part of a response that does not match the models of this package.
+type SchemaDrift struct {
+	// Path locates the member in the response document, such as "data[0].attributes.appStoreState".
+	Path string
+	// Enum is the name of the enum type whose value is unknown, such as "AppStoreVersionState".
+	// It is empty if the member itself is unknown.
+	Enum string
+	// Value is the JSON value of the member.
+	Value json.RawMessage
+}
+
+func (d SchemaDrift) String() string {
+	if d.Enum != "" {
+		return fmt.Sprintf("unknown %s value %s at %s", d.Enum, d.Value, d.Path)
+	}
+
+	return "unknown field " + d.Path
+}
+
+// SchemaDriftError is returned in StrictModeFail when a response has fields or enum values that
+// the models of this package do not know about.
+type SchemaDriftError struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the request, relative to the client's base URL.
+	Path string
+	// Drift lists every unknown field and enum value of the response.
+	Drift []SchemaDrift
+}
+
+func (e *SchemaDriftError) Error() string {
+	return fmt.Sprintf("%s %s: %s: %s", e.Method, e.Path, ErrSchemaDrift, e.summary())
+}
+
+func (e *SchemaDriftError) summary() string {
+	drift := make([]string, len(e.Drift))
+	for i, d := range e.Drift {
+		drift[i] = d.String()
+	}
+
+	return strings.Join(drift, "; ")
+}
+
+// Unwrap returns ErrSchemaDrift.
+func (e *SchemaDriftError) Unwrap() error {
+	return ErrSchemaDrift
+}
+
+// SetStrictMode sets how the client reacts to unknown fields and enum values in responses, so that
+// changes to the API are noticed before they cause problems. It must be called before the client
+// is used to make requests.
+func (c *Client) SetStrictMode(mode StrictMode) {
+	c.strictMode = mode
+}
+
+// checkSchema looks for unknown fields and enum values in v, the decoded response to req, and
+// reports them according to the client's strict mode.
+func (c *Client) checkSchema(ctx context.Context, req *http.Request, v interface{}) error {
+	if c.strictMode == StrictModeOff || v == nil {
+		return nil
+	}
+
+	drift := findSchemaDrift(reflect.ValueOf(v), "", nil)
+	if len(drift) == 0 {
+		return nil
+	}
+
+	err := &SchemaDriftError{
+		Method: req.Method,
+		Path:   c.resourcePath(req.URL),
+		Drift:  drift,
+	}
+
+	if c.strictMode == StrictModeFail {
+		return err
+	}
+
+	logger := c.log()
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	logger.LogAttrs(ctx, slog.LevelWarn, "asc schema drift",
+		slog.String("method", err.Method),
+		slog.String("path", err.Path),
+		slog.String("drift", err.summary()),
+	)
+
+	return nil
+}
+
+// findSchemaDrift appends the unknown fields and enum values found in v, located at path, to drift.
+func findSchemaDrift(v reflect.Value, path string, drift []SchemaDrift) []SchemaDrift {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			drift = findSchemaDrift(v.Elem(), path, drift)
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			drift = findSchemaDrift(v.Index(i), path+"["+strconv.Itoa(i)+"]", drift)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		if t.PkgPath() != "" && t.PkgPath() != packagePath {
+			break
+		}
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			if field.Type == unknownFieldsType {
+				drift = appendUnknownFields(drift, path, v.Field(i))
+
+				continue
+			}
+
+			name, ok := jsonFieldName(field)
+			if !field.IsExported() && !field.Anonymous {
+				// Unexported fields, such as the resource held by included wrappers, are not
+				// members of their own.
+				name, ok = "", true
+			}
+
+			if !ok {
+				continue
+			}
+
+			drift = findSchemaDrift(v.Field(i), joinPath(path, name), drift)
+		}
+	case reflect.String:
+		known, ok := knownEnumValues[v.Type()]
+		if ok && v.String() != "" && !slices.Contains(known, v.String()) {
+			drift = append(drift, SchemaDrift{
+				Path:  path,
+				Enum:  v.Type().Name(),
+				Value: json.RawMessage(strconv.Quote(v.String())),
+			})
+		}
+	}
+
+	return drift
+}
+
+func appendUnknownFields(drift []SchemaDrift, path string, unknown reflect.Value) []SchemaDrift {
+	keys := make([]string, 0, unknown.Len())
+	for _, key := range unknown.MapKeys() {
+		keys = append(keys, key.String())
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		drift = append(drift, SchemaDrift{
+			Path:  joinPath(path, key),
+			Value: unknown.MapIndex(reflect.ValueOf(key)).Bytes(),
+		})
+	}
+
+	return drift
+}
+
+func joinPath(path string, name string) string {
+	if path == "" || name == "" {
+		return path + name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,187 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const driftingAppStoreVersion = `{
+	"data": {
+		"id": "1",
+		"type": "appStoreVersions",
+		"attributes": {
+			"appStoreState": "AWAITING_SOMETHING_NEW",
+			"versionString": "1.0",
+			"reviewType": "APP_STORE"
+		},
+		"relationships": {
+			"build": {"data": {"id": "2", "type": "builds"}},
+			"alternativeDistributionPackage": {"links": {"self": "https://example.com"}}
+		}
+	}
+}`
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var version AppStoreVersionResponse
+
+	err := json.Unmarshal([]byte(driftingAppStoreVersion), &version)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", *version.Data.Attributes.VersionString)
+	assert.Equal(t, []string{"reviewType"}, version.Data.Attributes.Unknown.Keys())
+	assert.Equal(t, json.RawMessage(`"APP_STORE"`), version.Data.Attributes.Unknown["reviewType"])
+	assert.NotNil(t, version.Data.Relationships.Build)
+	assert.Equal(t, []string{"alternativeDistributionPackage"}, version.Data.Relationships.Unknown.Keys())
+
+	encoded, err := json.Marshal(version.Data.Attributes)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"appStoreState":"AWAITING_SOMETHING_NEW","versionString":"1.0","reviewType":"APP_STORE"}`, string(encoded))
+
+	var known AppStoreVersionAttributes
+
+	err = json.Unmarshal([]byte(`{"versionString":"1.0"}`), &known)
+	assert.NoError(t, err)
+	assert.Nil(t, known.Unknown)
+}
+
+func TestUnknownFieldsUpdate(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(driftingAppStoreVersion))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	_ = client.SetBaseURL(server.URL + "/")
+
+	version, _, err := client.Apps.GetAppStoreVersion(context.Background(), "1", nil)
+	assert.NoError(t, err)
+
+	copyright := "2026 Sky"
+	_, _, err = client.Apps.UpdateAppStoreVersion(context.Background(), "1", &AppStoreVersionUpdateRequestAttributes{
+		Copyright: &copyright,
+		Unknown:   version.Data.Attributes.Unknown,
+	}, nil)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"data":{"attributes":{"copyright":"2026 Sky","reviewType":"APP_STORE"},"id":"1","type":"appStoreVersions"}}`, string(body))
+}
+
+func TestUnknownFieldsDoNotOverrideKnownFields(t *testing.T) {
+	t.Parallel()
+
+	copyright := "2026 Sky"
+	encoded, err := json.Marshal(AppStoreVersionUpdateRequestAttributes{
+		Copyright: &copyright,
+		Unknown:   UnknownFields{"copyright": json.RawMessage(`"stale"`)},
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"copyright":"2026 Sky"}`, string(encoded))
+}
+
+func TestStrictModeFail(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(driftingAppStoreVersion, http.StatusOK, false)
+	defer server.Close()
+
+	client.SetStrictMode(StrictModeFail)
+
+	version, _, err := client.Apps.GetAppStoreVersion(context.Background(), "1", nil)
+	assert.ErrorIs(t, err, ErrSchemaDrift)
+	assert.Equal(t, "1.0", *version.Data.Attributes.VersionString)
+
+	var driftErr *SchemaDriftError
+
+	assert.True(t, errors.As(err, &driftErr))
+	assert.Equal(t, "GET", driftErr.Method)
+	assert.Equal(t, "appStoreVersions/1", driftErr.Path)
+	assert.Equal(t, []SchemaDrift{
+		{Path: "data.attributes.appStoreState", Enum: "AppStoreVersionState", Value: json.RawMessage(`"AWAITING_SOMETHING_NEW"`)},
+		{Path: "data.attributes.reviewType", Value: json.RawMessage(`"APP_STORE"`)},
+		{Path: "data.relationships.alternativeDistributionPackage", Value: json.RawMessage(`{"links": {"self": "https://example.com"}}`)},
+	}, driftErr.Drift)
+	assert.Contains(t, err.Error(), "unknown AppStoreVersionState value \"AWAITING_SOMETHING_NEW\" at data.attributes.appStoreState")
+}
+
+func TestStrictModeLog(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(driftingAppStoreVersion, http.StatusOK, false)
+	defer server.Close()
+
+	var buf bytes.Buffer
+
+	client.SetLogger(slog.New(slog.NewTextHandler(&buf, nil)))
+	client.SetStrictMode(StrictModeLog)
+
+	_, _, err := client.Apps.GetAppStoreVersion(context.Background(), "1", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "asc schema drift")
+	assert.Contains(t, buf.String(), "unknown field data.attributes.reviewType")
+}
+
+func TestStrictModeIncluded(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{
+		"data": [],
+		"included": [{"type": "apps", "id": "1", "attributes": {"name": "My App", "streamlinedPurchasingEnabled": true}}],
+		"links": {"self": ""}
+	}`, http.StatusOK, false)
+	defer server.Close()
+
+	client.SetStrictMode(StrictModeFail)
+
+	_, _, err := client.Builds.ListBuilds(context.Background(), nil)
+
+	var driftErr *SchemaDriftError
+
+	assert.True(t, errors.As(err, &driftErr))
+	assert.Equal(t, "included[0].attributes.streamlinedPurchasingEnabled", driftErr.Drift[0].Path)
+}
+
+func TestStrictModeKnownSchema(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"1","type":"appStoreVersions","attributes":{"appStoreState":"READY_FOR_SALE","platform":"IOS"}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	client.SetStrictMode(StrictModeFail)
+
+	_, _, err := client.Apps.GetAppStoreVersion(context.Background(), "1", nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,457 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultUploadConcurrency is the number of parts Upload sends at once, unless set with
+// WithUploadConcurrency.
+const DefaultUploadConcurrency = 4
+
+// UploadOption customizes how Upload sends the parts of a file.
+type UploadOption func(*uploadConfig)
+
+type uploadConfig struct {
+	concurrency int
+	retryPolicy RetryPolicy
+	checkpoint  string
+	progress    []func(UploadProgress)
+	summary     *UploadSummary
+}
+
+// WithUploadConcurrency sets the number of parts sent at once. It defaults to
+// DefaultUploadConcurrency.
+func WithUploadConcurrency(n int) UploadOption {
+	return func(cfg *uploadConfig) {
+		if n > 0 {
+			cfg.concurrency = n
+		}
+	}
+}
+
+// WithUploadRetryPolicy sets how failed parts are retried. It defaults to the retry policy of the
+// client. Parts are retried regardless of the IdempotentMethods of the policy, since sending the same
+// part twice has no other effect.
+func WithUploadRetryPolicy(policy RetryPolicy) UploadOption {
+	return func(cfg *uploadConfig) {
+		cfg.retryPolicy = policy
+	}
+}
+
+// WithUploadCheckpoint records the parts that have been uploaded in a file at path, so that if
+// the upload fails or the process stops, calling Upload again with the same operations only sends
+// the missing parts. The file is removed once every part has been uploaded, and ignored if it was
+// recorded for other operations.
+func WithUploadCheckpoint(path string) UploadOption {
+	return func(cfg *uploadConfig) {
+		cfg.checkpoint = path
+	}
+}
+
+// UploadError is returned by Upload when some parts of the file could not be uploaded. Each failed
+// operation can be passed to Upload again to retry it.
+type UploadError struct {
+	// Failed lists the failed operations and their errors, in the order of the operations passed
+	// to Upload. Operations that were not attempted because the context was canceled are included
+	// with the error of the context.
+	Failed []UploadOperationError
+}
+
+func (e *UploadError) Error() string {
+	msgs := make([]string, len(e.Failed))
+	for i, failed := range e.Failed {
+		msgs[i] = failed.Error()
+	}
+
+	return fmt.Sprintf("%d upload operations failed: %s", len(e.Failed), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the errors of the failed operations, as UploadOperationError values.
+func (e *UploadError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, failed := range e.Failed {
+		errs[i] = failed
+	}
+
+	return errs
+}
+
+// Operations returns the failed operations, which can be passed to Upload again.
+func (e *UploadError) Operations() []UploadOperation {
+	ops := make([]UploadOperation, len(e.Failed))
+	for i, failed := range e.Failed {
+		ops[i] = failed.Operation
+	}
+
+	return ops
+}
+
+// Upload sends each part of the file described by the operations of an asset reservation to App
+// Store Connect, several at a time. Parts are read from the file with ReadAt, so the file is never
+// read whole into memory, and each part is retried on network errors and server errors.
+//
+// If the context is canceled, Upload stops sending new parts and waits for the parts in flight to
+// finish. If any part fails, the returned error is an *UploadError listing every failed operation.
+// In dry-run mode, the operations are captured in the client's Plan instead of being sent.
+func (c *Client) Upload(ctx context.Context, ops []UploadOperation, file io.ReaderAt, opts ...UploadOption) error {
+	cfg := uploadConfig{
+		concurrency: DefaultUploadConcurrency,
+		retryPolicy: c.retryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if c.plan != nil {
+		c.captureUpload(ops)
+
+		return nil
+	}
+
+	checkpoint, err := loadUploadCheckpoint(cfg.checkpoint, ops)
+	if err != nil {
+		return err
+	}
+
+	tracker := newUploadTracker(ops, cfg.progress)
+
+	// Use a plain HTTP client for CDN uploads - no auth headers needed
+	// The CDN URL already contains signed parameters
+	plainClient := c.uploadClient
+	if plainClient == nil {
+		plainClient = &http.Client{}
+	}
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		failed = map[int]error{}
+		sem    = make(chan struct{}, cfg.concurrency)
+	)
+
+	if cfg.summary != nil {
+		defer func() {
+			*cfg.summary = tracker.finish(failed)
+		}()
+	}
+
+	fail := func(i int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		failed[i] = err
+	}
+
+	for i := range ops {
+		if checkpoint.done(ops[i]) {
+			tracker.skip(i)
+
+			continue
+		}
+
+		if ctx.Err() != nil {
+			fail(i, ctx.Err())
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			fail(i, ctx.Err())
+
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := c.uploadPart(ctx, plainClient, cfg.retryPolicy, tracker, i, ops[i], file); err != nil {
+				fail(i, err)
+			} else if err := checkpoint.complete(ops[i]); err != nil {
+				fail(i, fmt.Errorf("saving upload checkpoint: %w", err))
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return newUploadError(ops, failed)
+	}
+
+	return checkpoint.remove()
+}
+
+// SetUploadClient sets the HTTP client that Upload sends the parts of assets with. The parts are
+// sent to signed URLs that need no authentication, so the client should not be the authenticated
+// client passed to NewClient. By default, a plain http.Client is used.
+func (c *Client) SetUploadClient(client *http.Client) {
+	c.uploadClient = client
+}
+
+func newUploadError(ops []UploadOperation, failed map[int]error) *UploadError {
+	indexes := make([]int, 0, len(failed))
+	for i := range failed {
+		indexes = append(indexes, i)
+	}
+
+	sort.Ints(indexes)
+
+	uploadErr := &UploadError{}
+	for _, i := range indexes {
+		uploadErr.Failed = append(uploadErr.Failed, UploadOperationError{Operation: ops[i], Err: failed[i]})
+	}
+
+	return uploadErr
+}
+
+// uploadPart sends one part of the file, retrying it according to the policy.
+func (c *Client) uploadPart(ctx context.Context, client *http.Client, policy RetryPolicy, tracker *uploadTracker, i int, op UploadOperation, file io.ReaderAt) (err error) {
+	attempts := 0
+
+	defer func() {
+		tracker.done(i, attempts, err)
+	}()
+
+	chunk, err := op.chunk(file)
+	if err != nil {
+		return err
+	}
+
+	b := policy.backOff()
+	start := time.Now()
+
+	for {
+		attempts++
+
+		req, err := op.request(ctx, chunk)
+		if err != nil {
+			return err
+		}
+
+		req.Body = tracker.body(i, attempts, req.Body)
+
+		policy.IdempotentMethods = []string{req.Method}
+
+		attemptStart := time.Now()
+		resp, err := client.Do(req)
+
+		c.logUpload(ctx, req, resp, err, time.Since(attemptStart))
+
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			discardBody(resp)
+
+			return nil
+		}
+
+		retry, minimum := policy.shouldRetry(req, resp, err)
+
+		if err == nil {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			err = errors.New("upload failed with status " + resp.Status + ": " + string(body))
+		}
+
+		if !retry {
+			return err
+		}
+
+		delay, ok := policy.retryDelay(b, attempts, start, minimum)
+		if !ok {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// uploadCheckpoint records the operations of an upload that have completed.
+type uploadCheckpoint struct {
+	path string
+
+	mu        sync.Mutex
+	state     uploadCheckpointState
+	completed map[string]bool
+}
+
+type uploadCheckpointState struct {
+	// Operations identifies the operations of the upload.
+	Operations string `json:"operations"`
+	// Completed lists the parts that have been uploaded, as "offset:length".
+	Completed []string `json:"completed"`
+}
+
+// loadUploadCheckpoint reads the checkpoint at path, if any. An empty path records nothing.
+func loadUploadCheckpoint(path string, ops []UploadOperation) (*uploadCheckpoint, error) {
+	checkpoint := &uploadCheckpoint{
+		path:      path,
+		state:     uploadCheckpointState{Operations: operationsFingerprint(ops)},
+		completed: map[string]bool{},
+	}
+
+	if path == "" {
+		return checkpoint, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return checkpoint, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var state uploadCheckpointState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("reading upload checkpoint %s: %w", path, err)
+	}
+
+	if state.Operations != checkpoint.state.Operations {
+		return checkpoint, nil
+	}
+
+	checkpoint.state = state
+	for _, key := range state.Completed {
+		checkpoint.completed[key] = true
+	}
+
+	return checkpoint, nil
+}
+
+func (c *uploadCheckpoint) done(op UploadOperation) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.completed[operationKey(op)]
+}
+
+func (c *uploadCheckpoint) complete(op UploadOperation) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := operationKey(op)
+	c.completed[key] = true
+	c.state.Completed = append(c.state.Completed, key)
+
+	if c.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(c.state)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return os.Rename(tmp.Name(), c.path)
+}
+
+func (c *uploadCheckpoint) remove() error {
+	if c.path == "" {
+		return nil
+	}
+
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func operationKey(op UploadOperation) string {
+	var offset, length int
+	if op.Offset != nil {
+		offset = *op.Offset
+	}
+
+	if op.Length != nil {
+		length = *op.Length
+	}
+
+	return fmt.Sprintf("%d:%d", offset, length)
+}
+
+// operationsFingerprint identifies a set of operations by their destinations and bounds.
+func operationsFingerprint(ops []UploadOperation) string {
+	h := sha256.New()
+
+	for _, op := range ops {
+		var method, url string
+		if op.Method != nil {
+			method = *op.Method
+		}
+
+		if op.URL != nil {
+			url = *op.URL
+		}
+
+		fmt.Fprintf(h, "%s %s %s\n", method, url, operationKey(op))
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
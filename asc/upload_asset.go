@@ -0,0 +1,541 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Delivery states of an asset, as reported by AppMediaAssetState.State.
+const (
+	AssetStateAwaitingUpload = "AWAITING_UPLOAD"
+	AssetStateUploadComplete = "UPLOAD_COMPLETE"
+	AssetStateComplete       = "COMPLETE"
+	AssetStateFailed         = "FAILED"
+)
+
+// DefaultAssetPollInterval is the default interval between two checks of the delivery state of an
+// uploaded asset.
+const DefaultAssetPollInterval = 2 * time.Second
+
+// DefaultAssetDeliveryTimeout is how long UploadAsset waits by default for App Store Connect to
+// process an uploaded asset.
+const DefaultAssetDeliveryTimeout = 15 * time.Minute
+
+var (
+	// ErrAssetDeliveryFailed happens when App Store Connect fails to process an uploaded asset.
+	ErrAssetDeliveryFailed = errors.New("asset delivery failed")
+	// ErrAssetDeliveryTimeout happens when App Store Connect does not finish processing an uploaded
+	// asset within the delivery timeout.
+	ErrAssetDeliveryTimeout = errors.New("timed out waiting for asset delivery")
+)
+
+// AssetDeliveryError is returned by UploadAsset when App Store Connect fails to process an
+// uploaded asset. It wraps ErrAssetDeliveryFailed.
+type AssetDeliveryError struct {
+	// Kind is the type of the asset, such as "appScreenshots".
+	Kind string
+	// ID is the ID of the asset.
+	ID string
+	// Errors are the errors reported by App Store Connect.
+	Errors []AppMediaStateError
+}
+
+func (e *AssetDeliveryError) Error() string {
+	reasons := make([]string, 0, len(e.Errors))
+
+	for _, stateErr := range e.Errors {
+		var code, description string
+		if stateErr.Code != nil {
+			code = *stateErr.Code
+		}
+
+		if stateErr.Description != nil {
+			description = *stateErr.Description
+		}
+
+		switch {
+		case code != "" && description != "":
+			reasons = append(reasons, code+": "+description)
+		case code != "":
+			reasons = append(reasons, code)
+		case description != "":
+			reasons = append(reasons, description)
+		}
+	}
+
+	msg := fmt.Sprintf("%s %s: %s", e.Kind, e.ID, ErrAssetDeliveryFailed)
+	if len(reasons) > 0 {
+		msg += " (" + strings.Join(reasons, "; ") + ")"
+	}
+
+	return msg
+}
+
+func (e *AssetDeliveryError) Unwrap() error {
+	return ErrAssetDeliveryFailed
+}
+
+// AssetDeliveryTimeoutError is returned by UploadAsset when App Store Connect does not finish
+// processing an uploaded asset within the delivery timeout. It wraps ErrAssetDeliveryTimeout.
+type AssetDeliveryTimeoutError struct {
+	// Kind is the type of the asset, such as "appScreenshots".
+	Kind string
+	// ID is the ID of the asset.
+	ID string
+	// State is the last delivery state of the asset, such as "UPLOAD_COMPLETE".
+	State string
+	// Timeout is how long UploadAsset waited for the asset.
+	Timeout time.Duration
+	// Asset is the asset as last fetched, such as an *AppScreenshot. It can be deleted, or waited
+	// for again.
+	Asset interface{}
+}
+
+func (e *AssetDeliveryTimeoutError) Error() string {
+	return fmt.Sprintf("%s %s: %s after %s (%s)", e.Kind, e.ID, ErrAssetDeliveryTimeout, e.Timeout, e.State)
+}
+
+func (e *AssetDeliveryTimeoutError) Unwrap() error {
+	return ErrAssetDeliveryTimeout
+}
+
+// HasCode reports whether App Store Connect reported an error with the given code, such as
+// "IMAGE_INCORRECT_DIMENSIONS".
+func (e *AssetDeliveryError) HasCode(code string) bool {
+	for _, stateErr := range e.Errors {
+		if stateErr.Code != nil && *stateErr.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// assetState describes the upload of an asset resource.
+type assetState struct {
+	id         string
+	operations []UploadOperation
+	delivery   *AppMediaAssetState
+}
+
+// AssetKind describes how to reserve, commit and check an asset resource of type T, such as an
+// AppScreenshot. Use one of the AssetKind variables of this package with UploadAsset.
+type AssetKind[T any] struct {
+	name    string
+	reserve func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*T, error)
+	commit  func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*T, error)
+	get     func(ctx context.Context, c *Client, id string) (*T, error)
+	state   func(*T) assetState
+}
+
+// String returns the type of the asset resources, such as "appScreenshots".
+func (k AssetKind[T]) String() string {
+	return k.name
+}
+
+// AppScreenshotAsset uploads an AppScreenshot to the app screenshot set whose ID is given to
+// UploadAsset. Screenshots of custom product pages are uploaded to the sets of their localization.
+var AppScreenshotAsset = AssetKind[AppScreenshot]{
+	name: "appScreenshots",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*AppScreenshot, error) {
+		res, _, err := c.Apps.CreateAppScreenshot(ctx, fileName, fileSize, parentID)
+
+		return assetData(res, err, func(res *AppScreenshotResponse) *AppScreenshot { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*AppScreenshot, error) {
+		res, _, err := c.Apps.CommitAppScreenshot(ctx, id, Bool(true), &checksum)
+
+		return assetData(res, err, func(res *AppScreenshotResponse) *AppScreenshot { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*AppScreenshot, error) {
+		res, _, err := c.Apps.GetAppScreenshot(ctx, id, nil)
+
+		return assetData(res, err, func(res *AppScreenshotResponse) *AppScreenshot { return &res.Data })
+	},
+	state: func(a *AppScreenshot) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+// AppPreviewAsset uploads an AppPreview to the app preview set whose ID is given to UploadAsset.
+// Previews of custom product pages are uploaded to the sets of their localization.
+var AppPreviewAsset = AssetKind[AppPreview]{
+	name: "appPreviews",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*AppPreview, error) {
+		res, _, err := c.Apps.CreateAppPreview(ctx, fileName, fileSize, parentID)
+
+		return assetData(res, err, func(res *AppPreviewResponse) *AppPreview { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*AppPreview, error) {
+		res, _, err := c.Apps.CommitAppPreview(ctx, id, Bool(true), &checksum, cfg.previewFrameTimeCode)
+
+		return assetData(res, err, func(res *AppPreviewResponse) *AppPreview { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*AppPreview, error) {
+		res, _, err := c.Apps.GetAppPreview(ctx, id, nil)
+
+		return assetData(res, err, func(res *AppPreviewResponse) *AppPreview { return &res.Data })
+	},
+	state: func(a *AppPreview) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+// AppStoreReviewAttachmentAsset uploads an AppStoreReviewAttachment to the app store review detail
+// whose ID is given to UploadAsset.
+var AppStoreReviewAttachmentAsset = AssetKind[AppStoreReviewAttachment]{
+	name: "appStoreReviewAttachments",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*AppStoreReviewAttachment, error) {
+		res, _, err := c.Submission.CreateAttachment(ctx, fileName, fileSize, parentID)
+
+		return assetData(res, err, func(res *AppStoreReviewAttachmentResponse) *AppStoreReviewAttachment { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*AppStoreReviewAttachment, error) {
+		res, _, err := c.Submission.CommitAttachment(ctx, id, Bool(true), &checksum)
+
+		return assetData(res, err, func(res *AppStoreReviewAttachmentResponse) *AppStoreReviewAttachment { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*AppStoreReviewAttachment, error) {
+		res, _, err := c.Submission.GetAttachment(ctx, id, nil)
+
+		return assetData(res, err, func(res *AppStoreReviewAttachmentResponse) *AppStoreReviewAttachment { return &res.Data })
+	},
+	state: func(a *AppStoreReviewAttachment) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+// RoutingAppCoverageAsset uploads the RoutingAppCoverage of the app store version whose ID is given
+// to UploadAsset.
+var RoutingAppCoverageAsset = AssetKind[RoutingAppCoverage]{
+	name: "routingAppCoverages",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*RoutingAppCoverage, error) {
+		res, _, err := c.Apps.CreateRoutingAppCoverage(ctx, fileName, fileSize, parentID)
+
+		return assetData(res, err, func(res *RoutingAppCoverageResponse) *RoutingAppCoverage { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*RoutingAppCoverage, error) {
+		res, _, err := c.Apps.CommitRoutingAppCoverage(ctx, id, Bool(true), &checksum)
+
+		return assetData(res, err, func(res *RoutingAppCoverageResponse) *RoutingAppCoverage { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*RoutingAppCoverage, error) {
+		res, _, err := c.Apps.GetRoutingAppCoverage(ctx, id, nil)
+
+		return assetData(res, err, func(res *RoutingAppCoverageResponse) *RoutingAppCoverage { return &res.Data })
+	},
+	state: func(a *RoutingAppCoverage) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+// GameCenterAchievementImageAsset uploads a GameCenterAchievementImage to the achievement
+// localization whose ID is given to UploadAsset. App Store Connect takes no checksum for it.
+var GameCenterAchievementImageAsset = AssetKind[GameCenterAchievementImage]{
+	name: "gameCenterAchievementImages",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*GameCenterAchievementImage, error) {
+		res, _, err := c.GameCenter.CreateGameCenterAchievementImage(ctx, GameCenterAchievementImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: int(fileSize),
+		}, parentID)
+
+		return assetData(res, err, func(res *GameCenterAchievementImageResponse) *GameCenterAchievementImage { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*GameCenterAchievementImage, error) {
+		res, _, err := c.GameCenter.UpdateGameCenterAchievementImage(ctx, id, &GameCenterAchievementImageUpdateRequestAttributes{
+			Uploaded: Bool(true),
+		})
+
+		return assetData(res, err, func(res *GameCenterAchievementImageResponse) *GameCenterAchievementImage { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*GameCenterAchievementImage, error) {
+		res, _, err := c.GameCenter.GetGameCenterAchievementImage(ctx, id, nil)
+
+		return assetData(res, err, func(res *GameCenterAchievementImageResponse) *GameCenterAchievementImage { return &res.Data })
+	},
+	state: func(a *GameCenterAchievementImage) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+// GameCenterLeaderboardImageAsset uploads a GameCenterLeaderboardImage to the leaderboard
+// localization whose ID is given to UploadAsset. App Store Connect takes no checksum for it.
+var GameCenterLeaderboardImageAsset = AssetKind[GameCenterLeaderboardImage]{
+	name: "gameCenterLeaderboardImages",
+	reserve: func(ctx context.Context, c *Client, parentID string, fileName string, fileSize int64) (*GameCenterLeaderboardImage, error) {
+		res, _, err := c.GameCenter.CreateGameCenterLeaderboardImage(ctx, GameCenterLeaderboardImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: int(fileSize),
+		}, parentID)
+
+		return assetData(res, err, func(res *GameCenterLeaderboardImageResponse) *GameCenterLeaderboardImage { return &res.Data })
+	},
+	commit: func(ctx context.Context, c *Client, id string, checksum string, cfg *assetConfig) (*GameCenterLeaderboardImage, error) {
+		res, _, err := c.GameCenter.UpdateGameCenterLeaderboardImage(ctx, id, &GameCenterLeaderboardImageUpdateRequestAttributes{
+			Uploaded: Bool(true),
+		})
+
+		return assetData(res, err, func(res *GameCenterLeaderboardImageResponse) *GameCenterLeaderboardImage { return &res.Data })
+	},
+	get: func(ctx context.Context, c *Client, id string) (*GameCenterLeaderboardImage, error) {
+		res, _, err := c.GameCenter.GetGameCenterLeaderboardImage(ctx, id, nil)
+
+		return assetData(res, err, func(res *GameCenterLeaderboardImageResponse) *GameCenterLeaderboardImage { return &res.Data })
+	},
+	state: func(a *GameCenterLeaderboardImage) assetState {
+		if a.Attributes == nil {
+			return assetState{id: a.ID}
+		}
+
+		return assetState{id: a.ID, operations: a.Attributes.UploadOperations, delivery: a.Attributes.AssetDeliveryState}
+	},
+}
+
+func assetData[R any, T any](res *R, err error, data func(*R) *T) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return data(res), nil
+}
+
+// AssetOption configures UploadAsset.
+type AssetOption func(*assetConfig)
+
+type assetConfig struct {
+	pollInterval         time.Duration
+	deliveryTimeout      time.Duration
+	uploadOptions        []UploadOption
+	previewFrameTimeCode *string
+	noWait               bool
+}
+
+// WithAssetPollInterval sets the interval between two checks of the delivery state of the asset
+// after it is committed. It defaults to DefaultAssetPollInterval.
+func WithAssetPollInterval(interval time.Duration) AssetOption {
+	return func(cfg *assetConfig) {
+		if interval > 0 {
+			cfg.pollInterval = interval
+		}
+	}
+}
+
+// WithAssetDeliveryTimeout sets how long UploadAsset waits for App Store Connect to process the
+// asset after it is committed. It defaults to DefaultAssetDeliveryTimeout. A timeout of zero or
+// less waits until the context is done.
+func WithAssetDeliveryTimeout(timeout time.Duration) AssetOption {
+	return func(cfg *assetConfig) {
+		cfg.deliveryTimeout = timeout
+	}
+}
+
+// WithAssetUploadOptions passes options, such as WithUploadProgress, to the upload of the parts of
+// the asset.
+func WithAssetUploadOptions(opts ...UploadOption) AssetOption {
+	return func(cfg *assetConfig) {
+		cfg.uploadOptions = append(cfg.uploadOptions, opts...)
+	}
+}
+
+// WithPreviewFrameTimeCode sets the time code of the frame of an app preview used as its poster
+// image, such as "00:00:05:00". It is ignored by other assets.
+func WithPreviewFrameTimeCode(timeCode string) AssetOption {
+	return func(cfg *assetConfig) {
+		cfg.previewFrameTimeCode = &timeCode
+	}
+}
+
+// WithoutAssetDeliveryWait makes UploadAsset return as soon as the asset is committed, without
+// waiting for App Store Connect to process it.
+func WithoutAssetDeliveryWait() AssetOption {
+	return func(cfg *assetConfig) {
+		cfg.noWait = true
+	}
+}
+
+// UploadAsset uploads the size bytes of file as an asset of the given kind, attached to the
+// resource whose ID is parentID, and returns the asset once App Store Connect has processed it.
+//
+// It reserves the asset, uploads its parts with Upload, commits it with the MD5 checksum of the
+// file, then polls its delivery state until it is COMPLETE or FAILED. If App Store Connect fails to
+// process the asset, the returned error is an *AssetDeliveryError, and if it does not process it
+// within the delivery timeout, an *AssetDeliveryTimeoutError. If UploadAsset fails after the
+// asset was reserved, the reserved asset is returned with the error so that it can be deleted or
+// committed again.
+//
+// In dry-run mode, the reservation, upload and commit of the asset are captured in the client's
+// Plan, and the synthesized asset is returned without waiting for its delivery.
+//
+//	screenshot, err := asc.UploadAsset(ctx, client, asc.AppScreenshotAsset, setID, "home.png", file, size)
+func UploadAsset[T any](ctx context.Context, c *Client, kind AssetKind[T], parentID string, fileName string, file io.ReaderAt, size int64, opts ...AssetOption) (*T, error) {
+	cfg := assetConfig{
+		pollInterval:    DefaultAssetPollInterval,
+		deliveryTimeout: DefaultAssetDeliveryTimeout,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	checksum, err := md5Checksum(io.NewSectionReader(file, 0, size))
+	if err != nil {
+		return nil, fmt.Errorf("computing the checksum of %s: %w", fileName, err)
+	}
+
+	asset, err := kind.reserve(ctx, c, parentID, fileName, size)
+	if err != nil {
+		return nil, err
+	}
+
+	state := kind.state(asset)
+
+	if c.plan != nil && len(state.operations) == 0 {
+		// A synthesized reservation has no upload operations, so plan the upload of the whole file.
+		c.plan.add(PlannedChange{
+			Action:       ChangeUpload,
+			Method:       http.MethodPut,
+			Path:         fileName,
+			ResourceType: kind.name,
+			ResourceID:   state.id,
+			Length:       size,
+		})
+	}
+
+	if err := c.Upload(ctx, state.operations, file, cfg.uploadOptions...); err != nil {
+		return asset, err
+	}
+
+	committed, err := kind.commit(ctx, c, state.id, checksum, &cfg)
+	if err != nil {
+		return asset, err
+	}
+
+	if cfg.noWait || c.plan != nil {
+		return committed, nil
+	}
+
+	return waitForAssetDelivery(ctx, c, kind, committed, cfg.pollInterval, cfg.deliveryTimeout)
+}
+
+// UploadAssetFile uploads the file at path with UploadAsset, named after the base name of path.
+func UploadAssetFile[T any](ctx context.Context, c *Client, kind AssetKind[T], parentID string, path string, opts ...AssetOption) (*T, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return UploadAsset(ctx, c, kind, parentID, filepath.Base(path), f, stat.Size(), opts...)
+}
+
+// waitForAssetDelivery polls the asset until its delivery state is COMPLETE or FAILED, or until the
+// timeout has passed, unless it is zero or less.
+func waitForAssetDelivery[T any](ctx context.Context, c *Client, kind AssetKind[T], asset *T, interval time.Duration, timeout time.Duration) (*T, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var expired <-chan time.Time
+
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		expired = timer.C
+	}
+
+	for {
+		state := kind.state(asset)
+
+		if state.delivery != nil && state.delivery.State != nil {
+			switch *state.delivery.State {
+			case AssetStateComplete:
+				return asset, nil
+			case AssetStateFailed:
+				return asset, &AssetDeliveryError{Kind: kind.name, ID: state.id, Errors: state.delivery.Errors}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return asset, ctx.Err()
+		case <-expired:
+			timeoutErr := &AssetDeliveryTimeoutError{Kind: kind.name, ID: state.id, Timeout: timeout, Asset: asset}
+			if state.delivery != nil && state.delivery.State != nil {
+				timeoutErr.State = *state.delivery.State
+			}
+
+			return asset, timeoutErr
+		case <-ticker.C:
+		}
+
+		next, err := kind.get(ctx, c, state.id)
+		if err != nil {
+			return asset, err
+		}
+
+		asset = next
+	}
+}
+
+// md5Checksum returns the hexadecimal MD5 checksum of the contents of r.
+func md5Checksum(r io.Reader) (string, error) {
+	h := md5.New() // nolint: gosec
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
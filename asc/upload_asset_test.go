@@ -0,0 +1,244 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint: gosec
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// assetServer fakes the App Store Connect endpoints of an asset type, and the storage its parts
+// are uploaded to. The delivery state of the asset moves through states after it is committed.
+type assetServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	kind      string
+	received  []byte
+	committed map[string]interface{}
+	states    []string
+	errors    []AppMediaStateError
+	requests  []string
+}
+
+func newAssetServer(kind string, states ...string) (*Client, *assetServer) {
+	s := &assetServer{kind: kind, states: states}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.URL.Path == "/upload":
+			body, _ := io.ReadAll(r.Body)
+			s.received = append(s.received, body...)
+		case r.Method == http.MethodPost:
+			var req struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			size := int(req.Data.Attributes["fileSize"].(float64))
+
+			s.write(w, AppMediaAssetState{State: String(AssetStateAwaitingUpload)}, []UploadOperation{
+				{Method: String("PUT"), URL: String(s.URL + "/upload"), Offset: Int(0), Length: Int(size)},
+			})
+		case r.Method == http.MethodPatch:
+			var req struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			s.committed = req.Data.Attributes
+
+			s.write(w, AppMediaAssetState{State: String(AssetStateUploadComplete)}, nil)
+		case r.Method == http.MethodGet:
+			state := s.states[0]
+			if len(s.states) > 1 {
+				s.states = s.states[1:]
+			}
+
+			delivery := AppMediaAssetState{State: String(state)}
+			if state == AssetStateFailed {
+				delivery.Errors = s.errors
+			}
+
+			s.write(w, delivery, nil)
+		}
+	}))
+
+	base, _ := url.Parse(s.URL + "/v1/")
+	client := NewClient(s.Client())
+	client.baseURL = base
+
+	return client, s
+}
+
+func (s *assetServer) write(w http.ResponseWriter, state AppMediaAssetState, ops []UploadOperation) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":   "1",
+			"type": s.kind,
+			"attributes": map[string]interface{}{
+				"assetDeliveryState": state,
+				"uploadOperations":   ops,
+			},
+		},
+	})
+}
+
+func TestUploadAsset(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAssetServer("appScreenshots", AssetStateUploadComplete, AssetStateComplete)
+	defer server.Close()
+
+	contents := bytes.Repeat([]byte("screenshot"), 10)
+
+	var summary UploadSummary
+
+	screenshot, err := UploadAsset(context.Background(), client, AppScreenshotAsset, "set", "home.png",
+		bytes.NewReader(contents), int64(len(contents)),
+		WithAssetPollInterval(time.Millisecond), WithAssetUploadOptions(WithUploadSummary(&summary)))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", screenshot.ID)
+	assert.Equal(t, AssetStateComplete, *screenshot.Attributes.AssetDeliveryState.State)
+	assert.Equal(t, contents, server.received)
+	assert.Equal(t, fmt.Sprintf("%x", md5.Sum(contents)), server.committed["sourceFileChecksum"]) // nolint: gosec
+	assert.Equal(t, true, server.committed["uploaded"])
+	assert.Equal(t, 1, summary.Parts)
+	assert.Equal(t, []string{
+		"POST /v1/appScreenshots",
+		"PUT /upload",
+		"PATCH /v1/appScreenshots/1",
+		"GET /v1/appScreenshots/1",
+		"GET /v1/appScreenshots/1",
+	}, server.requests)
+}
+
+func TestUploadAssetDeliveryFailed(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAssetServer("gameCenterAchievementImages", AssetStateFailed)
+	defer server.Close()
+
+	server.errors = []AppMediaStateError{
+		{Code: String("IMAGE_INCORRECT_DIMENSIONS"), Description: String("The image is 10x10.")},
+	}
+
+	contents := []byte("image")
+
+	image, err := UploadAsset(context.Background(), client, GameCenterAchievementImageAsset, "loc", "badge.png",
+		bytes.NewReader(contents), int64(len(contents)), WithAssetPollInterval(time.Millisecond))
+	assert.ErrorIs(t, err, ErrAssetDeliveryFailed)
+	assert.Equal(t, "1", image.ID)
+	assert.Equal(t, map[string]interface{}{"uploaded": true}, server.committed)
+
+	var deliveryErr *AssetDeliveryError
+
+	assert.True(t, errors.As(err, &deliveryErr))
+	assert.True(t, deliveryErr.HasCode("IMAGE_INCORRECT_DIMENSIONS"))
+	assert.False(t, deliveryErr.HasCode("IMAGE_TOO_LARGE"))
+	assert.Equal(t, "gameCenterAchievementImages 1: asset delivery failed (IMAGE_INCORRECT_DIMENSIONS: The image is 10x10.)", err.Error())
+}
+
+func TestUploadAssetFile(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAssetServer("appPreviews", AssetStateComplete)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "preview.mov")
+	contents := []byte("preview")
+	assert.NoError(t, os.WriteFile(path, contents, 0o600))
+
+	preview, err := UploadAssetFile(context.Background(), client, AppPreviewAsset, "set", path,
+		WithPreviewFrameTimeCode("00:00:01:00"), WithoutAssetDeliveryWait())
+	assert.NoError(t, err)
+	assert.Equal(t, AssetStateUploadComplete, *preview.Attributes.AssetDeliveryState.State)
+	assert.Equal(t, contents, server.received)
+	assert.Equal(t, "00:00:01:00", server.committed["previewFrameTimeCode"])
+	assert.Equal(t, "appPreviews", AppPreviewAsset.String())
+
+	_, err = UploadAssetFile(context.Background(), client, AppPreviewAsset, "set", filepath.Join(t.TempDir(), "missing.mov"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestUploadAssetCanceledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAssetServer("routingAppCoverages", AssetStateUploadComplete)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	contents := []byte("{}")
+
+	coverage, err := UploadAsset(ctx, client, RoutingAppCoverageAsset, "version", "coverage.geojson",
+		bytes.NewReader(contents), int64(len(contents)), WithAssetPollInterval(time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "1", coverage.ID)
+}
+
+func TestUploadAssetDeliveryTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, server := newAssetServer("appScreenshots", AssetStateUploadComplete)
+	defer server.Close()
+
+	contents := []byte("image")
+
+	screenshot, err := UploadAsset(context.Background(), client, AppScreenshotAsset, "set", "home.png",
+		bytes.NewReader(contents), int64(len(contents)),
+		WithAssetPollInterval(time.Millisecond), WithAssetDeliveryTimeout(20*time.Millisecond))
+	assert.ErrorIs(t, err, ErrAssetDeliveryTimeout)
+	assert.Equal(t, "1", screenshot.ID)
+
+	var timeoutErr *AssetDeliveryTimeoutError
+
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "1", timeoutErr.ID)
+	assert.Equal(t, AssetStateUploadComplete, timeoutErr.State)
+	assert.Equal(t, screenshot, timeoutErr.Asset)
+	assert.Equal(t, "appScreenshots 1: timed out waiting for asset delivery after 20ms (UPLOAD_COMPLETE)", err.Error())
+}
@@ -21,12 +21,11 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
-	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
-	"sync"
 )
 
 // ErrMissingChunkBounds happens when the UploadOperation object is missing an offset or length used to mark
@@ -36,6 +35,10 @@ var ErrMissingChunkBounds = errors.New("could not establish bounds of upload ope
 // ErrMissingUploadDestination happens when the UploadOperation object is missing a URL or HTTP method.
 var ErrMissingUploadDestination = errors.New("could not establish destination of upload operation")
 
+// ErrChunkOutOfBounds happens when the part of the file described by an UploadOperation extends
+// past the end of the file.
+var ErrChunkOutOfBounds = errors.New("upload operation extends past the end of the file")
+
 // UploadOperation defines model for UploadOperation.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/uploadoperation
@@ -67,42 +70,49 @@ func (e UploadOperationError) Error() string {
 	return e.Err.Error()
 }
 
-// chunk returns the bytes in the file from the given offset and with the given length.
-func (op *UploadOperation) chunk(f io.ReadSeeker) (*bytes.Buffer, error) {
+// Unwrap returns the error of the operation.
+func (e UploadOperationError) Unwrap() error {
+	return e.Err
+}
+
+// chunk returns the section of the file from the given offset and with the given length.
+func (op *UploadOperation) chunk(f io.ReaderAt) (*io.SectionReader, error) {
 	if op.Offset == nil || op.Length == nil {
 		return nil, ErrMissingChunkBounds
 	}
 
-	_, err := f.Seek(int64(*op.Offset), 0)
-	if err != nil {
-		return nil, err
-	}
-
-	data := make([]byte, *op.Length)
+	// Make sure the whole section can be read, so a truncated file is not sent as a short part. A
+	// ReaderAt may return io.EOF along with the last byte of the file.
+	if *op.Length > 0 {
+		n, err := f.ReadAt(make([]byte, 1), int64(*op.Offset+*op.Length-1))
+		if n == 1 && errors.Is(err, io.EOF) {
+			err = nil
+		}
 
-	_, err = f.Read(data)
-	if err != nil {
-		return nil, err
+		if err != nil {
+			return nil, fmt.Errorf("%w: %d bytes at offset %d: %w", ErrChunkOutOfBounds, *op.Length, *op.Offset, err)
+		}
 	}
 
-	return bytes.NewBuffer(data), nil
+	return io.NewSectionReader(f, int64(*op.Offset), int64(*op.Length)), nil
 }
 
-// request creates a new http.request instance from the given UploadOperation and buffer.
-func (op *UploadOperation) request(ctx context.Context, data *bytes.Buffer) (*http.Request, error) {
+// request creates a new http.request instance from the given UploadOperation and section of the file.
+func (op *UploadOperation) request(ctx context.Context, chunk *io.SectionReader) (*http.Request, error) {
 	if op.Method == nil || op.URL == nil {
 		return nil, ErrMissingUploadDestination
 	}
 
-	// Create request with the data bytes directly
-	bodyBytes := data.Bytes()
-	req, err := http.NewRequestWithContext(ctx, *op.Method, *op.URL, bytes.NewReader(bodyBytes))
+	req, err := http.NewRequestWithContext(ctx, *op.Method, *op.URL, io.NewSectionReader(chunk, 0, chunk.Size()))
 	if err != nil {
 		return nil, err
 	}
 
 	// Set Content-Length explicitly - required for CDN uploads
-	req.ContentLength = int64(len(bodyBytes))
+	req.ContentLength = chunk.Size()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(io.NewSectionReader(chunk, 0, chunk.Size())), nil
+	}
 
 	// Set headers from upload operation
 	if op.RequestHeaders != nil {
@@ -117,82 +127,3 @@ func (op *UploadOperation) request(ctx context.Context, data *bytes.Buffer) (*ht
 
 	return req, nil
 }
-
-// Upload takes a file path and concurrently uploads each part of the file to App Store Connect.
-func (c *Client) Upload(ctx context.Context, ops []UploadOperation, file io.ReadSeeker) error {
-	var wg sync.WaitGroup
-
-	// Use buffered channel to avoid blocking
-	errs := make(chan UploadOperationError, len(ops))
-
-	// Create a single HTTP client for all chunk uploads to reuse connection pool
-	// Use a plain HTTP client for CDN uploads - no auth headers needed
-	// The CDN URL already contains signed parameters
-	plainClient := &http.Client{}
-
-	for i, operation := range ops {
-		chunk, err := operation.chunk(file)
-		if err != nil {
-			errs <- UploadOperationError{
-				Operation: operation,
-				Err:       err,
-			}
-
-			continue
-		}
-
-		wg.Add(1)
-
-		go c.uploadChunk(ctx, ops[i], chunk, plainClient, errs, &wg)
-	}
-
-	// Wait for all uploads to complete, then close the channel
-	go func() {
-		wg.Wait()
-		close(errs)
-	}()
-
-	// Collect all errors
-	var firstErr error
-	for err := range errs {
-		if firstErr == nil {
-			firstErr = err
-		}
-	}
-
-	return firstErr
-}
-
-func (c *Client) uploadChunk(ctx context.Context, op UploadOperation, chunk *bytes.Buffer, client *http.Client, errs chan<- UploadOperationError, wg *sync.WaitGroup) {
-	defer wg.Done()
-
-	req, err := op.request(ctx, chunk)
-	if err != nil {
-		errs <- UploadOperationError{
-			Operation: op,
-			Err:       err,
-		}
-
-		return
-	}
-
-	resp, err := client.Do(req)
-	if err != nil {
-		errs <- UploadOperationError{
-			Operation: op,
-			Err:       err,
-		}
-		return
-	}
-	defer resp.Body.Close()
-
-	// Check for non-2xx status codes
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		errs <- UploadOperationError{
-			Operation: op,
-			Err:       errors.New("upload failed with status " + resp.Status + ": " + string(body)),
-		}
-		return
-	}
-}
@@ -155,6 +155,39 @@ func TestUploadOperationChunk(t *testing.T) {
 	assert.EqualValues(t, written, *op.Length)
 }
 
+// eofReaderAt returns io.EOF along with the last bytes of its data, as io.ReaderAt allows.
+type eofReaderAt []byte
+
+func (r eofReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= int64(len(r)) {
+		return 0, io.EOF
+	}
+
+	n := copy(p, r[off:])
+	if off+int64(n) == int64(len(r)) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+func TestUploadOperationChunkEOF(t *testing.T) {
+	t.Parallel()
+
+	file := eofReaderAt("0123456789")
+
+	op := UploadOperation{Offset: Int(5), Length: Int(5)}
+
+	chunk, err := op.chunk(file)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, chunk.Size())
+
+	op.Length = Int(6)
+
+	_, err = op.chunk(file)
+	assert.ErrorIs(t, err, ErrChunkOutOfBounds)
+}
+
 func TestUploadOperationUploadError_InvalidOperation(t *testing.T) {
 	t.Parallel()
 
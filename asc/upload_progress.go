@@ -0,0 +1,344 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// uploadProgressInterval is the minimum time between two reports of the progress of a part.
+const uploadProgressInterval = 200 * time.Millisecond
+
+// UploadProgress reports the progress of an Upload.
+type UploadProgress struct {
+	// Part is the index of the operation being reported in the operations passed to Upload.
+	Part int
+	// Operation is the operation being reported.
+	Operation UploadOperation
+	// PartBytes is the number of bytes of the part sent so far by its current attempt.
+	PartBytes int64
+	// PartSize is the length of the part.
+	PartSize int64
+	// Attempt is the attempt of the part being reported, starting at 1.
+	Attempt int
+	// Done reports whether the part has been uploaded successfully.
+	Done bool
+	// PartDuration is the time spent on the part so far, including its retries.
+	PartDuration time.Duration
+
+	// BytesSent is the number of bytes sent so far across all parts, including parts uploaded
+	// before resuming from a checkpoint.
+	BytesSent int64
+	// TotalBytes is the size of all parts.
+	TotalBytes int64
+	// Elapsed is the time since Upload was called.
+	Elapsed time.Duration
+	// Remaining estimates the time left, from the throughput so far. It is zero until some bytes
+	// have been sent.
+	Remaining time.Duration
+}
+
+// Percent returns the overall progress of the upload, between 0 and 100.
+func (p UploadProgress) Percent() float64 {
+	if p.TotalBytes == 0 {
+		return 100
+	}
+
+	return float64(p.BytesSent) / float64(p.TotalBytes) * 100
+}
+
+// String formats the progress for logs, such as "part 3: 12.0 MiB / 40.0 MiB (30.0%), 18s remaining".
+func (p UploadProgress) String() string {
+	s := fmt.Sprintf("part %d: %s / %s (%.1f%%)", p.Part, formatBytes(p.BytesSent), formatBytes(p.TotalBytes), p.Percent())
+
+	if p.Done {
+		s += fmt.Sprintf(", part done in %s", p.PartDuration.Round(time.Millisecond))
+	}
+
+	if p.Remaining > 0 {
+		s += fmt.Sprintf(", %s remaining", p.Remaining.Round(time.Second))
+	}
+
+	return s
+}
+
+// UploadSummary describes a finished Upload.
+type UploadSummary struct {
+	// Parts is the number of parts uploaded successfully by this call.
+	Parts int
+	// Skipped is the number of parts skipped because a checkpoint recorded them as uploaded.
+	Skipped int
+	// Failed is the number of parts that could not be uploaded, or whose upload could not be saved
+	// in the checkpoint. They are the parts listed by the UploadError returned by Upload.
+	Failed int
+	// Retries is the number of attempts that were retried, across all parts.
+	Retries int
+	// Bytes is the number of bytes of the parts uploaded successfully by this call.
+	Bytes int64
+	// Duration is the time spent by Upload.
+	Duration time.Duration
+}
+
+// Throughput returns the number of bytes uploaded per second.
+func (s UploadSummary) Throughput() float64 {
+	if s.Duration <= 0 {
+		return 0
+	}
+
+	return float64(s.Bytes) / s.Duration.Seconds()
+}
+
+// String formats the summary for logs, such as "uploaded 4 parts (40.0 MiB) in 20s at 2.0 MiB/s,
+// 1 retries, 0 failed".
+func (s UploadSummary) String() string {
+	str := fmt.Sprintf("uploaded %d parts (%s) in %s at %s/s, %d retries, %d failed",
+		s.Parts, formatBytes(s.Bytes), s.Duration.Round(time.Millisecond), formatBytes(int64(s.Throughput())), s.Retries, s.Failed)
+
+	if s.Skipped > 0 {
+		str += fmt.Sprintf(", %d skipped", s.Skipped)
+	}
+
+	return str
+}
+
+// WithUploadProgress calls fn as the parts of the file are sent, at least when each part is done.
+// It may be called concurrently for different parts, and should return quickly.
+func WithUploadProgress(fn func(UploadProgress)) UploadOption {
+	return func(cfg *uploadConfig) {
+		cfg.progress = append(cfg.progress, fn)
+	}
+}
+
+// WithUploadProgressChannel sends the progress of the upload to ch, like WithUploadProgress. Sends
+// block, so ch must be read until Upload returns. Upload does not close ch.
+func WithUploadProgressChannel(ch chan<- UploadProgress) UploadOption {
+	return WithUploadProgress(func(p UploadProgress) {
+		ch <- p
+	})
+}
+
+// WithUploadSummary stores a summary of the upload in summary when Upload returns, whether it
+// succeeded or not.
+func WithUploadSummary(summary *UploadSummary) UploadOption {
+	return func(cfg *uploadConfig) {
+		cfg.summary = summary
+	}
+}
+
+// uploadTracker follows the progress of the parts of an upload.
+type uploadTracker struct {
+	progress []func(UploadProgress)
+	ops      []UploadOperation
+	start    time.Time
+
+	mu         sync.Mutex
+	sent       []int64
+	uploaded   []bool
+	partStart  []time.Time
+	lastReport []time.Time
+	total      int64
+	resumed    int64
+	summary    UploadSummary
+}
+
+func newUploadTracker(ops []UploadOperation, progress []func(UploadProgress)) *uploadTracker {
+	t := &uploadTracker{
+		progress:   progress,
+		ops:        ops,
+		start:      time.Now(),
+		sent:       make([]int64, len(ops)),
+		uploaded:   make([]bool, len(ops)),
+		partStart:  make([]time.Time, len(ops)),
+		lastReport: make([]time.Time, len(ops)),
+	}
+
+	for _, op := range ops {
+		if op.Length != nil {
+			t.total += int64(*op.Length)
+		}
+	}
+
+	return t
+}
+
+// skip records a part recorded as uploaded by a checkpoint.
+func (t *uploadTracker) skip(i int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sent[i] = partSize(t.ops[i])
+	t.resumed += t.sent[i]
+	t.summary.Skipped++
+}
+
+// body wraps the body of an attempt to upload part i, so the bytes read from it are reported.
+func (t *uploadTracker) body(i int, attempt int, body io.ReadCloser) io.ReadCloser {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if attempt == 1 {
+		t.partStart[i] = time.Now()
+	} else {
+		t.summary.Retries++
+	}
+
+	t.sent[i] = 0
+
+	return &progressReader{ReadCloser: body, tracker: t, part: i, attempt: attempt}
+}
+
+// read records n bytes of part i sent by an attempt.
+func (t *uploadTracker) read(i int, attempt int, n int) {
+	t.mu.Lock()
+
+	t.sent[i] += int64(n)
+
+	now := time.Now()
+	if len(t.progress) == 0 || now.Sub(t.lastReport[i]) < uploadProgressInterval {
+		t.mu.Unlock()
+
+		return
+	}
+
+	t.lastReport[i] = now
+	p := t.snapshot(i, attempt, false, now)
+	t.mu.Unlock()
+
+	t.report(p)
+}
+
+// done records the outcome of part i.
+func (t *uploadTracker) done(i int, attempt int, err error) {
+	t.mu.Lock()
+
+	if err != nil {
+		t.sent[i] = 0
+		t.mu.Unlock()
+
+		return
+	}
+
+	t.sent[i] = partSize(t.ops[i])
+	t.uploaded[i] = true
+	t.summary.Parts++
+	t.summary.Bytes += t.sent[i]
+	p := t.snapshot(i, attempt, true, time.Now())
+	t.mu.Unlock()
+
+	t.report(p)
+}
+
+// finish returns the summary of the upload, given the parts that Upload reports as failed. These
+// include parts that were never sent and parts whose checkpoint could not be saved.
+func (t *uploadTracker) finish(failed map[int]error) UploadSummary {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.summary.Failed = len(failed)
+
+	for i := range failed {
+		if t.uploaded[i] {
+			t.summary.Parts--
+			t.summary.Bytes -= partSize(t.ops[i])
+		}
+	}
+
+	t.summary.Duration = time.Since(t.start)
+
+	return t.summary
+}
+
+func (t *uploadTracker) snapshot(i int, attempt int, done bool, now time.Time) UploadProgress {
+	p := UploadProgress{
+		Part:         i,
+		Operation:    t.ops[i],
+		PartBytes:    t.sent[i],
+		PartSize:     partSize(t.ops[i]),
+		Attempt:      attempt,
+		Done:         done,
+		PartDuration: now.Sub(t.partStart[i]),
+		TotalBytes:   t.total,
+		Elapsed:      now.Sub(t.start),
+	}
+
+	for _, sent := range t.sent {
+		p.BytesSent += sent
+	}
+
+	if sent := p.BytesSent - t.resumed; sent > 0 {
+		rate := float64(sent) / p.Elapsed.Seconds()
+		p.Remaining = time.Duration(float64(p.TotalBytes-p.BytesSent) / rate * float64(time.Second))
+	}
+
+	return p
+}
+
+func (t *uploadTracker) report(p UploadProgress) {
+	for _, fn := range t.progress {
+		fn(p)
+	}
+}
+
+// progressReader reports the bytes read from the body of an upload attempt.
+type progressReader struct {
+	io.ReadCloser
+
+	tracker *uploadTracker
+	part    int
+	attempt int
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 {
+		r.tracker.read(r.part, r.attempt, n)
+	}
+
+	return n, err
+}
+
+func partSize(op UploadOperation) int64 {
+	if op.Length == nil {
+		return 0
+	}
+
+	return int64(*op.Length)
+}
+
+// formatBytes formats a number of bytes with a binary unit, such as "1.5 MiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,384 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// uploadServer stores the parts it receives by offset, failing the requests that fail returns a
+// status for.
+type uploadServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	parts    map[string][]byte
+	attempts map[string]int
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	fail     func(offset string, attempt int) int
+}
+
+func newUploadServer() *uploadServer {
+	s := &uploadServer{
+		parts:    map[string][]byte{},
+		attempts: map[string]int{},
+		fail:     func(string, int) int { return 0 },
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+
+		for {
+			peak := s.peak.Load()
+			if n <= peak || s.peak.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		offset := r.URL.Query().Get("offset")
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.attempts[offset]++
+
+		if status := s.fail(offset, s.attempts[offset]); status != 0 {
+			w.WriteHeader(status)
+
+			return
+		}
+
+		s.parts[offset] = body
+	}))
+
+	return s
+}
+
+func (s *uploadServer) operations(size int, partSize int) []UploadOperation {
+	var ops []UploadOperation
+
+	for offset := 0; offset < size; offset += partSize {
+		length := partSize
+		if offset+length > size {
+			length = size - offset
+		}
+
+		ops = append(ops, UploadOperation{
+			Method: String("PUT"),
+			URL:    String(s.URL + "/?offset=" + strconv.Itoa(offset)),
+			Offset: Int(offset),
+			Length: Int(length),
+		})
+	}
+
+	return ops
+}
+
+func (s *uploadServer) received() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []byte
+
+	for offset := 0; ; {
+		part, ok := s.parts[strconv.Itoa(offset)]
+		if !ok {
+			return out
+		}
+
+		out = append(out, part...)
+		offset += len(part)
+	}
+}
+
+func TestUploadRetriesParts(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	server.fail = func(offset string, attempt int) int {
+		if offset == "20" && attempt < 3 {
+			return http.StatusServiceUnavailable
+		}
+
+		return 0
+	}
+
+	contents := bytes.Repeat([]byte("0123456789"), 10)
+	client := NewClient(nil)
+
+	err := client.Upload(context.Background(), server.operations(len(contents), 10), bytes.NewReader(contents),
+		WithUploadConcurrency(2), WithUploadRetryPolicy(fastRetryPolicy()))
+	assert.NoError(t, err)
+	assert.Equal(t, contents, server.received())
+	assert.Equal(t, 3, server.attempts["20"])
+	assert.LessOrEqual(t, server.peak.Load(), int32(2))
+}
+
+func TestUploadReportsEveryFailedOperation(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	server.fail = func(offset string, attempt int) int {
+		if offset == "10" || offset == "30" {
+			return http.StatusForbidden
+		}
+
+		return 0
+	}
+
+	contents := bytes.Repeat([]byte("0123456789"), 4)
+	ops := server.operations(len(contents), 10)
+	ops = append(ops, UploadOperation{Method: String("PUT"), URL: String(server.URL), Offset: Int(35), Length: Int(10)})
+
+	err := NewClient(nil).Upload(context.Background(), ops, bytes.NewReader(contents))
+
+	var uploadErr *UploadError
+
+	assert.True(t, errors.As(err, &uploadErr))
+	assert.Equal(t, []UploadOperation{ops[1], ops[3], ops[4]}, uploadErr.Operations())
+	assert.ErrorIs(t, uploadErr.Failed[2].Err, ErrChunkOutOfBounds)
+	assert.Contains(t, err.Error(), "3 upload operations failed")
+
+	var opErr UploadOperationError
+
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, ops[1], opErr.Operation)
+}
+
+func TestUploadResumesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	server.fail = func(offset string, attempt int) int {
+		if offset == "50" && attempt == 1 {
+			return http.StatusBadRequest
+		}
+
+		return 0
+	}
+
+	contents := bytes.Repeat([]byte("0123456789"), 8)
+	ops := server.operations(len(contents), 10)
+	checkpoint := filepath.Join(t.TempDir(), "upload.json")
+	client := NewClient(nil)
+
+	err := client.Upload(context.Background(), ops, bytes.NewReader(contents), WithUploadCheckpoint(checkpoint))
+	assert.Error(t, err)
+	assert.FileExists(t, checkpoint)
+
+	err = client.Upload(context.Background(), ops, bytes.NewReader(contents), WithUploadCheckpoint(checkpoint))
+	assert.NoError(t, err)
+	assert.Equal(t, contents, server.received())
+	assert.Equal(t, 2, server.attempts["50"])
+	assert.Equal(t, 1, server.attempts["0"])
+
+	_, err = os.Stat(checkpoint)
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestUploadCanceled(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	contents := bytes.Repeat([]byte("0123456789"), 3)
+	ops := server.operations(len(contents), 10)
+
+	err := NewClient(nil).Upload(ctx, ops, bytes.NewReader(contents))
+
+	var uploadErr *UploadError
+
+	assert.True(t, errors.As(err, &uploadErr))
+	assert.Len(t, uploadErr.Failed, 3)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, server.received())
+}
+
+func TestUploadProgress(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	server.fail = func(offset string, attempt int) int {
+		if offset == "10" && attempt == 1 {
+			return http.StatusServiceUnavailable
+		}
+
+		return 0
+	}
+
+	contents := bytes.Repeat([]byte("0123456789"), 4)
+	ops := server.operations(len(contents), 10)
+	progress := make(chan UploadProgress)
+	done := map[int]UploadProgress{}
+	finished := make(chan struct{})
+
+	go func() {
+		defer close(finished)
+
+		for p := range progress {
+			if p.Done {
+				done[p.Part] = p
+			}
+		}
+	}()
+
+	var summary UploadSummary
+
+	err := NewClient(nil).Upload(context.Background(), ops, bytes.NewReader(contents),
+		WithUploadConcurrency(1), WithUploadRetryPolicy(fastRetryPolicy()),
+		WithUploadProgressChannel(progress), WithUploadSummary(&summary))
+	close(progress)
+	<-finished
+
+	assert.NoError(t, err)
+	assert.Len(t, done, 4)
+	assert.Equal(t, int64(40), done[3].BytesSent)
+	assert.Equal(t, int64(40), done[3].TotalBytes)
+	assert.Equal(t, 100.0, done[3].Percent())
+	assert.Equal(t, time.Duration(0), done[3].Remaining)
+	assert.Equal(t, int64(20), done[1].BytesSent)
+	assert.Equal(t, int64(10), done[1].PartBytes)
+	assert.Equal(t, 2, done[1].Attempt)
+	assert.Equal(t, ops[1], done[1].Operation)
+	assert.Positive(t, done[1].PartDuration)
+	assert.Contains(t, done[3].String(), "part 3: 40 B / 40 B (100.0%)")
+
+	assert.Equal(t, 4, summary.Parts)
+	assert.Equal(t, 1, summary.Retries)
+	assert.Equal(t, 0, summary.Failed)
+	assert.Equal(t, int64(40), summary.Bytes)
+	assert.Positive(t, summary.Duration)
+	assert.Positive(t, summary.Throughput())
+	assert.Contains(t, summary.String(), "uploaded 4 parts (40 B)")
+}
+
+func TestUploadSummaryCountsSkippedAndFailedParts(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	server.fail = func(offset string, attempt int) int {
+		if offset == "20" && attempt == 1 {
+			return http.StatusForbidden
+		}
+
+		return 0
+	}
+
+	contents := bytes.Repeat([]byte("0123456789"), 3)
+	ops := server.operations(len(contents), 10)
+	checkpoint := filepath.Join(t.TempDir(), "upload.json")
+	client := NewClient(nil)
+
+	var summary UploadSummary
+
+	err := client.Upload(context.Background(), ops, bytes.NewReader(contents),
+		WithUploadCheckpoint(checkpoint), WithUploadSummary(&summary))
+	assert.Error(t, err)
+	assert.Equal(t, UploadSummary{Parts: 2, Failed: 1, Bytes: 20, Duration: summary.Duration}, summary)
+
+	var last UploadProgress
+
+	err = client.Upload(context.Background(), ops, bytes.NewReader(contents), WithUploadCheckpoint(checkpoint),
+		WithUploadSummary(&summary), WithUploadProgress(func(p UploadProgress) { last = p }))
+	assert.NoError(t, err)
+	assert.Equal(t, UploadSummary{Parts: 1, Skipped: 2, Bytes: 10, Duration: summary.Duration}, summary)
+	assert.Equal(t, 2, last.Part)
+	assert.Equal(t, int64(30), last.BytesSent)
+	assert.Contains(t, summary.String(), "2 skipped")
+}
+
+func TestUploadSummaryMatchesUploadError(t *testing.T) {
+	t.Parallel()
+
+	server := newUploadServer()
+	defer server.Close()
+
+	contents := bytes.Repeat([]byte("0123456789"), 3)
+	ops := server.operations(len(contents), 10)
+	client := NewClient(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var summary UploadSummary
+
+	err := client.Upload(ctx, ops, bytes.NewReader(contents), WithUploadSummary(&summary))
+
+	var uploadErr *UploadError
+	if assert.ErrorAs(t, err, &uploadErr) {
+		assert.Len(t, uploadErr.Failed, 3)
+	}
+
+	assert.Equal(t, UploadSummary{Failed: 3, Duration: summary.Duration}, summary)
+
+	checkpoint := filepath.Join(t.TempDir(), "missing", "upload.json")
+
+	err = client.Upload(context.Background(), ops, bytes.NewReader(contents),
+		WithUploadCheckpoint(checkpoint), WithUploadSummary(&summary))
+	if assert.ErrorAs(t, err, &uploadErr) {
+		assert.Len(t, uploadErr.Failed, 3)
+		assert.ErrorContains(t, uploadErr, "saving upload checkpoint")
+	}
+
+	assert.Equal(t, UploadSummary{Failed: 3, Duration: summary.Duration}, summary)
+}
+
+func TestFormatBytes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "40.0 MiB", formatBytes(40<<20))
+	assert.Equal(t, "2.0 GiB", formatBytes(2<<30))
+}
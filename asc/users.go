@@ -88,6 +88,8 @@ type UserAttributes struct {
 	ProvisioningAllowed *bool      `json:"provisioningAllowed,omitempty"`
 	Roles               []UserRole `json:"roles,omitempty"`
 	Username            *string    `json:"username,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // UserRelationships defines model for User.Relationships
@@ -95,6 +97,8 @@ type UserAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/user/relationships
 type UserRelationships struct {
 	VisibleApps *PagedRelationship `json:"visibleApps,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // UserUpdateRequest defines model for UserUpdateRequest.
@@ -114,6 +118,8 @@ type UserUpdateRequestAttributes struct {
 	AllAppsVisible      *bool      `json:"allAppsVisible,omitempty"`
 	ProvisioningAllowed *bool      `json:"provisioningAllowed,omitempty"`
 	Roles               []UserRole `json:"roles,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // userUpdateRequestRelationships are relationships for UserUpdateRequest
@@ -155,44 +161,48 @@ type UserVisibleAppsLinkagesResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_users
 type ListUsersQuery struct {
-	FieldsApps        []string `url:"fields[apps],omitempty"`
-	FieldsUsers       []string `url:"fields[users],omitempty"`
-	FilterRoles       []string `url:"filter[roles],omitempty"`
-	FilterVisibleApps []string `url:"filter[visibleApps],omitempty"`
-	FilterUsername    []string `url:"filter[username],omitempty"`
-	Limit             int      `url:"limit,omitempty"`
-	LimitVisibleApps  int      `url:"limit[visibleApps],omitempty"`
-	Include           []string `url:"include,omitempty"`
-	Sort              []string `url:"sort,omitempty"`
-	Cursor            string   `url:"cursor,omitempty"`
+	FieldsApps        []string     `url:"fields[apps],omitempty"`
+	FieldsUsers       []string     `url:"fields[users],omitempty"`
+	FilterRoles       []string     `url:"filter[roles],omitempty"`
+	FilterVisibleApps []string     `url:"filter[visibleApps],omitempty"`
+	FilterUsername    []string     `url:"filter[username],omitempty"`
+	Limit             int          `url:"limit,omitempty"`
+	LimitVisibleApps  int          `url:"limit[visibleApps],omitempty"`
+	Include           []string     `url:"include,omitempty"`
+	Sort              []string     `url:"sort,omitempty"`
+	Cursor            string       `url:"cursor,omitempty"`
+	Query             QueryEncoder `url:"-"`
 }
 
 // GetUserQuery is query options for GetUser
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_user_information
 type GetUserQuery struct {
-	FieldsApps       []string `url:"fields[apps],omitempty"`
-	FieldsUsers      []string `url:"fields[users],omitempty"`
-	Include          []string `url:"include,omitempty"`
-	Limit            int      `url:"limit,omitempty"`
-	LimitVisibleApps int      `url:"limit[visibleApps],omitempty"`
+	FieldsApps       []string     `url:"fields[apps],omitempty"`
+	FieldsUsers      []string     `url:"fields[users],omitempty"`
+	Include          []string     `url:"include,omitempty"`
+	Limit            int          `url:"limit,omitempty"`
+	LimitVisibleApps int          `url:"limit[visibleApps],omitempty"`
+	Query            QueryEncoder `url:"-"`
 }
 
 // ListVisibleAppsQuery is query options for ListVisibleAppsForUser
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_apps_visible_to_a_user
 type ListVisibleAppsQuery struct {
-	FieldsApps []string `url:"fields[apps],omitempty"`
-	Limit      int      `url:"limit,omitempty"`
-	Cursor     string   `url:"cursor,omitempty"`
+	FieldsApps []string     `url:"fields[apps],omitempty"`
+	Limit      int          `url:"limit,omitempty"`
+	Cursor     string       `url:"cursor,omitempty"`
+	Query      QueryEncoder `url:"-"`
 }
 
 // ListVisibleAppsByResourceIDQuery is query options for ListVisibleAppsByResourceIDForUser
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_visible_app_resource_ids_for_a_user
 type ListVisibleAppsByResourceIDQuery struct {
-	Limit  int    `url:"limit,omitempty"`
-	Cursor string `url:"cursor,omitempty"`
+	Limit  int          `url:"limit,omitempty"`
+	Cursor string       `url:"cursor,omitempty"`
+	Query  QueryEncoder `url:"-"`
 }
 
 // ListUsers gets a list of the users on your team.
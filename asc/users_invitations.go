@@ -47,6 +47,8 @@ type UserInvitationAttributes struct {
 	LastName            *string    `json:"lastName,omitempty"`
 	ProvisioningAllowed *bool      `json:"provisioningAllowed,omitempty"`
 	Roles               []UserRole `json:"roles,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // UserInvitationRelationships defines model for UserInvitation.Relationships
@@ -54,6 +56,8 @@ type UserInvitationAttributes struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/userinvitation/relationships
 type UserInvitationRelationships struct {
 	VisibleApps *PagedRelationship `json:"visibleApps,omitempty"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // userInvitationCreateRequest defines model for userInvitationCreateRequest.
@@ -75,6 +79,8 @@ type UserInvitationCreateRequestAttributes struct {
 	LastName            string     `json:"lastName"`
 	ProvisioningAllowed *bool      `json:"provisioningAllowed,omitempty"`
 	Roles               []UserRole `json:"roles"`
+
+	Unknown UnknownFields `json:"-"`
 }
 
 // userInvitationCreateRequestRelationships are relationships for UserInvitationCreateRequest
@@ -107,26 +113,28 @@ type UserInvitationsResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_invited_users
 type ListInvitationsQuery struct {
-	FieldsApps            []string `url:"fields[apps],omitempty"`
-	FieldsUserInvitations []string `url:"fields[userInvitations],omitempty"`
-	FilterRoles           []string `url:"filter[roles],omitempty"`
-	FilterEmail           []string `url:"filter[email],omitempty"`
-	FilterVisibleApps     []string `url:"filter[visibleApps],omitempty"`
-	Include               []string `url:"include,omitempty"`
-	Limit                 int      `url:"limit,omitempty"`
-	LimitVisibleApps      int      `url:"limit[visibleApps],omitempty"`
-	Sort                  []string `url:"sort,omitempty"`
-	Cursor                string   `url:"cursor,omitempty"`
+	FieldsApps            []string     `url:"fields[apps],omitempty"`
+	FieldsUserInvitations []string     `url:"fields[userInvitations],omitempty"`
+	FilterRoles           []string     `url:"filter[roles],omitempty"`
+	FilterEmail           []string     `url:"filter[email],omitempty"`
+	FilterVisibleApps     []string     `url:"filter[visibleApps],omitempty"`
+	Include               []string     `url:"include,omitempty"`
+	Limit                 int          `url:"limit,omitempty"`
+	LimitVisibleApps      int          `url:"limit[visibleApps],omitempty"`
+	Sort                  []string     `url:"sort,omitempty"`
+	Cursor                string       `url:"cursor,omitempty"`
+	Query                 QueryEncoder `url:"-"`
 }
 
 // GetInvitationQuery is query options for GetInvitation
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_user_invitation_information
 type GetInvitationQuery struct {
-	FieldsApps            []string `url:"fields[apps],omitempty"`
-	FieldsUserInvitations []string `url:"fields[userInvitations],omitempty"`
-	Include               []string `url:"include,omitempty"`
-	LimitVisibleApps      int      `url:"limit[visibleApps],omitempty"`
+	FieldsApps            []string     `url:"fields[apps],omitempty"`
+	FieldsUserInvitations []string     `url:"fields[userInvitations],omitempty"`
+	Include               []string     `url:"include,omitempty"`
+	LimitVisibleApps      int          `url:"limit[visibleApps],omitempty"`
+	Query                 QueryEncoder `url:"-"`
 }
 
 // ListInvitations gets a list of pending invitations to join your team.
@@ -0,0 +1,119 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"net/url"
+	"strings"
+)
+
+// APIVersion is a major version of the App Store Connect API. Each version is served from its own
+// root on the API host, and newer resources are only available from the version that introduced them.
+type APIVersion string
+
+const (
+	// APIv1 is the version most resources are served from, and the default for paths without a version.
+	APIv1 APIVersion = "v1"
+	// APIv2 serves newer resources such as in-app purchases, app availabilities and Game Center leaderboards.
+	APIv2 APIVersion = "v2"
+	// APIv3 serves newer resources such as app price points.
+	APIv3 APIVersion = "v3"
+)
+
+// Path returns path prefixed with the version, such as "v2/inAppPurchases". Requests made by the
+// client with such a path are sent to the root of that version rather than to v1.
+func (v APIVersion) Path(path string) string {
+	return string(v) + "/" + strings.TrimPrefix(path, "/")
+}
+
+// SetBaseURL sets the root of the API host that requests are sent to, such as a proxy or test
+// server. The root is shared by every API version, so with a root of "https://proxy.example.com/asc/",
+// v1 resources are requested from "https://proxy.example.com/asc/v1/" and v2 resources from
+// "https://proxy.example.com/asc/v2/". It defaults to "https://api.appstoreconnect.apple.com/".
+func (c *Client) SetBaseURL(root string) error {
+	u, err := url.Parse(root)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	c.baseURL = u.ResolveReference(&url.URL{Path: APIv1.Path("")})
+
+	return nil
+}
+
+// BaseURL returns the URL that requests for resources of the given version are resolved against.
+func (c *Client) BaseURL(version APIVersion) *url.URL {
+	if version == APIv1 || version == "" {
+		u := *c.baseURL
+
+		return &u
+	}
+
+	return c.rootURL().ResolveReference(&url.URL{Path: version.Path("")})
+}
+
+// rootURL returns the root of the API host, which the base URL of every version is relative to.
+func (c *Client) rootURL() *url.URL {
+	return c.baseURL.ResolveReference(&url.URL{Path: "../"})
+}
+
+// resolve returns the URL of a request path. Paths that start with a version, such as
+// "v2/inAppPurchases", are resolved against the root of that version, and other paths against v1.
+// A leading slash is ignored, so that paths never escape the base URL set with SetBaseURL.
+func (c *Client) resolve(rel *url.URL) *url.URL {
+	if rel.IsAbs() || rel.Host != "" {
+		return rel
+	}
+
+	if strings.HasPrefix(rel.Path, "/") {
+		trimmed := *rel
+		trimmed.Path = strings.TrimLeft(rel.Path, "/")
+		trimmed.RawPath = strings.TrimLeft(rel.RawPath, "/")
+		rel = &trimmed
+	}
+
+	if _, ok := pathVersion(rel.Path); ok {
+		return c.rootURL().ResolveReference(rel)
+	}
+
+	return c.baseURL.ResolveReference(rel)
+}
+
+// pathVersion returns the version a relative path starts with, if any, such as v2 for
+// "v2/inAppPurchases". A version is the letter v followed by digits.
+func pathVersion(path string) (APIVersion, bool) {
+	segment, _, found := strings.Cut(path, "/")
+	if !found || len(segment) < 2 || segment[0] != 'v' {
+		return "", false
+	}
+
+	for _, r := range segment[1:] {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+
+	return APIVersion(segment), true
+}
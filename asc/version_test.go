@@ -0,0 +1,104 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseURL(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+	assert.Equal(t, "https://api.appstoreconnect.apple.com/v1/", client.BaseURL(APIv1).String())
+	assert.Equal(t, "https://api.appstoreconnect.apple.com/v2/", client.BaseURL(APIv2).String())
+	assert.Equal(t, "https://api.appstoreconnect.apple.com/v3/", client.BaseURL(APIv3).String())
+
+	assert.NoError(t, client.SetBaseURL("https://proxy.example.com/asc"))
+	assert.Equal(t, "https://proxy.example.com/asc/v1/", client.BaseURL(APIv1).String())
+	assert.Equal(t, "https://proxy.example.com/asc/v3/", client.BaseURL(APIv3).String())
+
+	assert.Error(t, client.SetBaseURL(":"))
+}
+
+func TestResolveVersionedPaths(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+		fmt.Fprintln(w, "{}")
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	assert.NoError(t, client.SetBaseURL(server.URL+"/proxy/"))
+
+	ctx := context.Background()
+
+	_, err := client.get(ctx, "apps/1", nil, nil)
+	assert.NoError(t, err)
+	_, err = client.get(ctx, APIv2.Path("inAppPurchases/1"), url.Values{"include": {"app"}}, nil)
+	assert.NoError(t, err)
+	_, err = client.Do(ctx, "GET", "v3/appPricePoints/1", nil, nil, nil)
+	assert.NoError(t, err)
+	_, _, err = client.CustomerReviews.GetCustomerReviewsForApp(ctx, "1", nil)
+	assert.NoError(t, err)
+	_, _, err = client.GameCenter.GetGameCenterLeaderboardVersion(ctx, "1", nil)
+	assert.NoError(t, err)
+	_, err = client.get(ctx, "/v2/inAppPurchases/2", nil, nil)
+	assert.NoError(t, err)
+	_, err = client.Do(ctx, "GET", "/apps/2", nil, nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"/proxy/v1/apps/1",
+		"/proxy/v2/inAppPurchases/1?include=app",
+		"/proxy/v3/appPricePoints/1",
+		"/proxy/v1/apps/1/customerReviews",
+		"/proxy/v2/gameCenterLeaderboardVersions/1",
+		"/proxy/v2/inAppPurchases/2",
+		"/proxy/v1/apps/2",
+	}, paths)
+}
+
+func TestPathVersion(t *testing.T) {
+	t.Parallel()
+
+	v, ok := pathVersion("v2/inAppPurchases")
+	assert.True(t, ok)
+	assert.Equal(t, APIv2, v)
+
+	_, ok = pathVersion("v2")
+	assert.False(t, ok)
+	_, ok = pathVersion("versions/1")
+	assert.False(t, ok)
+	_, ok = pathVersion("apps/1")
+	assert.False(t, ok)
+}
@@ -40,7 +40,6 @@ import (
 	"github.com/castbox/asc-go/asc"
 	"github.com/castbox/asc-go/examples/util"
 	"golang.org/x/sync/errgroup"
-	"golang.org/x/time/rate"
 )
 
 var (
@@ -50,7 +49,7 @@ var (
 	concurrency = flag.Int("concurrency", 5, "Number of concurrent localization/image uploads (default: 5)")
 )
 
-// rateLimiter limits API requests based on App Store Connect API official limits:
+// newRateLimiter paces API requests based on App Store Connect API official limits:
 //
 // Official Documentation:
 // https://developer.apple.com/documentation/appstoreconnectapi/identifying-rate-limits
@@ -67,10 +66,16 @@ var (
 //   - Limit resets at the start of each clock minute
 //
 // Current Configuration:
-// - Rate: 4.5 req/s = 270 req/min (safely under 300/min limit)
-// - Burst: 10 requests
-// - This ensures we stay under both hourly and per-minute limits
-var rateLimiter = rate.NewLimiter(rate.Limit(4.5), 10)
+//   - Rate: up to 4.5 req/s = 270 req/min (safely under 300/min limit)
+//   - Burst: 10 requests
+//   - The client slows down automatically as the hourly quota runs out, based on the
+//     x-rate-limit header of every response, so we stay under both limits
+func newRateLimiter() *asc.RateLimiter {
+	return asc.NewRateLimiter(asc.RateLimiterOptions{
+		MaxRate: 4.5,
+		Burst:   10,
+	})
+}
 
 // Retry configuration for handling rate limit errors
 const (
@@ -131,11 +136,6 @@ func updateProgress(achievementName string) {
 	// Print progress
 	fmt.Printf("\n[PROGRESS] %d/%d (%.1f%%) | Elapsed: %v | ETA: %v | Current: %s\n",
 		processed, total, progress, elapsed.Round(time.Second), eta.Round(time.Second), achievementName)
-
-	// Adjust rate limit dynamically every 5 achievements
-	if processed%5 == 0 {
-		adjustRateLimitDynamically()
-	}
 }
 
 // parseRateLimitHeader parses the x-rate-limit header from API responses
@@ -212,43 +212,6 @@ func getRateLimitInfo() (hourLimit, hourRemaining int, lastUpdated time.Time) {
 	return globalRateLimitInfo.hourLimit, globalRateLimitInfo.hourRemaining, globalRateLimitInfo.lastUpdated
 }
 
-// adjustRateLimitDynamically adjusts the rate limiter based on remaining quota
-func adjustRateLimitDynamically() {
-	hourLimit, hourRemaining, lastUpdated := getRateLimitInfo()
-	if hourLimit == 0 || time.Since(lastUpdated) > 5*time.Minute {
-		return // No data or stale data
-	}
-
-	// Calculate remaining percentage
-	remainingPercent := float64(hourRemaining) / float64(hourLimit) * 100
-
-	var newRate float64
-	switch {
-	case remainingPercent < 10:
-		// Critical: slow down to 2 req/s (120 req/min)
-		newRate = 2.0
-		log.Printf("[RATE ADJUST] Critical quota (%.1f%% remaining), reducing to 2 req/s", remainingPercent)
-	case remainingPercent < 25:
-		// Low: slow down to 3 req/s (180 req/min)
-		newRate = 3.0
-		log.Printf("[RATE ADJUST] Low quota (%.1f%% remaining), reducing to 3 req/s", remainingPercent)
-	case remainingPercent < 50:
-		// Medium: use default 4.5 req/s (270 req/min)
-		newRate = 4.5
-	case remainingPercent >= 50:
-		// Plenty: can use 5 req/s (300 req/min) - still under the 300-350/min limit
-		newRate = 5.0
-		if remainingPercent > 80 {
-			log.Printf("[RATE ADJUST] Plenty of quota (%.1f%% remaining), increasing to 5 req/s", remainingPercent)
-		}
-	default:
-		newRate = 4.5
-	}
-
-	// Update rate limiter
-	rateLimiter.SetLimit(rate.Limit(newRate))
-}
-
 // AchievementConfig represents a single achievement configuration
 type AchievementConfig struct {
 	ReferenceName    string               `json:"referenceName"`
@@ -299,6 +262,7 @@ func main() {
 		log.Fatalf("client config failed: %s", err)
 	}
 	client := asc.NewClient(auth.Client())
+	client.SetRateLimiter(newRateLimiter())
 
 	// Get app
 	fmt.Printf("Looking up app with bundle ID: %s\n", *bundleID)
@@ -758,9 +722,6 @@ func verifyAndHandleImage(ctx context.Context, client *asc.Client, imageID strin
 
 	// Get image info with retry
 	err = retryWithBackoff(ctx, fmt.Sprintf("GetImage[%s]", locale), func() error {
-		if err := rateLimiter.Wait(ctx); err != nil {
-			return err
-		}
 		var resp *asc.Response
 		imageInfo, resp, err = client.GameCenter.GetGameCenterAchievementImage(ctx, imageID, nil)
 		if resp != nil && resp.Response != nil {
@@ -783,9 +744,6 @@ func verifyAndHandleImage(ctx context.Context, client *asc.Client, imageID strin
 
 		// Delete the incomplete image with retry
 		deleteErr := retryWithBackoff(ctx, fmt.Sprintf("DeleteImage[%s]", locale), func() error {
-			if err := rateLimiter.Wait(ctx); err != nil {
-				return err
-			}
 			resp, err := client.GameCenter.DeleteGameCenterAchievementImage(ctx, imageID)
 			if resp != nil && resp.Response != nil {
 				updateRateLimitInfo(resp.Response)
@@ -798,9 +756,6 @@ func verifyAndHandleImage(ctx context.Context, client *asc.Client, imageID strin
 
 		// Re-upload the image with retry
 		imgErr := retryWithBackoff(ctx, fmt.Sprintf("ReuploadImage[%s]", locale), func() error {
-			if err := rateLimiter.Wait(ctx); err != nil {
-				return err
-			}
 			return uploadImage(ctx, client, localizationID, imagePath)
 		})
 		if imgErr != nil {
@@ -828,9 +783,6 @@ func uploadImageIfProvided(ctx context.Context, client *asc.Client, localization
 
 	// Upload image with retry
 	imgErr := retryWithBackoff(ctx, fmt.Sprintf("UploadImage[%s]", locale), func() error {
-		if err := rateLimiter.Wait(ctx); err != nil {
-			return err
-		}
 		return uploadImage(ctx, client, localizationID, imagePath)
 	})
 	if imgErr != nil {
@@ -850,9 +802,6 @@ func createNewLocalization(ctx context.Context, client *asc.Client, achievementI
 
 	// Create localization with retry
 	locErr := retryWithBackoff(ctx, fmt.Sprintf("CreateLocalization[%s]", locConfig.Locale), func() error {
-		if err := rateLimiter.Wait(ctx); err != nil {
-			return err
-		}
 		var err error
 		var resp *asc.Response
 		newLoc, resp, err = client.GameCenter.CreateGameCenterAchievementLocalization(ctx, asc.GameCenterAchievementLocalizationCreateRequestAttributes{
@@ -899,9 +848,6 @@ func handleExistingLocalization(ctx context.Context, client *asc.Client, existin
 		} else {
 			// No image exists, upload new image with retry
 			imgErr := retryWithBackoff(ctx, fmt.Sprintf("UploadMissingImage[%s]", locConfig.Locale), func() error {
-				if err := rateLimiter.Wait(ctx); err != nil {
-					return err
-				}
 				return uploadImage(ctx, client, existingLoc.ID, locConfig.ImageFile)
 			})
 			if imgErr != nil {
@@ -1059,9 +1005,6 @@ func createAchievementWithRelease(ctx context.Context, client *asc.Client, gameC
 
 			// Create localization with retry
 			locErr := retryWithBackoff(gCtx, fmt.Sprintf("CreateLoc[%s]", locConfig.Locale), func() error {
-				if err := rateLimiter.Wait(gCtx); err != nil {
-					return err
-				}
 				var err error
 				var resp *asc.Response
 				localization, resp, err = client.GameCenter.CreateGameCenterAchievementLocalization(gCtx, asc.GameCenterAchievementLocalizationCreateRequestAttributes{
@@ -1086,9 +1029,6 @@ func createAchievementWithRelease(ctx context.Context, client *asc.Client, gameC
 			// Upload image if provided
 			if locConfig.ImageFile != "" {
 				imgErr := retryWithBackoff(gCtx, fmt.Sprintf("UploadImg[%s]", locConfig.Locale), func() error {
-					if err := rateLimiter.Wait(gCtx); err != nil {
-						return err
-					}
 					return uploadImage(gCtx, client, localization.Data.ID, locConfig.ImageFile)
 				})
 				if imgErr != nil {
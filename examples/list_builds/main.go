@@ -52,28 +52,17 @@ func main() {
 		log.Fatalf("%s", err)
 	}
 
-	var cursor string
 	params := asc.ListBuildsQuery{
 		FilterApp: []string{app.ID},
 	}
-	for {
-		if cursor != "" {
-			params.Cursor = cursor
-		}
-		builds, _, err := client.Builds.ListBuilds(ctx, &params)
+	builds := asc.Items[asc.Build](ctx, client, func(ctx context.Context) (*asc.BuildsResponse, *asc.Response, error) {
+		return client.Builds.ListBuilds(ctx, &params)
+	}, asc.WithPrefetch())
+	for build, err := range builds {
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		for _, build := range builds.Data {
-			fmt.Println(*build.Attributes.Version)
-		}
-
-		if builds.Links.Next != nil {
-			cursor = builds.Links.Next.Cursor()
-		}
-		if cursor == "" {
-			break
-		}
+		fmt.Println(*build.Attributes.Version)
 	}
 }
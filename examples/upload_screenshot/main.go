@@ -22,12 +22,9 @@ package main
 
 import (
 	"context"
-	"crypto/md5"
 	"flag"
 	"fmt"
-	"io"
 	"log"
-	"os"
 
 	"github.com/castbox/asc-go/asc"
 	"github.com/castbox/asc-go/examples/util"
@@ -139,64 +136,19 @@ func main() {
 		selectedScreenshotSet = newScreenshotSet.Data
 	}
 
-	// 8. Reserve an app screenshot in the selected app screenshot set.
-	//    Tell the API to create a screenshot before uploading the
-	//    screenshot data.
-	file, err := os.Open(*screenshotFile)
+	// 8. Upload the screenshot to the selected app screenshot set.
+	//    UploadAssetFile reserves the screenshot, uploads each part according
+	//    to the upload operations returned by the reservation, commits it with
+	//    the checksum of the file, and waits for App Store Connect to process it.
+	fmt.Println("Uploading the app screenshot.")
+	screenshot, err := asc.UploadAssetFile(ctx, client, asc.AppScreenshotAsset, selectedScreenshotSet.ID, *screenshotFile,
+		asc.WithAssetUploadOptions(asc.WithUploadProgress(func(p asc.UploadProgress) {
+			fmt.Println(p)
+		})))
 	if err != nil {
-		log.Fatalf("file could not be read: %s", err)
+		log.Fatalf("screenshot could not be uploaded: %s", err)
 	}
-	stat, err := file.Stat()
-	if err != nil {
-		log.Fatalf("file could not be read: %s", err)
-	}
-	fmt.Println("Reserving space for a new app screenshot.")
-	reserveScreenshot, _, err := client.Apps.CreateAppScreenshot(ctx, file.Name(), stat.Size(), selectedScreenshotSet.ID)
-	if err != nil {
-		fmt.Println(err)
-	}
-	screenshot := reserveScreenshot.Data
-
-	// 9. Upload each part according to the returned upload operations.
-	//     The reservation returned uploadOperations, which instructs us how
-	//     to split the asset into parts. Upload each part individually.
-	//     Note: To speed up the process, upload multiple parts asynchronously
-	//     if you have the bandwidth.
-	uploadOperations := screenshot.Attributes.UploadOperations
-	fmt.Printf("Uploading %d screenshot components\n", len(uploadOperations))
-	err = client.Upload(ctx, uploadOperations, file)
-	if err != nil {
-		log.Fatalf("file could not be read: %s", err)
-	}
-
-	// 10. Commit the reservation and provide a checksum.
-	//     Committing tells App Store Connect the script is finished uploading parts.
-	//     App Store Connect uses the checksum to ensure the parts were uploaded
-	//     successfully.
-	fmt.Println("Commit the reservation")
-	screenshotURL := screenshot.Links.Self
-	checksum, err := md5Checksum(*screenshotFile)
-	if err != nil {
-		log.Fatalf("file checksum could not be calculated: %s", err)
-	}
-
-	client.Apps.CommitAppScreenshot(ctx, screenshot.ID, asc.Bool(true), &checksum)
 
 	// Report success to the caller.
-	fmt.Printf("\nApp Screenshot successfully uploaded to:\n%s\nYou can verify success in App Store Connect or using the API.\n\n", screenshotURL.String())
-}
-
-func md5Checksum(file string) (string, error) {
-	f, err := os.Open(file)
-	if err != nil {
-		return "", err
-	}
-	defer util.Close(f)
-
-	h := md5.New()
-	if _, err := io.Copy(h, f); err != nil {
-		return "", err
-	}
-
-	return fmt.Sprintf("%x", h.Sum(nil)), nil
+	fmt.Printf("\nApp Screenshot successfully uploaded to:\n%s\nYou can verify success in App Store Connect or using the API.\n\n", screenshot.Links.Self.String())
 }
@@ -22,44 +22,38 @@ package util
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"log"
-	"os"
-	"time"
 
 	"github.com/castbox/asc-go/asc"
 )
 
 var (
+	profile        = flag.String("profile", "", "profile of the asc config file to use")
 	keyID          = flag.String("kid", "", "key ID")
 	issuerID       = flag.String("iss", "", "issuer ID")
 	privateKey     = flag.String("privatekey", "", "private key used to sign authorization token")
 	privateKeyPath = flag.String("privatekeypath", "", "path to a private key used to sign authorization token")
 )
 
-// TokenConfig creates the auth transport using the required information
+// TokenConfig creates the auth transport using the required information, from the flags, the
+// ASC_* environment variables or the asc config file
 func TokenConfig() (auth *asc.AuthTransport, err error) {
-	var secret []byte
-	if *privateKey != "" {
-		secret = []byte(*privateKey)
-	} else if *privateKeyPath != "" {
-		// Read private key file as []byte
-		secret, err = os.ReadFile(*privateKeyPath)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, errors.New("no private key provided to either the -privatekey or -privatekeypath flags")
-	}
-	// Create the token using the required information
-	auth, err = asc.NewTokenConfig(*keyID, *issuerID, 20*time.Minute, secret)
+	cfg, err := asc.LoadConfig(
+		asc.WithProfile(*profile),
+		asc.WithOverrides(asc.Config{
+			KeyID:          *keyID,
+			IssuerID:       *issuerID,
+			PrivateKey:     *privateKey,
+			PrivateKeyPath: *privateKeyPath,
+		}),
+	)
 	if err != nil {
 		return nil, err
 	}
-	return auth, nil
+	return cfg.AuthTransport()
 }
 
 // GetApp returns a single asc.App by filtering by its full name on App Store Connect
@@ -3,6 +3,7 @@ module github.com/castbox/asc-go
 go 1.24.0
 
 require (
+	github.com/BurntSushi/toml v1.2.0
 	github.com/cenkalti/backoff/v4 v4.1.1
 	github.com/dgrijalva/jwt-go/v4 v4.0.0-preview1
 	github.com/gogf/gf/v2 v2.6.4
@@ -13,7 +14,6 @@ require (
 )
 
 require (
-	github.com/BurntSushi/toml v1.2.0 // indirect
 	github.com/clbanning/mxj/v2 v2.7.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
@@ -0,0 +1,359 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Drift lists the parts of the document that are missing from a package.
+type Drift struct {
+	// Endpoints are the endpoints of the document that no service method calls, such as
+	// "GET /v1/apps/{id}/builds".
+	Endpoints []string
+	// Attributes are the attributes of resources in the document that their model lacks, such as
+	// "App.attributes.subscriptionStatusUrl".
+	Attributes []string
+}
+
+// Empty reports whether the package is up to date with the document.
+func (d *Drift) Empty() bool {
+	return len(d.Endpoints) == 0 && len(d.Attributes) == 0
+}
+
+// String returns a report of the drift, one missing endpoint or attribute per line.
+func (d *Drift) String() string {
+	var b strings.Builder
+
+	for _, endpoint := range d.Endpoints {
+		fmt.Fprintf(&b, "missing endpoint: %s\n", endpoint)
+	}
+
+	for _, attribute := range d.Attributes {
+		fmt.Fprintf(&b, "missing attribute: %s\n", attribute)
+	}
+
+	return b.String()
+}
+
+// Check compares the document to the Go package in dir, reporting the endpoints and resource
+// attributes of the document that are missing from the package.
+func Check(spec *Spec, dir string) (*Drift, error) {
+	pkg, err := scanPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := &Drift{}
+
+	for _, endpoint := range spec.Endpoints() {
+		path, _ := servicePath(endpoint.Path)
+		if !pkg.endpoints[strings.ToUpper(endpoint.Method)+" "+normalizePath(path)] {
+			drift.Endpoints = append(drift.Endpoints, strings.ToUpper(endpoint.Method)+" "+endpoint.Path)
+		}
+	}
+
+	resources := spec.Resources()
+
+	for _, typ := range sortedKeys(resources) {
+		name := resources[typ]
+
+		attributes := spec.Schema(spec.Components.Schemas[name].Properties["attributes"])
+		if attributes == nil {
+			continue
+		}
+
+		fields := pkg.structs[name+"Attributes"]
+
+		for _, attr := range sortedKeys(attributes.Properties) {
+			if !fields[attr] {
+				drift.Attributes = append(drift.Attributes, name+".attributes."+attr)
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+// scannedPackage holds what Check needs to know about a package.
+type scannedPackage struct {
+	// endpoints holds the normalized method and path of every request made by the package.
+	endpoints map[string]bool
+	// structs holds the JSON field names of every struct type of the package.
+	structs map[string]map[string]bool
+	// fieldTypes holds the types of the fields of every struct type of the package, by JSON name.
+	fieldTypes map[string]map[string]string
+	// params holds the names of the query parameters of every struct type of the package with url
+	// tags, in the order they are declared.
+	params map[string][]string
+	// unknownFields lists the struct types with an Unknown field of type UnknownFields, in the
+	// order they are declared.
+	unknownFields []string
+	// methods holds the methods declared by the package, as "Type.Method".
+	methods map[string]bool
+	// enums holds the values of the constants of every string type of the package, in the order
+	// they are declared.
+	enums map[string][]string
+}
+
+var requestMethods = map[string]string{
+	"get":    "GET",
+	"post":   "POST",
+	"patch":  "PATCH",
+	"delete": "DELETE",
+}
+
+func scanPackage(dir string) (*scannedPackage, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	scanned := &scannedPackage{
+		endpoints:  map[string]bool{},
+		structs:    map[string]map[string]bool{},
+		fieldTypes: map[string]map[string]string{},
+		params:     map[string][]string{},
+		methods:    map[string]bool{},
+		enums:      map[string][]string{},
+	}
+
+	var consts []*ast.GenDecl
+
+	fset := token.NewFileSet()
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || isGeneratedFile(filepath.Base(path)) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok == token.CONST {
+					consts = append(consts, decl)
+				}
+
+				scanned.scanTypes(decl)
+			case *ast.FuncDecl:
+				scanned.scanMethod(decl)
+				scanned.scanRequests(decl)
+			}
+		}
+	}
+
+	// Constants are scanned last, as they may be declared before their type.
+	for _, decl := range consts {
+		scanned.scanEnums(decl)
+	}
+
+	return scanned, nil
+}
+
+func (p *scannedPackage) scanTypes(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		if ident, ok := typeSpec.Type.(*ast.Ident); ok && ident.Name == "string" {
+			p.enums[typeSpec.Name.Name] = []string{}
+
+			continue
+		}
+
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		fields := map[string]bool{}
+		fieldTypes := map[string]string{}
+
+		for _, field := range structType.Fields.List {
+			if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "UnknownFields" &&
+				len(field.Names) == 1 && field.Names[0].Name == "Unknown" {
+				p.unknownFields = append(p.unknownFields, typeSpec.Name.Name)
+			}
+
+			if field.Tag == nil {
+				continue
+			}
+
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+
+			name, _, _ := strings.Cut(reflect.StructTag(tag).Get("json"), ",")
+			if name != "" && name != "-" {
+				fields[name] = true
+				fieldTypes[name] = types.ExprString(field.Type)
+			}
+
+			param, _, _ := strings.Cut(reflect.StructTag(tag).Get("url"), ",")
+			if param != "" && param != "-" {
+				p.params[typeSpec.Name.Name] = append(p.params[typeSpec.Name.Name], param)
+			}
+		}
+
+		p.structs[typeSpec.Name.Name] = fields
+		p.fieldTypes[typeSpec.Name.Name] = fieldTypes
+	}
+}
+
+func (p *scannedPackage) scanMethod(decl *ast.FuncDecl) {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return
+	}
+
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+
+	if ident, ok := recv.(*ast.Ident); ok {
+		p.methods[ident.Name+"."+decl.Name.Name] = true
+	}
+}
+
+// scanEnums records the string constants declared with an explicit string type of the package.
+func (p *scannedPackage) scanEnums(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		ident, ok := valueSpec.Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		values, ok := p.enums[ident.Name]
+		if !ok {
+			continue
+		}
+
+		for _, value := range valueSpec.Values {
+			lit, ok := value.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+
+			if s, err := strconv.Unquote(lit.Value); err == nil {
+				values = append(values, s)
+			}
+		}
+
+		p.enums[ident.Name] = values
+	}
+}
+
+// scanRequests records the requests made by a function, resolving paths built with fmt.Sprintf
+// or assigned to local variables.
+func (p *scannedPackage) scanRequests(decl *ast.FuncDecl) {
+	if decl.Body == nil {
+		return
+	}
+
+	locals := map[string]string{}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range n.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if ok && i < len(n.Rhs) {
+					if path, ok := pathExpr(n.Rhs[i], locals); ok {
+						locals[ident.Name] = path
+					}
+				}
+			}
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok || len(n.Args) < 2 {
+				return true
+			}
+
+			method, ok := requestMethods[sel.Sel.Name]
+			if !ok {
+				return true
+			}
+
+			if path, ok := pathExpr(n.Args[1], locals); ok {
+				p.endpoints[method+" "+normalizePath(path)] = true
+			}
+		}
+
+		return true
+	})
+}
+
+// pathExpr returns the path of a string literal, a call to fmt.Sprintf with a literal format,
+// or a local variable holding either.
+func pathExpr(expr ast.Expr, locals map[string]string) (string, bool) {
+	switch expr := expr.(type) {
+	case *ast.BasicLit:
+		if expr.Kind == token.STRING {
+			s, err := strconv.Unquote(expr.Value)
+
+			return s, err == nil
+		}
+	case *ast.Ident:
+		s, ok := locals[expr.Name]
+
+		return s, ok
+	case *ast.CallExpr:
+		if sel, ok := expr.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Sprintf" && len(expr.Args) > 0 {
+			return pathExpr(expr.Args[0], locals)
+		}
+	}
+
+	return "", false
+}
+
+var pathParam = regexp.MustCompile(`%[sdv]|\{[^}]*\}`)
+
+// normalizePath makes paths of the document and of the package comparable, relative to the v1
+// root of the API and with path parameters replaced by "{}".
+func normalizePath(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimPrefix(path, "v1/")
+	path, _, _ = strings.Cut(path, "?")
+
+	return pathParam.ReplaceAllString(path, "{}")
+}
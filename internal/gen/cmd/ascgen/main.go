@@ -0,0 +1,167 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Command ascgen generates Go source from Apple's App Store Connect OpenAPI document, or reports
+// the endpoints and attributes of the document that package asc is missing. With -queries, it
+// generates the typed parameters of Query for the resource types of the document, or of the models
+// of the package without -spec. With -unknown, it generates the methods that preserve unknown
+// members of the models of a package instead, with -services the interfaces of its services, and
+// with -mocks the mocks of those interfaces.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/castbox/asc-go/internal/gen"
+)
+
+// sourceFileMode is the mode of the generated files, which are checked in like any other source.
+const sourceFileMode = 0o644
+
+func main() {
+	var (
+		specPath = flag.String("spec", "", "path to the App Store Connect OpenAPI document (required)")
+		out      = flag.String("out", "", "directory to write the generated files to")
+		pkg      = flag.String("pkg", "asc", "package name of the generated files")
+		check    = flag.String("check", "", "package directory to check for drift against the document")
+		queries  = flag.String("queries", "", "package directory to generate the typed parameters of Query for")
+		unknown  = flag.String("unknown", "", "package directory to generate methods preserving unknown fields for")
+		services = flag.String("services", "", "package directory to generate the interfaces of the services for")
+		mocks    = flag.String("mocks", "", "directory to write the mocks of the -services interfaces to")
+		imp      = flag.String("import", "github.com/castbox/asc-go/asc", "import path of the -services package")
+	)
+
+	flag.Parse()
+
+	if *unknown != "" {
+		src, err := gen.GenerateUnknownFields(*unknown, *pkg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(*unknown, gen.UnknownFieldsFile), src, sourceFileMode); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
+	if *services != "" {
+		src, err := gen.GenerateServices(*services, *pkg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(*services, gen.ServicesFile), src, sourceFileMode); err != nil {
+			log.Fatal(err)
+		}
+
+		if *mocks != "" {
+			src, err := gen.GenerateMocks(*services, *imp, filepath.Base(*mocks))
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if err := os.WriteFile(filepath.Join(*mocks, gen.MocksFile), src, sourceFileMode); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		return
+	}
+
+	if *queries != "" && *specPath == "" {
+		writeQueryResources(nil, *queries, *pkg)
+
+		return
+	}
+
+	modes := 0
+
+	for _, dir := range []string{*out, *check, *queries} {
+		if dir != "" {
+			modes++
+		}
+	}
+
+	if *specPath == "" || modes != 1 {
+		fmt.Fprintln(os.Stderr, "usage: ascgen -spec openapi.oas.json (-out dir | -check dir | -queries dir) | ascgen -queries dir | ascgen -unknown dir | ascgen -services dir [-mocks dir]")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+
+	spec, err := gen.LoadSpec(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *queries != "" {
+		writeQueryResources(spec, *queries, *pkg)
+
+		return
+	}
+
+	if *check != "" {
+		drift, err := gen.Check(spec, *check)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Print(drift)
+
+		if !drift.Empty() {
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	files, err := gen.NewGenerator(spec, *pkg).Generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), src, sourceFileMode); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// writeQueryResources writes the typed parameters of Query for the package in dir, from the document
+// or, if spec is nil, from the models of the package.
+func writeQueryResources(spec *gen.Spec, dir, pkg string) {
+	src, err := gen.GenerateQueryResources(spec, dir, pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, gen.QueryResourcesFile), src, sourceFileMode); err != nil {
+		log.Fatal(err)
+	}
+}
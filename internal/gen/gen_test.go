@@ -0,0 +1,247 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadTestSpec(t *testing.T) *Spec {
+	t.Helper()
+
+	spec, err := LoadSpec("testdata/openapi.json")
+	assert.NoError(t, err)
+
+	return spec
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "BundleID", goName("bundleId"))
+	assert.Equal(t, "FilterAppStoreVersionsPlatform", goName("filter[appStoreVersions.platform]"))
+	assert.Equal(t, "SubscriptionStatusURL", goName("subscriptionStatusUrl"))
+	assert.Equal(t, "MACOS", goName("MAC_OS"))
+	assert.Equal(t, "BetaTesterIDs", goName("betaTesterIds"))
+}
+
+func TestMethodName(t *testing.T) {
+	t.Parallel()
+
+	resources := map[string]string{"apps": "App", "inAppPurchases": "InAppPurchaseV2"}
+
+	assert.Equal(t, "ListApps", methodName("apps_getCollection", resources))
+	assert.Equal(t, "GetApp", methodName("apps_getInstance", resources))
+	assert.Equal(t, "ListBuildsForApp", methodName("apps_builds_getToManyRelated", resources))
+	assert.Equal(t, "RemoveBetaTestersFromApp", methodName("apps_betaTesters_deleteToManyRelationship", resources))
+	assert.Equal(t, "DeleteInAppPurchaseV2", methodName("inAppPurchasesV2_deleteInstance", resources))
+	assert.Equal(t, "DeleteBundleID", methodName("bundleIds_deleteInstance", resources))
+	assert.Equal(t, "SomethingElse", methodName("somethingElse", resources))
+}
+
+func TestServicePath(t *testing.T) {
+	t.Parallel()
+
+	path, params := servicePath("/v1/apps/{id}/builds")
+	assert.Equal(t, "apps/%s/builds", path)
+	assert.Equal(t, []string{"id"}, params)
+
+	path, params = servicePath("/v2/inAppPurchases")
+	assert.Equal(t, "v2/inAppPurchases", path)
+	assert.Empty(t, params)
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	files, err := NewGenerator(loadTestSpec(t), "asc").Generate()
+	assert.NoError(t, err)
+	assert.Len(t, files, 4)
+
+	models := string(files["models_gen.go"])
+	assert.True(t, strings.HasPrefix(models, "// Code generated by ascgen. DO NOT EDIT."))
+	assert.Contains(t, models, "Relationships *AppRelationships `json:\"relationships,omitempty\"`")
+	assert.Contains(t, models, "Builds  *PagedRelationship `json:\"builds,omitempty\"`")
+	assert.Contains(t, models, "AppInfo *Relationship      `json:\"appInfo,omitempty\"`")
+	assert.Contains(t, models, "UploadedDate *DateTime `json:\"uploadedDate,omitempty\"`")
+	assert.Contains(t, models, "Included []AppsResponseIncluded `json:\"included,omitempty\"`")
+	assert.Contains(t, models, "func (i *AppsResponseIncluded) Build() *Build {")
+	assert.Contains(t, models, `PlatformMACOS Platform = "MAC_OS"`)
+	assert.NotContains(t, models, "type ResourceLinks")
+
+	queries := string(files["queries_gen.go"])
+	assert.Contains(t, queries, "FilterBundleID []string     `url:\"filter[bundleId],omitempty,comma\"`")
+	assert.Contains(t, queries, "Query          QueryEncoder `url:\"-\"`")
+	assert.Contains(t, queries, "type ListBuildsForAppQuery struct")
+
+	services := string(files["services_gen.go"])
+	assert.Contains(t, services, "func (s *AppsService) ListBuildsForApp(ctx context.Context, id string, params *ListBuildsForAppQuery) (*BuildsResponse, *Response, error) {")
+	assert.Contains(t, services, `resp, err := s.client.patch(ctx, url, newRequestBody(body.Data), res)`)
+	assert.Contains(t, services, "func (s *InAppPurchasesService) DeleteInAppPurchaseV2(ctx context.Context, id string) (*Response, error) {")
+	assert.Contains(t, services, `url := fmt.Sprintf("v2/inAppPurchases/%s", id)`)
+	assert.NotContains(t, services, "legacyThings")
+
+	included := string(files["included_gen.go"])
+	assert.Contains(t, included, `"builds": func(b []byte) (string, interface{}, error) {`)
+	assert.Contains(t, included, "func extractIncludedBuild(i interface{}) *Build {")
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	drift, err := Check(loadTestSpec(t), "../../asc")
+	assert.NoError(t, err)
+	assert.False(t, drift.Empty())
+
+	assert.Equal(t, []string{
+		"GET /v2/inAppPurchases/{id}",
+		"DELETE /v2/inAppPurchases/{id}",
+	}, drift.Endpoints)
+
+	assert.Contains(t, drift.Attributes, "App.attributes.subscriptionStatusUrl")
+	assert.Contains(t, drift.Attributes, "Build.attributes.platform")
+	assert.NotContains(t, drift.Attributes, "App.attributes.bundleId")
+	assert.Contains(t, drift.String(), "missing endpoint: GET /v2/inAppPurchases/{id}\n")
+}
+
+func TestGenerateUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateUnknownFields("../../asc", "asc")
+	assert.NoError(t, err)
+
+	generated := string(src)
+	assert.Contains(t, generated, "func (v *AppAttributes) UnmarshalJSON(b []byte) error {")
+	assert.Contains(t, generated, "func (v AppRelationships) MarshalJSON() ([]byte, error) {")
+	assert.Contains(t, generated, "func (v GameCenterLeaderboardAttributes) MarshalJSON() ([]byte, error) {")
+	assert.NotContains(t, generated, "func (v *GameCenterLeaderboardAttributes) UnmarshalJSON(b []byte) error {")
+	assert.Contains(t, generated, "reflect.TypeOf(AppStoreVersionState(\"\")): {")
+	assert.NotContains(t, generated, "Email(")
+
+	checkedIn, err := os.ReadFile("../../asc/" + UnknownFieldsFile)
+	assert.NoError(t, err)
+	assert.Equal(t, string(checkedIn), generated, "asc/%s is out of date, run go generate ./asc", UnknownFieldsFile)
+}
+
+func TestGenerateUnknownFieldsUnexported(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	src := "package asc\n\ntype appUpdateRequestRelationships struct {\n\tUnknown UnknownFields `json:\"-\"`\n}\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "apps.go"), []byte(src), 0o600))
+
+	_, err := GenerateUnknownFields(dir, "asc")
+	assert.ErrorContains(t, err, "appUpdateRequestRelationships")
+}
+
+func TestGenerateQueryResources(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateQueryResources(loadTestSpec(t), "../../asc", "asc")
+	assert.NoError(t, err)
+
+	generated := string(src)
+	assert.True(t, strings.HasPrefix(generated, "// Code generated by ascgen. DO NOT EDIT.\n\npackage asc\n"))
+	assert.Contains(t, generated, "func NewAppsQuery() *Query[App] {\n\treturn newQuery[App](\"apps\")\n}")
+	assert.Contains(t, generated, "AppFieldSubscriptionStatusURL  = Field{resource: \"apps\", name: \"subscriptionStatusUrl\"}")
+	assert.Contains(t, generated, "AppFieldBuilds                 = Field{resource: \"apps\", name: \"builds\"}")
+	assert.Contains(t, generated, "AppIncludeAppInfo = Include[App]{name: \"appInfo\", target: \"appInfos\"}")
+	assert.Contains(t, generated, "AppIncludeBuilds  = Include[App]{name: \"builds\", target: \"builds\", maxLimit: maxIncludedLimit}")
+	assert.Contains(t, generated, "AppSortBundleID = Sort[App]{name: \"bundleId\"}")
+	assert.Contains(t, generated, "AppFilterBundleID = Filter[App]{name: \"bundleId\"}")
+	assert.Contains(t, generated, "AppExistsBuilds = Exists[App]{name: \"builds\"}")
+	assert.Contains(t, generated, "func NewBuildsQuery() *Query[Build] {")
+	assert.Contains(t, generated, "BuildFieldUploadedDate = Field{resource: \"builds\", name: \"uploadedDate\"}")
+	assert.NotContains(t, generated, "BuildSort")
+	assert.NotContains(t, generated, "InAppPurchaseV2", "types the package has no model for are skipped")
+}
+
+func TestGenerateQueryResourcesFromModels(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateQueryResources(nil, "../../asc", "asc")
+	assert.NoError(t, err)
+
+	generated := string(src)
+	assert.True(t, strings.HasPrefix(generated, "// Code generated by ascgen. DO NOT EDIT.\n\npackage asc\n"))
+	assert.Contains(t, generated, "func NewBuildsQuery() *Query[Build] {\n\treturn newQuery[Build](\"builds\")\n}")
+	assert.Contains(t, generated, "BuildIncludeIndividualTesters        = Include[Build]{name: \"individualTesters\", target: \"betaTesters\", maxLimit: maxIncludedLimit}")
+	assert.Contains(t, generated, "BuildIncludePreReleaseVersion        = Include[Build]{name: \"preReleaseVersion\", target: \"preReleaseVersions\"}")
+	assert.Contains(t, generated, "BuildSortUploadedDate            = Sort[Build]{name: \"uploadedDate\"}")
+	assert.Contains(t, generated, "AppExistsGameCenterEnabledVersions = Exists[App]{name: \"gameCenterEnabledVersions\"}")
+	assert.Contains(t, generated, "func NewPreReleaseVersionsQuery() *Query[PrereleaseVersion] {")
+	assert.Contains(t, generated, "func NewAppPricesQuery() *Query[AppPrice] {")
+	assert.NotContains(t, generated, "Query[DiagnosticLog]", "types without query options are skipped")
+
+	checkedIn, err := os.ReadFile("../../asc/" + QueryResourcesFile)
+	assert.NoError(t, err)
+	assert.Equal(t, string(checkedIn), generated, "asc/%s is out of date, run go generate ./asc", QueryResourcesFile)
+}
+
+func TestGenerateServices(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateServices("../../asc", "asc")
+	assert.NoError(t, err)
+
+	generated := string(src)
+	assert.Contains(t, generated, "type AppsAPI interface {")
+	assert.Contains(t, generated, "\tGetApp(ctx context.Context, id string, params *GetAppQuery) (*AppResponse, *Response, error)\n")
+	assert.Contains(t, generated, "\tTestFlight        TestflightAPI\n")
+	assert.Contains(t, generated, "_ AppCustomerReviewsAPI   = (*AppCustomerReviewsService)(nil)")
+
+	checkedIn, err := os.ReadFile("../../asc/" + ServicesFile)
+	assert.NoError(t, err)
+	assert.Equal(t, string(checkedIn), generated, "asc/%s is out of date, run go generate ./asc", ServicesFile)
+}
+
+func TestGenerateMocks(t *testing.T) {
+	t.Parallel()
+
+	src, err := GenerateMocks("../../asc", "github.com/castbox/asc-go/asc", "ascmock")
+	assert.NoError(t, err)
+
+	generated := string(src)
+	assert.Contains(t, generated, "var _ asc.AppsAPI = (*AppsMock)(nil)")
+	assert.Contains(t, generated, "\tGetAppFunc ")
+	assert.Contains(t, generated, "func (mock *AppsMock) GetApp(ctx context.Context, id string, params *asc.GetAppQuery) (*asc.AppResponse, *asc.Response, error) {")
+	assert.Contains(t, generated, "\t\treturn r0, r1, notStubbed(\"AppsMock\", \"GetApp\")\n")
+
+	checkedIn, err := os.ReadFile("../../asc/ascmock/" + MocksFile)
+	assert.NoError(t, err)
+	assert.Equal(t, string(checkedIn), generated, "asc/ascmock/%s is out of date, run go generate ./asc", MocksFile)
+}
+
+func TestMockParamNames(t *testing.T) {
+	t.Parallel()
+
+	m := serviceMethod{
+		params:  []param{{name: "ctx"}, {name: "_"}, {name: "io"}, {name: ""}, {name: "mock"}},
+		imports: map[string]string{"io": "io"},
+	}
+
+	assert.Equal(t, []string{"ctx", "arg1", "arg2", "arg3", "arg4"}, m.mockParamNames())
+}
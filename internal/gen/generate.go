@@ -0,0 +1,473 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// builtinTypes are schemas of the document that package asc models by hand, mapped to the name of
+// their Go type.
+var builtinTypes = map[string]string{
+	"DocumentLinks":      "DocumentLinks",
+	"PagedDocumentLinks": "PagedDocumentLinks",
+	"PagingInformation":  "PagingInformation",
+	"ResourceLinks":      "ResourceLinks",
+	"RelationshipLinks":  "RelationshipLinks",
+	"ErrorResponse":      "ErrorResponse",
+}
+
+// Generator emits Go source for the schemas and operations of a Spec.
+type Generator struct {
+	spec      *Spec
+	pkg       string
+	resources map[string]string
+
+	decls    map[string]string
+	included map[string][]string
+}
+
+// NewGenerator creates a Generator for the document, emitting files of the given package.
+func NewGenerator(spec *Spec, pkg string) *Generator {
+	return &Generator{
+		spec:      spec,
+		pkg:       pkg,
+		resources: spec.Resources(),
+		decls:     map[string]string{},
+		included:  map[string][]string{},
+	}
+}
+
+// Generate returns the formatted source of the generated files, keyed by file name.
+func (g *Generator) Generate() (map[string][]byte, error) {
+	for _, name := range sortedKeys(g.spec.Components.Schemas) {
+		if _, ok := builtinTypes[name]; !ok {
+			g.declare(name, g.spec.Components.Schemas[name])
+		}
+	}
+
+	files := map[string]*bytes.Buffer{
+		"models_gen.go":   g.models(),
+		"queries_gen.go":  nil,
+		"services_gen.go": nil,
+		"included_gen.go": g.includedRegistry(),
+	}
+	files["queries_gen.go"], files["services_gen.go"] = g.operations()
+
+	out := map[string][]byte{}
+
+	for name, buf := range files {
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", name, err)
+		}
+
+		out[name] = src
+	}
+
+	return out, nil
+}
+
+func (g *Generator) header(imports ...string) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "// Code generated by ascgen. DO NOT EDIT.\n\npackage %s\n\n", g.pkg)
+
+	if len(imports) > 0 {
+		buf.WriteString("import (\n")
+
+		for _, imp := range imports {
+			fmt.Fprintf(buf, "\t%q\n", imp)
+		}
+
+		buf.WriteString(")\n\n")
+	}
+
+	return buf
+}
+
+// declare records the declaration of the named schema, and of the inline schemas it contains.
+func (g *Generator) declare(name string, schema *Schema) {
+	if _, ok := g.decls[name]; ok {
+		return
+	}
+
+	g.decls[name] = ""
+
+	var b strings.Builder
+
+	switch {
+	case schema.Ref != "":
+		fmt.Fprintf(&b, "// %s defines model for %s.\ntype %s = %s\n", name, name, name, g.goType(name, "", schema, true))
+	case schema.Type == "string" && len(schema.Enum) > 0:
+		fmt.Fprintf(&b, "// %s defines model for %s.\ntype %s string\n\nconst (\n", name, name, name)
+
+		for _, value := range schema.Enum {
+			fmt.Fprintf(&b, "\t%s%s %s = %q\n", name, goName(value), name, value)
+		}
+
+		b.WriteString(")\n")
+	case len(schema.Properties) > 0:
+		fmt.Fprintf(&b, "// %s defines model for %s.\ntype %s struct {\n", name, name, name)
+
+		required := map[string]bool{}
+		for _, prop := range schema.Required {
+			required[prop] = true
+		}
+
+		for _, prop := range sortedKeys(schema.Properties) {
+			typ := g.propertyType(name, prop, schema.Properties[prop], required[prop])
+
+			tag := prop
+			if !required[prop] {
+				tag += ",omitempty"
+			}
+
+			fmt.Fprintf(&b, "\t%s %s `json:\"%s\"`\n", goName(prop), typ, tag)
+		}
+
+		b.WriteString("}\n")
+	default:
+		fmt.Fprintf(&b, "// %s defines model for %s.\ntype %s %s\n", name, name, name, g.goType(name, "", schema, true))
+	}
+
+	g.decls[name] = b.String()
+}
+
+// propertyType returns the Go type of a property of an object, declaring any types it needs.
+func (g *Generator) propertyType(parent, prop string, schema *Schema, required bool) string {
+	switch {
+	case prop == "relationships" && schema.Ref == "" && len(schema.Properties) > 0:
+		name := parent + "Relationships"
+		g.declareRelationships(name, schema)
+
+		return "*" + name
+	case prop == "included" && schema.Items != nil && len(schema.Items.OneOf) > 0:
+		name := parent + "Included"
+		g.declareIncluded(name, schema.Items.OneOf)
+
+		return "[]" + name
+	}
+
+	return g.goType(parent, prop, schema, required)
+}
+
+// goType returns the Go type of a schema, declaring inline objects as types named after their
+// parent and property.
+func (g *Generator) goType(parent, prop string, schema *Schema, required bool) string {
+	pointer := func(typ string) string {
+		if required {
+			return typ
+		}
+
+		return "*" + typ
+	}
+
+	if ref := schema.RefName(); ref != "" {
+		if builtin, ok := builtinTypes[ref]; ok {
+			return pointer(builtin)
+		}
+
+		resolved := g.spec.Schema(schema)
+		if resolved != nil && resolved.Type == "array" {
+			return ref
+		}
+
+		return pointer(ref)
+	}
+
+	switch schema.Type {
+	case "string":
+		switch schema.Format {
+		case "date-time":
+			return pointer("DateTime")
+		case "date":
+			return pointer("Date")
+		case "email":
+			return pointer("Email")
+		}
+
+		return pointer("string")
+	case "integer":
+		return pointer("int")
+	case "number":
+		return pointer("float64")
+	case "boolean":
+		return pointer("bool")
+	case "array":
+		if schema.Items == nil {
+			return "[]interface{}"
+		}
+
+		return "[]" + g.goType(parent, prop, schema.Items, true)
+	}
+
+	if len(schema.Properties) > 0 {
+		name := parent + goName(prop)
+		g.declare(name, schema)
+
+		return pointer(name)
+	}
+
+	return "interface{}"
+}
+
+// declareRelationships declares the relationships of a resource, using the Relationship and
+// PagedRelationship types of package asc.
+func (g *Generator) declareRelationships(name string, schema *Schema) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "// %s defines model for %s.\ntype %s struct {\n", name, name, name)
+
+	for _, prop := range sortedKeys(schema.Properties) {
+		rel := g.spec.Schema(schema.Properties[prop])
+
+		typ := "*Relationship"
+		if data := g.spec.Schema(rel.Properties["data"]); data != nil && data.Type == "array" || rel.Properties["meta"] != nil {
+			typ = "*PagedRelationship"
+		}
+
+		fmt.Fprintf(&b, "\t%s %s `json:\"%s,omitempty\"`\n", goName(prop), typ, prop)
+	}
+
+	b.WriteString("}\n")
+
+	g.decls[name] = b.String()
+}
+
+// declareIncluded declares a heterogenous wrapper for the resources that can be included in a
+// response, with an accessor for each of them.
+func (g *Generator) declareIncluded(name string, oneOf []*Schema) {
+	var (
+		b     strings.Builder
+		types []string
+	)
+
+	for _, s := range oneOf {
+		if ref := s.RefName(); ref != "" {
+			types = append(types, ref)
+		}
+	}
+
+	sort.Strings(types)
+
+	fmt.Fprintf(&b, "// %s is a heterogenous wrapper for the possible types that can be included.\ntype %s included\n\n", name, name)
+	fmt.Fprintf(&b, "// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in %s.\n", name)
+	fmt.Fprintf(&b, "func (i *%s) UnmarshalJSON(b []byte) error {\n\ttypeName, inner, err := unmarshalInclude(b)\n\ti.Type = typeName\n\ti.inner = inner\n\n\treturn err\n}\n", name)
+
+	for _, typ := range types {
+		fmt.Fprintf(&b, "\n// %s returns the %s stored within, if one is present.\n", typ, typ)
+		fmt.Fprintf(&b, "func (i *%s) %s() *%s {\n\treturn extractIncluded%s(i.inner)\n}\n", name, typ, typ, typ)
+	}
+
+	g.decls[name] = b.String()
+	g.included[name] = types
+}
+
+func (g *Generator) models() *bytes.Buffer {
+	buf := g.header()
+
+	for _, name := range sortedKeys(g.decls) {
+		buf.WriteString(g.decls[name])
+		buf.WriteString("\n")
+	}
+
+	return buf
+}
+
+// includedRegistry emits the unmarshallers and extractors of every resource type that can be included.
+func (g *Generator) includedRegistry() *bytes.Buffer {
+	buf := g.header("encoding/json")
+
+	types := map[string]bool{}
+	for _, names := range g.included {
+		for _, name := range names {
+			types[name] = true
+		}
+	}
+
+	byType := map[string]string{}
+
+	for typ, name := range g.resources {
+		if types[name] {
+			byType[typ] = name
+		}
+	}
+
+	buf.WriteString("func generatedIncludeTypes() includeTypeUnmarshallers {\n\treturn includeTypeUnmarshallers{\n")
+
+	for _, typ := range sortedKeys(byType) {
+		fmt.Fprintf(buf, "\t\t%q: func(b []byte) (string, interface{}, error) {\n\t\t\tvar v %s\n\t\t\terr := json.Unmarshal(b, &v)\n\n\t\t\treturn v.Type, v, err\n\t\t},\n", typ, byType[typ])
+	}
+
+	buf.WriteString("\t}\n}\n")
+
+	for _, name := range sortedKeys(types) {
+		fmt.Fprintf(buf, "\nfunc extractIncluded%s(i interface{}) *%s {\n\tif v, ok := i.(%s); ok {\n\t\treturn &v\n\t}\n\n\treturn nil\n}\n", name, name, name)
+	}
+
+	return buf
+}
+
+// operations emits the query structs and service methods of every endpoint.
+func (g *Generator) operations() (queries *bytes.Buffer, services *bytes.Buffer) {
+	queries = g.header()
+	methods := new(bytes.Buffer)
+
+	byService := map[string][]Endpoint{}
+
+	for _, endpoint := range g.spec.Endpoints() {
+		service := "Default"
+		if len(endpoint.Operation.Tags) > 0 {
+			service = goName(endpoint.Operation.Tags[0])
+		}
+
+		byService[service] = append(byService[service], endpoint)
+	}
+
+	for _, service := range sortedKeys(byService) {
+		typ := service + "Service"
+		fmt.Fprintf(methods, "// %s handles communication with the %s methods of the App Store Connect API.\ntype %s service\n\n", typ, service, typ)
+
+		for _, endpoint := range byService[service] {
+			g.operation(queries, methods, typ, endpoint)
+		}
+	}
+
+	var imports []string
+	if len(byService) > 0 {
+		imports = append(imports, "context")
+	}
+
+	if bytes.Contains(methods.Bytes(), []byte("fmt.Sprintf")) {
+		imports = append(imports, "fmt")
+	}
+
+	services = g.header(imports...)
+	services.Write(methods.Bytes())
+
+	return queries, services
+}
+
+func (g *Generator) operation(queries, services *bytes.Buffer, service string, endpoint Endpoint) {
+	op := endpoint.Operation
+	name := methodName(op.OperationID, g.resources)
+	path, pathParams := servicePath(endpoint.Path)
+
+	var queryParams []Parameter
+
+	for _, param := range op.Parameters {
+		if param.In == "query" {
+			queryParams = append(queryParams, param)
+		}
+	}
+
+	args := []string{"ctx context.Context"}
+	for _, param := range pathParams {
+		args = append(args, fmt.Sprintf("%s string", param))
+	}
+
+	query := "nil"
+
+	if len(queryParams) > 0 {
+		queryType := name + "Query"
+		g.queryStruct(queries, queryType, name, queryParams)
+
+		args = append(args, fmt.Sprintf("params *%s", queryType))
+		query = "params"
+	}
+
+	body := "nil"
+
+	if req := op.requestSchema(); req != "" {
+		args = append(args, fmt.Sprintf("body *%s", req))
+		body = "newRequestBody(body.Data)"
+	}
+
+	res := op.responseSchema()
+
+	fmt.Fprintf(services, "// %s calls %s %s.\n", name, strings.ToUpper(endpoint.Method), endpoint.Path)
+
+	if res != "" {
+		fmt.Fprintf(services, "func (s *%s) %s(%s) (*%s, *Response, error) {\n", service, name, strings.Join(args, ", "), res)
+	} else {
+		fmt.Fprintf(services, "func (s *%s) %s(%s) (*Response, error) {\n", service, name, strings.Join(args, ", "))
+	}
+
+	if len(pathParams) > 0 {
+		fmt.Fprintf(services, "\turl := fmt.Sprintf(%q, %s)\n", path, strings.Join(pathParams, ", "))
+	} else {
+		fmt.Fprintf(services, "\turl := %q\n", path)
+	}
+
+	var call string
+
+	switch endpoint.Method {
+	case "get":
+		call = fmt.Sprintf("s.client.get(ctx, url, %s, %%s)", query)
+	case "post":
+		call = fmt.Sprintf("s.client.post(ctx, url, %s, %%s)", body)
+	case "patch":
+		call = fmt.Sprintf("s.client.patch(ctx, url, %s, %%s)", body)
+	case "delete":
+		call = fmt.Sprintf("s.client.delete(ctx, url, %s)", body)
+	}
+
+	switch {
+	case res != "" && endpoint.Method != "delete":
+		fmt.Fprintf(services, "\tres := new(%s)\n\tresp, err := %s\n\n\treturn res, resp, err\n}\n\n", res, fmt.Sprintf(call, "res"))
+	case endpoint.Method == "delete":
+		fmt.Fprintf(services, "\n\treturn %s\n}\n\n", call)
+	default:
+		fmt.Fprintf(services, "\n\treturn %s\n}\n\n", fmt.Sprintf(call, "nil"))
+	}
+}
+
+func (g *Generator) queryStruct(buf *bytes.Buffer, name, method string, params []Parameter) {
+	fmt.Fprintf(buf, "// %s are query options for %s\ntype %s struct {\n", name, method, name)
+
+	for _, param := range params {
+		typ := "string"
+
+		schema := g.spec.Schema(param.Schema)
+		if schema != nil {
+			switch schema.Type {
+			case "array":
+				typ = "[]string"
+			case "integer":
+				typ = "int"
+			}
+		}
+
+		tag := param.Name + ",omitempty"
+		if typ == "[]string" && param.Explode != nil && !*param.Explode {
+			tag += ",comma"
+		}
+
+		fmt.Fprintf(buf, "\t%s %s `url:\"%s\"`\n", goName(param.Name), typ, tag)
+	}
+
+	buf.WriteString("\tQuery QueryEncoder `url:\"-\"`\n}\n\n")
+}
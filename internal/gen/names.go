@@ -0,0 +1,156 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are words spelled in upper case in Go identifiers.
+var initialisms = map[string]string{
+	"Id":  "ID",
+	"Ids": "IDs",
+	"Url": "URL",
+	"Uri": "URI",
+}
+
+// goName converts a name from the document, such as "bundleId" or "filter[app.platform]", to an
+// exported Go identifier, such as "BundleID" or "FilterAppPlatform".
+func goName(s string) string {
+	var b strings.Builder
+
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		for _, word := range camelWords(part) {
+			word = strings.ToUpper(word[:1]) + word[1:]
+			if initialism, ok := initialisms[word]; ok {
+				word = initialism
+			}
+
+			b.WriteString(word)
+		}
+	}
+
+	return b.String()
+}
+
+// camelWords splits a camel case name into words, such as "bundle" and "Id" for "bundleId".
+func camelWords(s string) []string {
+	var (
+		words []string
+		start int
+	)
+
+	runes := []rune(s)
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
+
+// methodName derives the name of the service method for an operation from its ID, such as
+// "ListBuildsForApp" for "apps_builds_getToManyRelated". Resources are named after the schema
+// of their type.
+func methodName(operationID string, resources map[string]string) string {
+	parts := strings.Split(operationID, "_")
+
+	singular := func(typ string) string {
+		if name, ok := resources[typ]; ok {
+			return name
+		}
+
+		// Operations on resources introduced by a later version are named after the version,
+		// as in "inAppPurchasesV2_getInstance".
+		if base := strings.TrimRightFunc(typ, unicode.IsDigit); strings.HasSuffix(base, "V") && base != typ {
+			if name, ok := resources[strings.TrimSuffix(base, "V")]; ok {
+				return name
+			}
+		}
+
+		return strings.TrimSuffix(goName(typ), "s")
+	}
+
+	switch len(parts) {
+	case 2:
+		res := parts[0]
+
+		switch parts[1] {
+		case "getCollection":
+			return "List" + goName(res)
+		case "getInstance":
+			return "Get" + singular(res)
+		case "createInstance":
+			return "Create" + singular(res)
+		case "updateInstance":
+			return "Update" + singular(res)
+		case "deleteInstance":
+			return "Delete" + singular(res)
+		}
+	case 3:
+		res, rel := singular(parts[0]), goName(parts[1])
+
+		switch parts[2] {
+		case "getToManyRelated":
+			return "List" + rel + "For" + res
+		case "getToOneRelated":
+			return "Get" + rel + "For" + res
+		case "getToManyRelationship":
+			return "List" + rel + "IDsFor" + res
+		case "getToOneRelationship":
+			return "Get" + rel + "IDFor" + res
+		case "createToManyRelationship":
+			return "Add" + rel + "To" + res
+		case "deleteToManyRelationship":
+			return "Remove" + rel + "From" + res
+		case "replaceToManyRelationship":
+			return "Replace" + rel + "For" + res
+		case "updateToOneRelationship":
+			return "Update" + rel + "For" + res
+		}
+	}
+
+	return goName(operationID)
+}
+
+// servicePath converts a path of the document to the form used by service methods, relative to the
+// v1 root of the API, along with a format string and the names of its path parameters. For example,
+// "/v1/apps/{id}/builds" becomes "apps/%s/builds" with the parameter "id", and "/v2/inAppPurchases"
+// becomes "v2/inAppPurchases".
+func servicePath(path string) (format string, params []string) {
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimPrefix(path, "v1/")
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params = append(params, strings.Trim(segment, "{}"))
+			segments[i] = "%s"
+		}
+	}
+
+	return strings.Join(segments, "/"), params
+}
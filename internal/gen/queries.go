@@ -0,0 +1,524 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// QueryResourcesFile is the name of the file GenerateQueryResources output is written to.
+const QueryResourcesFile = "query_resources_gen.go"
+
+// queryResource holds the typed parameters of Query for a resource type.
+type queryResource struct {
+	// typ is the resource type, such as "builds", and name the Go type of its model, such as
+	// "Build".
+	typ, name string
+	// fields are the attributes and relationships of the resource type.
+	fields  []string
+	include []queryInclude
+	sort    []string
+	filter  []string
+	exists  []string
+}
+
+// queryInclude is a relationship of a resource type that can be included.
+type queryInclude struct {
+	name   string
+	target string
+	toMany bool
+}
+
+// GenerateQueryResources generates the typed parameters of Query for every resource type that the
+// package in dir has a model for: its constructor, such as NewBuildsQuery, and its fields,
+// relationships that can be included, sort keys, filters and exists parameters, such as
+// BuildFieldVersion, BuildIncludeApp, BuildSortUploadedDate and BuildFilterProcessingState.
+//
+// Relationships, sort keys, filters and exists parameters are those of the requests for the
+// collection and the instances of the resource type. They are read from the document, or from the
+// models and the query options of the package if spec is nil. The package has no list of the sort
+// keys of a resource type, so they are then its attributes, which the API may not all accept.
+func GenerateQueryResources(spec *Spec, dir string, pkg string) ([]byte, error) {
+	scanned, err := scanPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []*queryResource
+	if spec != nil {
+		resources = spec.queryResources(scanned)
+	} else {
+		resources = scanned.queryResources()
+	}
+
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "// Code generated by ascgen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	for _, resource := range resources {
+		writeQueryResource(buf, resource)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", QueryResourcesFile, err)
+	}
+
+	return src, nil
+}
+
+// queryResources returns the typed parameters of Query for the resource types of the document that
+// the package has a model for, in the order of their types.
+func (s *Spec) queryResources(scanned *scannedPackage) []*queryResource {
+	var resources []*queryResource
+
+	all := s.Resources()
+	params := s.queryParams()
+
+	for _, typ := range sortedKeys(all) {
+		name := all[typ]
+		if scanned.structs[name] == nil {
+			continue
+		}
+
+		resource := &queryResource{typ: typ, name: name}
+		if p, ok := params[typ]; ok {
+			resource = p
+			resource.typ, resource.name = typ, name
+		}
+
+		schema := s.Components.Schemas[name]
+
+		if attributes := s.Schema(schema.Properties["attributes"]); attributes != nil {
+			resource.fields = append(resource.fields, sortedKeys(attributes.Properties)...)
+		}
+
+		relationships := s.Schema(schema.Properties["relationships"])
+		if relationships != nil {
+			resource.fields = appendUnique(resource.fields, sortedKeys(relationships.Properties)...)
+		}
+
+		var include []queryInclude
+
+		for _, rel := range resource.include {
+			if relationships == nil {
+				break
+			}
+
+			if target, toMany, ok := s.relationshipTarget(relationships, rel.name); ok {
+				include = append(include, queryInclude{name: rel.name, target: target, toMany: toMany})
+			}
+		}
+
+		resource.include = include
+		resources = append(resources, resource)
+	}
+
+	return resources
+}
+
+// queryParams collects the parameters of the GET requests for the collection and the instances of
+// every resource type, keyed by type. The targets of the relationships that can be included are
+// left to the caller.
+func (s *Spec) queryParams() map[string]*queryResource {
+	byType := map[string]*queryResource{}
+
+	for _, endpoint := range s.Endpoints() {
+		if endpoint.Method != "get" {
+			continue
+		}
+
+		path, _ := servicePath(endpoint.Path)
+		if version, rest, ok := strings.Cut(path, "/"); ok && isVersion(version) {
+			path = rest
+		}
+
+		typ := strings.TrimSuffix(path, "/%s")
+		if strings.Contains(typ, "/") {
+			continue
+		}
+
+		p, ok := byType[typ]
+		if !ok {
+			p = &queryResource{}
+			byType[typ] = p
+		}
+
+		for _, param := range endpoint.Operation.Parameters {
+			if param.In != "query" {
+				continue
+			}
+
+			switch name := param.Name; {
+			case name == "include":
+				for _, value := range s.paramValues(param) {
+					if !hasInclude(p.include, value) {
+						p.include = append(p.include, queryInclude{name: value})
+					}
+				}
+			case name == "sort":
+				for _, value := range s.paramValues(param) {
+					p.sort = appendUnique(p.sort, strings.TrimPrefix(value, "-"))
+				}
+			default:
+				p.addParam(name)
+			}
+		}
+	}
+
+	for _, p := range byType {
+		p.sortParams()
+	}
+
+	return byType
+}
+
+// paramValues returns the values a parameter is restricted to.
+func (s *Spec) paramValues(param Parameter) []string {
+	schema := s.Schema(param.Schema)
+	if schema == nil {
+		return nil
+	}
+
+	if items := s.Schema(schema.Items); items != nil {
+		return items.Enum
+	}
+
+	return schema.Enum
+}
+
+// relationshipTarget returns the resource type a relationship of a resource refers to, and whether
+// it is a to-many relationship.
+func (s *Spec) relationshipTarget(relationships *Schema, name string) (string, bool, bool) {
+	rel := s.Schema(relationships.Properties[name])
+	if rel == nil {
+		return "", false, false
+	}
+
+	data := s.Schema(rel.Properties["data"])
+	if data == nil {
+		return "", false, false
+	}
+
+	toMany := data.Type == "array"
+	if toMany {
+		data = s.Schema(data.Items)
+	}
+
+	if data == nil {
+		return "", false, false
+	}
+
+	typ := s.Schema(data.Properties["type"])
+	if typ == nil || len(typ.Enum) != 1 {
+		return "", false, false
+	}
+
+	return typ.Enum[0], toMany, true
+}
+
+// queryResources returns the typed parameters of Query for the resource types that the query
+// options of the package have a fields parameter for and that the package has a model for, in the
+// order of their types.
+//
+// The parameters of a resource type are those of its List and Get query options, such as
+// ListBuildsQuery and GetBuildQuery, whatever the case of their names. A relationship can be included if they have an include
+// parameter and a fields parameter for the type of the relationship, which is the plural of its
+// name or, failing that, the only type ending with the same word, such as "betaTesters" for
+// "individualTesters".
+func (p *scannedPackage) queryResources() []*queryResource {
+	types := map[string]bool{}
+
+	for name, params := range p.params {
+		if !strings.HasSuffix(name, "Query") {
+			continue
+		}
+
+		for _, param := range params {
+			if typ, ok := paramKey(param, "fields"); ok {
+				types[typ] = true
+			}
+		}
+	}
+
+	var resources []*queryResource
+
+	models := p.resourceModels()
+
+	queries := map[string][]string{}
+	for name, params := range p.params {
+		queries[strings.ToLower(name)] = params
+	}
+
+	for _, typ := range sortedKeys(types) {
+		name, ok := resourceModel(models, typ)
+		if !ok {
+			continue
+		}
+
+		resource := &queryResource{typ: typ, name: name}
+		list := queries["list"+strings.ToLower(typ)+"query"]
+		targets := map[string]bool{}
+		included := false
+
+		for _, params := range [][]string{list, queries["get"+strings.ToLower(name)+"query"]} {
+			for _, param := range params {
+				if target, ok := paramKey(param, "fields"); ok {
+					targets[target] = true
+				}
+
+				included = included || param == "include"
+
+				resource.addParam(param)
+			}
+		}
+
+		attributes := sortedKeys(p.structs[name+"Attributes"])
+		relationships := sortedKeys(p.structs[name+"Relationships"])
+
+		resource.fields = appendUnique(append([]string{}, attributes...), relationships...)
+
+		if hasParam(list, "sort") {
+			resource.sort = attributes
+		}
+
+		for _, rel := range relationships {
+			if !included {
+				break
+			}
+
+			if target, ok := relationshipType(rel, targets); ok {
+				toMany := p.fieldTypes[name+"Relationships"][rel] == "*PagedRelationship"
+				resource.include = append(resource.include, queryInclude{name: rel, target: target, toMany: toMany})
+			}
+		}
+
+		resource.sortParams()
+		resources = append(resources, resource)
+	}
+
+	return resources
+}
+
+// resourceModels returns the models of resources of the package, the exported struct types with a
+// type, an ID and attributes or relationships, by their name in lower case.
+func (p *scannedPackage) resourceModels() map[string]string {
+	models := map[string]string{}
+
+	for name, model := range p.structs {
+		if ast.IsExported(name) && model["type"] && model["id"] && (model["attributes"] || model["relationships"]) {
+			models[strings.ToLower(name)] = name
+		}
+	}
+
+	return models
+}
+
+// resourceModel returns the model of a resource type, such as Build for "builds": the model named
+// after the singular of the type.
+func resourceModel(models map[string]string, typ string) (string, bool) {
+	for _, singular := range []string{
+		strings.TrimSuffix(typ, "ies") + "y",
+		strings.TrimSuffix(typ, "s"),
+		strings.TrimSuffix(typ, "es"),
+	} {
+		if name, ok := models[strings.ToLower(singular)]; ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// relationshipType returns the type of a relationship among the types that can be included with
+// it: the plural of its name, or the only type ending with the same word.
+func relationshipType(rel string, types map[string]bool) (string, bool) {
+	plural := rel
+	if !strings.HasSuffix(plural, "s") {
+		plural = pluralOf(plural)
+	}
+
+	if types[plural] {
+		return plural, true
+	}
+
+	words := camelWords(plural)
+	last := strings.ToLower(words[len(words)-1])
+
+	var found []string
+
+	for _, typ := range sortedKeys(types) {
+		words := camelWords(typ)
+		if strings.ToLower(words[len(words)-1]) == last {
+			found = append(found, typ)
+		}
+	}
+
+	if len(found) != 1 {
+		return "", false
+	}
+
+	return found[0], true
+}
+
+func pluralOf(word string) string {
+	if strings.HasSuffix(word, "y") {
+		return strings.TrimSuffix(word, "y") + "ies"
+	}
+
+	return word + "s"
+}
+
+// paramKey returns the key of a parameter of the form prefix[key], such as "builds" for
+// "fields[builds]".
+func paramKey(param, prefix string) (string, bool) {
+	if !strings.HasPrefix(param, prefix+"[") || !strings.HasSuffix(param, "]") {
+		return "", false
+	}
+
+	return param[len(prefix)+1 : len(param)-1], true
+}
+
+func hasParam(params []string, param string) bool {
+	for _, p := range params {
+		if p == param {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasInclude(include []queryInclude, name string) bool {
+	for _, rel := range include {
+		if rel.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addParam records a filter or exists parameter of the resource type.
+func (r *queryResource) addParam(param string) {
+	if filter, ok := paramKey(param, "filter"); ok {
+		r.filter = appendUnique(r.filter, filter)
+	}
+
+	if exists, ok := paramKey(param, "exists"); ok {
+		r.exists = appendUnique(r.exists, exists)
+	}
+}
+
+func (r *queryResource) sortParams() {
+	sort.Slice(r.include, func(i, j int) bool { return r.include[i].name < r.include[j].name })
+	sort.Strings(r.sort)
+	sort.Strings(r.filter)
+	sort.Strings(r.exists)
+}
+
+func writeQueryResource(buf *bytes.Buffer, r *queryResource) {
+	name, typ := r.name, r.typ
+	words := strings.ToLower(strings.Join(camelWords(typ), " "))
+
+	fmt.Fprintf(buf, "// New%sQuery returns a Query for %s.\nfunc New%sQuery() *Query[%s] {\n\treturn newQuery[%s](%q)\n}\n\n",
+		goName(typ), words, goName(typ), name, name, typ)
+
+	writeQueryVars(buf, "Fields of "+words+".", r.fields, func(field string) string {
+		return fmt.Sprintf("%sField%s = Field{resource: %q, name: %q}", name, goName(field), typ, field)
+	})
+
+	writeQueryVars(buf, "Relationships of "+words+" that can be included.", r.include, func(rel queryInclude) string {
+		limit := ""
+		if rel.toMany {
+			limit = ", maxLimit: maxIncludedLimit"
+		}
+
+		return fmt.Sprintf("%sInclude%s = Include[%s]{name: %q, target: %q%s}", name, goName(rel.name), name, rel.name, rel.target, limit)
+	})
+
+	writeQueryVars(buf, "Sort keys of "+words+".", r.sort, func(key string) string {
+		return fmt.Sprintf("%sSort%s = Sort[%s]{name: %q}", name, goName(key), name, key)
+	})
+
+	writeQueryVars(buf, "Filters of "+words+".", r.filter, func(filter string) string {
+		return fmt.Sprintf("%sFilter%s = Filter[%s]{name: %q}", name, goName(filter), name, filter)
+	})
+
+	writeQueryVars(buf, "Relationships of "+words+" that can be filtered on with Exists.", r.exists, func(rel string) string {
+		return fmt.Sprintf("%sExists%s = Exists[%s]{name: %q}", name, goName(rel), name, rel)
+	})
+}
+
+// writeQueryVars writes a documented block of variables, one per value, unless there are none.
+func writeQueryVars[T any](buf *bytes.Buffer, doc string, values []T, decl func(T) string) {
+	if len(values) == 0 {
+		return
+	}
+
+	fmt.Fprintf(buf, "// %s\nvar (\n", doc)
+
+	for _, value := range values {
+		fmt.Fprintf(buf, "\t%s\n", decl(value))
+	}
+
+	buf.WriteString(")\n\n")
+}
+
+// isVersion reports whether a path segment is a version of the API, such as "v2".
+func isVersion(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+
+	for _, r := range segment[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func appendUnique(values []string, add ...string) []string {
+	for _, value := range add {
+		found := false
+
+		for _, v := range values {
+			if v == value {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
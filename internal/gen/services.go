@@ -0,0 +1,597 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// File names written by GenerateServices and GenerateMocks.
+const (
+	ServicesFile = "services_gen.go"
+	MocksFile    = "mocks_gen.go"
+)
+
+// service is a service type of a package, such as AppsService, and its exported methods.
+type service struct {
+	// field is the name of the Client field holding the service, such as "Apps".
+	field string
+	// name is the name of the type, such as "AppsService".
+	name    string
+	methods []serviceMethod
+}
+
+// iface returns the name of the interface of the service, such as "AppsAPI".
+func (s *service) iface() string {
+	return strings.TrimSuffix(s.name, "Service") + "API"
+}
+
+type serviceMethod struct {
+	name    string
+	params  []param
+	results []ast.Expr
+	// imports maps the names of the packages used by the signature to their import paths.
+	imports map[string]string
+}
+
+type param struct {
+	name     string
+	typ      ast.Expr
+	variadic bool
+}
+
+// scanServices returns the services of the package in dir: the types whose underlying type is
+// service, in the order of the fields of Client that hold them.
+func scanServices(dir string) ([]*service, map[string]bool, *token.FileSet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	fset := token.NewFileSet()
+	byName := map[string]*service{}
+	types := map[string]bool{}
+
+	var (
+		fields  []string
+		methods []*ast.FuncDecl
+		imports = map[*ast.FuncDecl]map[string]string{}
+	)
+
+	for _, p := range paths {
+		base := filepath.Base(p)
+		if strings.HasSuffix(base, "_test.go") || base == ServicesFile {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, p, nil, 0)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		fileImports := map[string]string{}
+
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			fileImports[name] = importPath
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+
+					types[typeSpec.Name.Name] = true
+
+					if ident, ok := typeSpec.Type.(*ast.Ident); ok && ident.Name == "service" {
+						byName[typeSpec.Name.Name] = &service{name: typeSpec.Name.Name}
+					}
+
+					if typeSpec.Name.Name == "Client" {
+						fields = clientServiceFields(typeSpec)
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && decl.Name.IsExported() {
+					methods = append(methods, decl)
+					imports[decl] = fileImports
+				}
+			}
+		}
+	}
+
+	for _, decl := range methods {
+		star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		recv, ok := star.X.(*ast.Ident)
+		if !ok || byName[recv.Name] == nil {
+			continue
+		}
+
+		byName[recv.Name].methods = append(byName[recv.Name].methods, newServiceMethod(decl, imports[decl]))
+	}
+
+	var services []*service
+
+	for _, field := range fields {
+		name, typ, _ := strings.Cut(field, " ")
+		if s := byName[typ]; s != nil {
+			s.field = name
+			sort.Slice(s.methods, func(i, j int) bool { return s.methods[i].name < s.methods[j].name })
+			services = append(services, s)
+		}
+	}
+
+	return services, types, fset, nil
+}
+
+// clientServiceFields returns the fields of Client of a pointer type, as "Name Type".
+func clientServiceFields(spec *ast.TypeSpec) []string {
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+
+	var fields []string
+
+	for _, field := range structType.Fields.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		if ident, ok := star.X.(*ast.Ident); ok {
+			for _, name := range field.Names {
+				fields = append(fields, name.Name+" "+ident.Name)
+			}
+		}
+	}
+
+	return fields
+}
+
+func newServiceMethod(decl *ast.FuncDecl, fileImports map[string]string) serviceMethod {
+	m := serviceMethod{name: decl.Name.Name, imports: map[string]string{}}
+
+	for _, field := range decl.Type.Params.List {
+		typ := field.Type
+
+		ellipsis, variadic := typ.(*ast.Ellipsis)
+		if variadic {
+			typ = ellipsis.Elt
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: ""}}
+		}
+
+		for _, name := range names {
+			m.params = append(m.params, param{name: name.Name, typ: typ, variadic: variadic})
+		}
+	}
+
+	if decl.Type.Results != nil {
+		for _, field := range decl.Type.Results.List {
+			for i := 0; i < len(field.Names) || i == 0; i++ {
+				m.results = append(m.results, field.Type)
+			}
+		}
+	}
+
+	ast.Inspect(decl.Type, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && fileImports[ident.Name] != "" {
+				m.imports[ident.Name] = fileImports[ident.Name]
+			}
+		}
+
+		return true
+	})
+
+	return m
+}
+
+// GenerateServices returns the source of an interface for each service of the package in dir,
+// such as AppsAPI for AppsService, of a Services struct holding them, and of the Services method
+// of Client that returns its services.
+func GenerateServices(dir string, pkg string) ([]byte, error) {
+	services, _, fset, err := scanServices(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	imports := map[string]string{}
+	body := new(bytes.Buffer)
+
+	for _, s := range services {
+		fmt.Fprintf(body, "// %s is the interface of %s, the methods of Client.%s.\n", s.iface(), s.name, s.field)
+		fmt.Fprintf(body, "type %s interface {\n", s.iface())
+
+		for _, m := range s.methods {
+			fmt.Fprintf(body, "\t%s%s\n", m.name, m.signature(fset, nil))
+
+			for name, importPath := range m.imports {
+				imports[name] = importPath
+			}
+		}
+
+		body.WriteString("}\n\n")
+	}
+
+	body.WriteString("// Services holds the services of a Client as interfaces, so that code using them can be\n")
+	body.WriteString("// tested with mocks, such as those of package ascmock.\n")
+	body.WriteString("type Services struct {\n")
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t%s %s\n", s.field, s.iface())
+	}
+
+	body.WriteString("}\n\n")
+	body.WriteString("// Services returns the services of the client.\n")
+	body.WriteString("func (c *Client) Services() Services {\n\treturn Services{\n")
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t\t%[1]s: c.%[1]s,\n", s.field)
+	}
+
+	body.WriteString("\t}\n}\n\n")
+	body.WriteString("var (\n")
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t_ %s = (*%s)(nil)\n", s.iface(), s.name)
+	}
+
+	body.WriteString(")\n")
+
+	return formatGenerated(pkg, imports, body, ServicesFile)
+}
+
+// GenerateMocks returns the source of package mockPkg, with a mock of each service interface of
+// the package in dir, whose import path is importPath. The mocks embed the Recorder type of
+// mockPkg, and call its record method and notStubbed function, which are not generated.
+func GenerateMocks(dir string, importPath string, mockPkg string) ([]byte, error) {
+	services, types, fset, err := scanServices(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg := path.Base(importPath)
+	imports := map[string]string{pkg: importPath}
+	body := new(bytes.Buffer)
+
+	body.WriteString("// Mocks holds a mock of each service.\n")
+	body.WriteString("type Mocks struct {\n")
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t%s *%sMock\n", s.field, s.field)
+	}
+
+	body.WriteString("}\n\n")
+	body.WriteString("// New returns a mock of each service, with no stubs.\n")
+	body.WriteString("func New() *Mocks {\n\treturn &Mocks{\n")
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t\t%[1]s: &%[1]sMock{},\n", s.field)
+	}
+
+	body.WriteString("\t}\n}\n\n")
+	fmt.Fprintf(body, "// Services returns the mocks as %s.Services.\n", pkg)
+	fmt.Fprintf(body, "func (m *Mocks) Services() %s.Services {\n\treturn %s.Services{\n", pkg, pkg)
+
+	for _, s := range services {
+		fmt.Fprintf(body, "\t\t%[1]s: m.%[1]s,\n", s.field)
+	}
+
+	body.WriteString("\t}\n}\n\n")
+
+	for _, s := range services {
+		mock := s.field + "Mock"
+
+		fmt.Fprintf(body, "// %s is a mock of %s.%s. Each method records the call, then calls the function\n", mock, pkg, s.iface())
+		fmt.Fprintf(body, "// of the field named after it, such as %sFunc, or fails with ErrNotStubbed if it is nil.\n", s.methods[0].name)
+		fmt.Fprintf(body, "type %s struct {\n\tRecorder\n\n", mock)
+
+		qualify := func(name string) bool { return types[name] }
+
+		for _, m := range s.methods {
+			fmt.Fprintf(body, "\t%sFunc func%s\n", m.name, m.signature(fset, qualifier(pkg, qualify)))
+		}
+
+		body.WriteString("}\n\n")
+		fmt.Fprintf(body, "var _ %s.%s = (*%s)(nil)\n\n", pkg, s.iface(), mock)
+
+		for _, m := range s.methods {
+			m.writeMock(body, fset, mock, qualifier(pkg, qualify), imports)
+		}
+	}
+
+	return formatGenerated(mockPkg, imports, body, MocksFile)
+}
+
+// writeMock writes the method of a mock that records the call and calls the stub.
+func (m serviceMethod) writeMock(buf *bytes.Buffer, fset *token.FileSet, mock string, qualify func(ast.Expr) ast.Expr, imports map[string]string) {
+	for name, importPath := range m.imports {
+		imports[name] = importPath
+	}
+
+	names := m.mockParamNames()
+	params := make([]string, len(m.params))
+	args := make([]string, len(m.params))
+
+	for i, p := range m.params {
+		typ := exprString(fset, qualify(p.typ))
+		args[i] = names[i]
+
+		if p.variadic {
+			typ = "..." + typ
+			args[i] += "..."
+		}
+
+		params[i] = names[i] + " " + typ
+	}
+
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = exprString(fset, qualify(r))
+	}
+
+	fmt.Fprintf(buf, "// %s calls %sFunc.\n", m.name, m.name)
+	fmt.Fprintf(buf, "func (mock *%s) %s(%s) %s {\n", mock, m.name, strings.Join(params, ", "), resultList(results))
+	fmt.Fprintf(buf, "\tmock.record(%q", m.name)
+
+	for _, name := range names {
+		fmt.Fprintf(buf, ", %s", name)
+	}
+
+	buf.WriteString(")\n\n")
+	fmt.Fprintf(buf, "\tif mock.%sFunc == nil {\n", m.name)
+
+	notStubbed := fmt.Sprintf("notStubbed(%q, %q)", mock, m.name)
+
+	switch {
+	case len(results) == 0:
+		buf.WriteString("\t\treturn\n")
+	case results[len(results)-1] != "error":
+		fmt.Fprintf(buf, "\t\tpanic(%s)\n", notStubbed)
+	default:
+		zeros := make([]string, 0, len(results))
+
+		if len(results) > 1 {
+			buf.WriteString("\t\tvar (\n")
+
+			for i, r := range results[:len(results)-1] {
+				fmt.Fprintf(buf, "\t\t\tr%d %s\n", i, r)
+				zeros = append(zeros, fmt.Sprintf("r%d", i))
+			}
+
+			buf.WriteString("\t\t)\n\n")
+		}
+
+		zeros = append(zeros, notStubbed)
+		fmt.Fprintf(buf, "\t\treturn %s\n", strings.Join(zeros, ", "))
+	}
+
+	buf.WriteString("\t}\n\n")
+
+	call := fmt.Sprintf("mock.%sFunc(%s)", m.name, strings.Join(args, ", "))
+	if len(results) == 0 {
+		fmt.Fprintf(buf, "\t%s\n}\n\n", call)
+	} else {
+		fmt.Fprintf(buf, "\treturn %s\n}\n\n", call)
+	}
+}
+
+// mockParamNames returns the names of the parameters of the method of a mock, renaming the
+// parameters that have no name, or whose name is used by the mock or an imported package.
+func (m serviceMethod) mockParamNames() []string {
+	reserved := map[string]bool{"mock": true, "_": true, "": true}
+	for name := range m.imports {
+		reserved[name] = true
+	}
+
+	names := make([]string, len(m.params))
+
+	for i, p := range m.params {
+		names[i] = p.name
+		if reserved[p.name] {
+			names[i] = fmt.Sprintf("arg%d", i)
+		}
+	}
+
+	return names
+}
+
+// signature returns the parameters and results of the method, with the types qualified by
+// qualify if it is not nil.
+func (m serviceMethod) signature(fset *token.FileSet, qualify func(ast.Expr) ast.Expr) string {
+	if qualify == nil {
+		qualify = func(expr ast.Expr) ast.Expr { return expr }
+	}
+
+	params := make([]string, len(m.params))
+
+	for i, p := range m.params {
+		typ := exprString(fset, qualify(p.typ))
+		if p.variadic {
+			typ = "..." + typ
+		}
+
+		params[i] = strings.TrimSpace(p.name + " " + typ)
+	}
+
+	results := make([]string, len(m.results))
+	for i, r := range m.results {
+		results[i] = exprString(fset, qualify(r))
+	}
+
+	return "(" + strings.Join(params, ", ") + ") " + resultList(results)
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return results[0]
+	default:
+		return "(" + strings.Join(results, ", ") + ")"
+	}
+}
+
+// qualifier returns a function that qualifies the identifiers of the types declared by a package
+// with the name of the package, such as AppsResponse to asc.AppsResponse.
+func qualifier(pkg string, declared func(string) bool) func(ast.Expr) ast.Expr {
+	var qualify func(ast.Expr) ast.Expr
+
+	qualify = func(expr ast.Expr) ast.Expr {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if declared(e.Name) {
+				return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(e.Name)}
+			}
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: qualify(e.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: qualify(e.Elt)}
+		case *ast.IndexExpr:
+			return &ast.IndexExpr{X: qualify(e.X), Index: qualify(e.Index)}
+		case *ast.IndexListExpr:
+			indices := make([]ast.Expr, len(e.Indices))
+			for i, index := range e.Indices {
+				indices[i] = qualify(index)
+			}
+
+			return &ast.IndexListExpr{X: qualify(e.X), Indices: indices}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: qualifyFields(e.Params, qualify), Results: qualifyFields(e.Results, qualify)}
+		}
+
+		return expr
+	}
+
+	return qualify
+}
+
+func qualifyFields(fields *ast.FieldList, qualify func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type)})
+	}
+
+	return qualified
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+
+	_ = printer.Fprint(&buf, fset, expr)
+
+	return buf.String()
+}
+
+// formatGenerated returns the formatted source of a generated file of package pkg with the given
+// imports and body.
+func formatGenerated(pkg string, imports map[string]string, body *bytes.Buffer, name string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "// Code generated by ascgen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	if len(imports) > 0 {
+		// Standard library imports come first, then the others, in separate groups.
+		var groups [2][]string
+
+		for name, importPath := range imports {
+			spec := strconv.Quote(importPath)
+			if path.Base(importPath) != name {
+				spec = name + " " + spec
+			}
+
+			group := 0
+			if first, _, _ := strings.Cut(importPath, "/"); strings.Contains(first, ".") {
+				group = 1
+			}
+
+			groups[group] = append(groups[group], spec)
+		}
+
+		buf.WriteString("import (\n")
+
+		for _, group := range groups {
+			if len(group) == 0 {
+				continue
+			}
+
+			sort.Strings(group)
+			fmt.Fprintf(buf, "\t%s\n\n", strings.Join(group, "\n\t"))
+		}
+
+		buf.WriteString(")\n\n")
+	}
+
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", name, err)
+	}
+
+	return src, nil
+}
+
+// isGeneratedFile reports whether name is a file written by ascgen, which scanPackage skips.
+func isGeneratedFile(name string) bool {
+	return name == UnknownFieldsFile || name == ServicesFile || name == QueryResourcesFile
+}
@@ -0,0 +1,257 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package gen generates the models, query structs, service methods and included-type registries of
+// package asc from Apple's App Store Connect OpenAPI document, and reports drift between the
+// document and the hand-maintained package.
+//
+// Apple publishes the document at https://developer.apple.com/sample-code/app-store-connect/app-store-connect-openapi-specification.zip.
+// Run the ascgen command to use it:
+//
+//	go run ./internal/gen/cmd/ascgen -spec openapi.oas.json -out ./generated
+//	go run ./internal/gen/cmd/ascgen -spec openapi.oas.json -check ./asc
+//	go run ./internal/gen/cmd/ascgen -spec openapi.oas.json -queries ./asc
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document used by the generator.
+type Spec struct {
+	Paths      map[string]PathItem `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// PathItem holds the operations of a path, keyed by lowercase HTTP method.
+type PathItem map[string]*Operation
+
+// UnmarshalJSON keeps only the operations of the path item, ignoring shared parameters and
+// other extensions.
+func (p *PathItem) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*p = PathItem{}
+
+	for _, method := range methods {
+		data, ok := raw[method]
+		if !ok {
+			continue
+		}
+
+		var op Operation
+		if err := json.Unmarshal(data, &op); err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+
+		(*p)[method] = &op
+	}
+
+	return nil
+}
+
+var methods = []string{"get", "post", "patch", "delete"}
+
+// Operation is an operation on a path.
+type Operation struct {
+	OperationID string                  `json:"operationId"`
+	Tags        []string                `json:"tags"`
+	Deprecated  bool                    `json:"deprecated"`
+	Parameters  []Parameter             `json:"parameters"`
+	RequestBody *MediaObject            `json:"requestBody"`
+	Responses   map[string]*MediaObject `json:"responses"`
+}
+
+// Parameter is a path or query parameter of an operation.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Explode  *bool   `json:"explode"`
+	Schema   *Schema `json:"schema"`
+}
+
+// MediaObject is a request body or a response of an operation.
+type MediaObject struct {
+	Description string `json:"description"`
+	Content     map[string]struct {
+		Schema *Schema `json:"schema"`
+	} `json:"content"`
+}
+
+// Schema is a JSON schema of a component, property or parameter.
+type Schema struct {
+	Ref        string             `json:"$ref"`
+	Type       string             `json:"type"`
+	Format     string             `json:"format"`
+	Properties map[string]*Schema `json:"properties"`
+	Items      *Schema            `json:"items"`
+	Enum       []string           `json:"enum"`
+	OneOf      []*Schema          `json:"oneOf"`
+	Required   []string           `json:"required"`
+	Deprecated bool               `json:"deprecated"`
+}
+
+// RefName returns the name of the component a schema refers to, or an empty string.
+func (s *Schema) RefName() string {
+	if s == nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(s.Ref, "#/components/schemas/")
+}
+
+// LoadSpec reads an OpenAPI document in JSON format.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSpec(data)
+}
+
+// ParseSpec parses an OpenAPI document in JSON format.
+func ParseSpec(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+
+	return &spec, nil
+}
+
+// Schema resolves a schema, following its reference if it has one.
+func (s *Spec) Schema(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = s.Components.Schemas[schema.RefName()]
+	}
+
+	return schema
+}
+
+// Endpoint is an operation together with its path and method.
+type Endpoint struct {
+	Method    string
+	Path      string
+	Operation *Operation
+}
+
+// Endpoints returns every operation of the document that is not deprecated, sorted by path and method.
+func (s *Spec) Endpoints() []Endpoint {
+	var endpoints []Endpoint
+
+	for path, item := range s.Paths {
+		for method, op := range item {
+			if !op.Deprecated {
+				endpoints = append(endpoints, Endpoint{Method: method, Path: path, Operation: op})
+			}
+		}
+	}
+
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+
+		return methodOrder(endpoints[i].Method) < methodOrder(endpoints[j].Method)
+	})
+
+	return endpoints
+}
+
+// Resources returns the names of the schemas of resources, which are objects with a type, an ID and
+// attributes or relationships, keyed by their resource type, such as "App" for "apps".
+func (s *Spec) Resources() map[string]string {
+	resources := map[string]string{}
+
+	for name, schema := range s.Components.Schemas {
+		typ := s.Schema(schema.Properties["type"])
+		if typ == nil || len(typ.Enum) != 1 || schema.Properties["id"] == nil {
+			continue
+		}
+
+		if schema.Properties["attributes"] == nil && schema.Properties["relationships"] == nil {
+			continue
+		}
+
+		if existing, ok := resources[typ.Enum[0]]; !ok || len(name) < len(existing) {
+			resources[typ.Enum[0]] = name
+		}
+	}
+
+	return resources
+}
+
+// responseSchema returns the name of the schema of the successful JSON response of an operation.
+func (op *Operation) responseSchema() string {
+	for _, status := range []string{"200", "201"} {
+		if resp, ok := op.Responses[status]; ok {
+			if content, ok := resp.Content["application/json"]; ok {
+				return content.Schema.RefName()
+			}
+		}
+	}
+
+	return ""
+}
+
+// requestSchema returns the name of the schema of the JSON request body of an operation.
+func (op *Operation) requestSchema() string {
+	if op.RequestBody == nil {
+		return ""
+	}
+
+	if content, ok := op.RequestBody.Content["application/json"]; ok {
+		return content.Schema.RefName()
+	}
+
+	return ""
+}
+
+func methodOrder(method string) int {
+	for i, m := range methods {
+		if m == method {
+			return i
+		}
+	}
+
+	return len(methods)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,103 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"sort"
+)
+
+// UnknownFieldsFile is the name of the file written by GenerateUnknownFields.
+const UnknownFieldsFile = "unknown_gen.go"
+
+// GenerateUnknownFields returns the source of the JSON methods that preserve the unknown members
+// of every struct type of the package in dir with an Unknown field of type UnknownFields, and of
+// the registry of the known values of its string enums.
+//
+// Types that declare their own UnmarshalJSON or MarshalJSON method are skipped, and must keep
+// their unknown members themselves. Unknown members are only kept by the exported response and
+// attribute types, so an Unknown field on an unexported type, such as the relationships of a request,
+// is an error.
+func GenerateUnknownFields(dir string, pkg string) ([]byte, error) {
+	scanned, err := scanPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range scanned.unknownFields {
+		if !ast.IsExported(name) {
+			return nil, fmt.Errorf("%s: unexported types do not keep unknown members", name)
+		}
+	}
+
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "// Code generated by ascgen. DO NOT EDIT.\n\npackage %s\n\nimport \"reflect\"\n\n", pkg)
+
+	for _, name := range scanned.unknownFields {
+		if !scanned.methods[name+".UnmarshalJSON"] {
+			fmt.Fprintf(buf, "// UnmarshalJSON decodes %[1]s, keeping unknown members in Unknown.\n", name)
+			fmt.Fprintf(buf, "func (v *%[1]s) UnmarshalJSON(b []byte) error {\n\ttype plain %[1]s\n\n", name)
+			buf.WriteString("\treturn unmarshalKeepingUnknown(b, (*plain)(v), &v.Unknown)\n}\n\n")
+		}
+
+		if !scanned.methods[name+".MarshalJSON"] {
+			fmt.Fprintf(buf, "// MarshalJSON encodes %[1]s along with its unknown members.\n", name)
+			fmt.Fprintf(buf, "func (v %[1]s) MarshalJSON() ([]byte, error) {\n\ttype plain %[1]s\n\n", name)
+			buf.WriteString("\treturn marshalWithUnknown(plain(v), v.Unknown)\n}\n\n")
+		}
+	}
+
+	enums := make([]string, 0, len(scanned.enums))
+
+	for name, values := range scanned.enums {
+		if len(values) > 0 {
+			enums = append(enums, name)
+		}
+	}
+
+	sort.Strings(enums)
+
+	buf.WriteString("// knownEnumValues holds the values of every string enum of the package.\n")
+	buf.WriteString("var knownEnumValues = map[reflect.Type][]string{\n")
+
+	for _, name := range enums {
+		fmt.Fprintf(buf, "\treflect.TypeOf(%s(\"\")): {\n", name)
+
+		for _, value := range scanned.enums[name] {
+			fmt.Fprintf(buf, "\t\t%q,\n", value)
+		}
+
+		buf.WriteString("\t},\n")
+	}
+
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", UnknownFieldsFile, err)
+	}
+
+	return src, nil
+}
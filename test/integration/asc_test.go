@@ -24,54 +24,102 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package integration
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
-	"time"
+	"testing"
 
 	"github.com/castbox/asc-go/asc"
+	"github.com/castbox/asc-go/asc/asctest"
 )
 
-const (
-	envKeyID          = "ASC_INTEGRATION_KID"
-	envIssuerID       = "ASC_INTEGRATION_ISS"
-	envPrivateKey     = "ASC_INTEGRATION_PRIVATE_KEY"
-	envPrivateKeyPath = "ASC_INTEGRATION_PRIVATE_KEY_PATH"
-)
+// cassettePath is the cassette the tests are recorded to and replayed from
+const cassettePath = "testdata/integration.json"
 
 var (
 	client *asc.Client
 )
 
-func init() {
+// errNoCredentials is returned by setup when neither a cassette nor credentials are available.
+var errNoCredentials = errors.New("no cassette or credentials to run the integration tests with")
+
+// TestMain runs the tests against the live API when ASC_INTEGRATION_RECORD is set, recording
+// them into the cassette. Otherwise the tests replay the cassette if it exists, or run against the
+// live API without recording. Without a cassette or credentials, the tests are skipped.
+func TestMain(m *testing.M) {
+	recorder, err := setup()
+	if errors.Is(err, errNoCredentials) {
+		fmt.Println("skipping the integration tests:", err)
+		os.Exit(0)
+	} else if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	code := m.Run()
+
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			fmt.Println(err)
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
+
+func setup() (*asctest.Recorder, error) {
+	if os.Getenv("ASC_INTEGRATION_RECORD") == "" {
+		recorder, err := asctest.NewRecorder(cassettePath, asctest.ModeReplay)
+		if err == nil {
+			client = asc.NewClient(recorder.Client())
+			client.SetUploadClient(recorder.Client())
+			return recorder, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
 	token := tokenConfig()
-	if token == nil {
-		return
+	if token == nil && os.Getenv("ASC_INTEGRATION_RECORD") == "" {
+		return nil, errNoCredentials
+	} else if token == nil {
+		return nil, errors.New("no credentials to record the integration tests against the API")
+	}
+
+	if os.Getenv("ASC_INTEGRATION_RECORD") == "" {
+		client = asc.NewClient(token.Client())
+		return nil, nil
 	}
+
+	recorder, err := asctest.NewRecorder(cassettePath, asctest.ModeRecord)
+	if err != nil {
+		return nil, err
+	}
+	token.Transport = recorder
 	client = asc.NewClient(token.Client())
+	client.SetUploadClient(recorder.Client())
+
+	return recorder, nil
 }
 
-// TokenConfig creates the auth transport using the required information
+// TokenConfig creates the auth transport from the ASC_INTEGRATION_* environment variables or the
+// asc config file. The ASC_INTEGRATION_KID and ASC_INTEGRATION_ISS variables used before are still
+// accepted as a fallback for the key and issuer IDs that neither of them sets.
 func tokenConfig() *asc.AuthTransport {
-	var privateKey []byte
-	var err error
-	if key := os.Getenv(envPrivateKey); key != "" {
-		privateKey = []byte(key)
-	} else if keyPath := os.Getenv(envPrivateKeyPath); keyPath != "" {
-		// Read private key file as []byte
-		privateKey, err = os.ReadFile(keyPath)
-		if err != nil {
-			fmt.Println(err)
-			return nil
-		}
-	} else {
-		fmt.Println("no private key provided to either the ASC_INTEGRATION_PRIVATE_KEY or ASC_INTEGRATION_PRIVATE_KEY_PATH environment variables")
+	cfg, err := asc.LoadConfig(asc.WithEnvPrefix("ASC_INTEGRATION_"))
+	if err != nil {
+		fmt.Println(err)
 		return nil
 	}
-	keyID := os.Getenv(envKeyID)
-	issuerID := os.Getenv(envIssuerID)
-	expiryDuration := 20 * time.Minute
-	// Create the token using the required information
-	auth, err := asc.NewTokenConfig(keyID, issuerID, expiryDuration, privateKey)
+	if cfg.KeyID == "" {
+		cfg.KeyID = os.Getenv("ASC_INTEGRATION_KID")
+	}
+	if cfg.IssuerID == "" {
+		cfg.IssuerID = os.Getenv("ASC_INTEGRATION_ISS")
+	}
+	auth, err := cfg.AuthTransport()
 	if err != nil {
 		fmt.Println(err)
 		return nil